@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/opctl/opctl/sdks/go/model"
+	sdknode "github.com/opctl/opctl/sdks/go/node"
+	"golang.org/x/term"
+)
+
+// attachContainer attaches the terminal to the TTY of an interactive container & returns once the container exits.
+// While attached the terminal is in raw mode & resizes of it are propagated to the container.
+func attachContainer(
+	ctx context.Context,
+	node sdknode.Node,
+	containerID string,
+) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stdinFd := int(os.Stdin.Fd())
+	if term.IsTerminal(stdinFd) {
+		// pass keystrokes (including Control-C) through to the container as is
+		oldState, err := term.MakeRaw(stdinFd)
+		if err != nil {
+			return err
+		}
+		defer term.Restore(stdinFd, oldState)
+	}
+
+	sigWinchChannel := make(chan os.Signal, 1)
+	signal.Notify(
+		sigWinchChannel,
+		syscall.Signal(0x1c), // portable version of syscall.SIGWINCH
+	)
+	defer signal.Stop(sigWinchChannel)
+
+	resizes := make(chan model.TerminalSize, 1)
+	resize := func() {
+		width, height, err := term.GetSize(int(os.Stdout.Fd()))
+		if err != nil {
+			return
+		}
+
+		select {
+		case resizes <- model.TerminalSize{Height: uint16(height), Width: uint16(width)}:
+		default:
+			// a resize is already pending
+		}
+	}
+
+	// propagate the initial size then any resizes
+	resize()
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-sigWinchChannel:
+				resize()
+			}
+		}
+	}()
+
+	return node.AttachContainer(
+		ctx,
+		model.AttachContainerReq{
+			ContainerID: containerID,
+			Stdin:       os.Stdin,
+			Stdout:      os.Stdout,
+			Resizes:     resizes,
+		},
+	)
+}
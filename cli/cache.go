@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/opctl/opctl/cli/internal/nodeprovider"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+// cacheLs implements "cache ls" command
+func cacheLs(
+	ctx context.Context,
+	nodeProvider nodeprovider.NodeProvider,
+	writer io.Writer,
+) error {
+	node, err := nodeProvider.CreateNodeIfNotExists(ctx)
+	if err != nil {
+		return err
+	}
+
+	entries, err := node.ListCacheEntries(ctx)
+	if err != nil {
+		return err
+	}
+
+	_tabWriter := new(tabwriter.Writer)
+	defer _tabWriter.Flush()
+	_tabWriter.Init(writer, 0, 8, 1, '\t', 0)
+
+	fmt.Fprintln(_tabWriter, "KEY\tIMAGE\tOP\tCREATED\tSIZE")
+
+	for _, entry := range entries {
+		fmt.Fprintf(
+			_tabWriter,
+			"%.12s\t%s\t%s\t%s\t%d\n",
+			entry.Key,
+			entry.ImageRef,
+			entry.OpRef,
+			entry.CreatedAt.Local().Format(time.RFC3339),
+			entry.SizeBytes,
+		)
+	}
+
+	return nil
+}
+
+// cachePrune implements "cache prune" command
+func cachePrune(
+	ctx context.Context,
+	nodeProvider nodeprovider.NodeProvider,
+	olderThan string,
+) (string, error) {
+	req := model.PruneCacheReq{}
+	if olderThan != "" {
+		olderThanDuration, err := time.ParseDuration(olderThan)
+		if err != nil {
+			return "", fmt.Errorf("invalid older-than: %w", err)
+		}
+
+		before := time.Now().UTC().Add(-olderThanDuration)
+		req.Before = &before
+	}
+
+	node, err := nodeProvider.CreateNodeIfNotExists(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	prunedEntries, err := node.PruneCache(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	var prunedBytes int64
+	for _, entry := range prunedEntries {
+		prunedBytes += entry.SizeBytes
+	}
+
+	return fmt.Sprintf("pruned %d cache entries (%d bytes)", len(prunedEntries), prunedBytes), nil
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	nodeproviderFakes "github.com/opctl/opctl/cli/internal/nodeprovider/fakes"
+	"github.com/opctl/opctl/sdks/go/model"
+	nodeFakes "github.com/opctl/opctl/sdks/go/node/fakes"
+)
+
+func TestCacheLs(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	/* arrange */
+	createdAt := time.Now()
+
+	fakeNode := new(nodeFakes.FakeNode)
+	fakeNode.ListCacheEntriesReturns(
+		[]*model.CacheEntry{
+			{
+				Key:       "0123456789abcdef",
+				ImageRef:  "alpine",
+				OpRef:     "op1",
+				CreatedAt: createdAt,
+				SizeBytes: 2,
+			},
+		},
+		nil,
+	)
+
+	fakeNodeProvider := new(nodeproviderFakes.FakeNodeProvider)
+	fakeNodeProvider.CreateNodeIfNotExistsReturns(fakeNode, nil)
+
+	output := new(bytes.Buffer)
+
+	/* act */
+	err := cacheLs(context.Background(), fakeNodeProvider, output)
+
+	/* assert */
+	g.Expect(err).To(BeNil())
+	g.Expect(output.String()).To(HavePrefix("KEY\t"))
+	g.Expect(output.String()).To(ContainSubstring("\n0123456789ab\talpine\top1\t" + createdAt.Local().Format(time.RFC3339) + "\t2\n"))
+}
+
+func TestCachePrune(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	/* arrange */
+	fakeNode := new(nodeFakes.FakeNode)
+	fakeNode.PruneCacheReturns(
+		[]*model.CacheEntry{
+			{SizeBytes: 2},
+			{SizeBytes: 3},
+		},
+		nil,
+	)
+
+	fakeNodeProvider := new(nodeproviderFakes.FakeNodeProvider)
+	fakeNodeProvider.CreateNodeIfNotExistsReturns(fakeNode, nil)
+
+	/* act */
+	message, err := cachePrune(context.Background(), fakeNodeProvider, "24h")
+
+	/* assert */
+	g.Expect(err).To(BeNil())
+	g.Expect(message).To(Equal("pruned 2 cache entries (5 bytes)"))
+
+	_, actualReq := fakeNode.PruneCacheArgsForCall(0)
+	g.Expect(*actualReq.Before).To(BeTemporally("~", time.Now().Add(-24*time.Hour), time.Minute))
+}
@@ -70,6 +70,14 @@ func newCli(
 		},
 	)
 
+	containerPrivilegedByDefault := cli.Bool(
+		mow.BoolOpt{
+			Desc:   "Run containers which don't explicitly set privileged as privileged; for compatibility w/ ops relying on containers being privileged",
+			EnvVar: "OPCTL_CONTAINER_PRIVILEGED_BY_DEFAULT",
+			Name:   "container-privileged-by-default",
+		},
+	)
+
 	dataDir := cli.String(
 		mow.StringOpt{
 			Desc:   "Path of dir used to store opctl data",
@@ -79,6 +87,30 @@ func newCli(
 		},
 	)
 
+	eventRetentionMaxAge := cli.String(
+		mow.StringOpt{
+			Desc:   "Max duration (e.g. 168h) events of ended root ops are retained by the node",
+			EnvVar: "OPCTL_EVENT_RETENTION_MAX_AGE",
+			Name:   "event-retention-max-age",
+		},
+	)
+
+	eventRetentionMaxRootOps := cli.Int(
+		mow.IntOpt{
+			Desc:   "Max number of ended root ops events are retained for by the node",
+			EnvVar: "OPCTL_EVENT_RETENTION_MAX_ROOT_OPS",
+			Name:   "event-retention-max-root-ops",
+		},
+	)
+
+	eventRetentionMaxSizeBytes := cli.Int(
+		mow.IntOpt{
+			Desc:   "Max total size in bytes of events retained by the node",
+			EnvVar: "OPCTL_EVENT_RETENTION_MAX_SIZE_BYTES",
+			Name:   "event-retention-max-size-bytes",
+		},
+	)
+
 	listenAddress := cli.String(
 		mow.StringOpt{
 			Desc:   "HOST:PORT on which the node will listen",
@@ -88,10 +120,24 @@ func newCli(
 		},
 	)
 
+	parallelOutputConflicts := cli.String(
+		mow.StringOpt{
+			Desc:   "How parallel calls handle more than one child producing the same output; fail or warn",
+			EnvVar: "OPCTL_PARALLEL_OUTPUT_CONFLICTS",
+			Name:   "parallel-output-conflicts",
+			Value:  "warn",
+		},
+	)
+
 	nodeCreateOpts := local.NodeCreateOpts{
-		ContainerRuntime: *containerRuntime,
-		DataDir:          *dataDir,
-		ListenAddress:    *listenAddress,
+		ContainerPrivilegedByDefault: *containerPrivilegedByDefault,
+		ContainerRuntime:             *containerRuntime,
+		DataDir:                      *dataDir,
+		EventRetentionMaxAge:         *eventRetentionMaxAge,
+		EventRetentionMaxRootOps:     *eventRetentionMaxRootOps,
+		EventRetentionMaxSizeBytes:   *eventRetentionMaxSizeBytes,
+		ListenAddress:                *listenAddress,
+		ParallelOutputConflicts:      *parallelOutputConflicts,
 	}
 
 	nodeProvider := local.New(
@@ -141,7 +187,41 @@ func newCli(
 		})
 	})
 
+	cli.Command("cache", "Manage cached container call results", func(cacheCmd *mow.Cmd) {
+		cacheCmd.Command("ls", "List cached container call results", func(lsCmd *mow.Cmd) {
+			lsCmd.Action = func() {
+				exitWith(
+					"",
+					cacheLs(
+						ctx,
+						nodeProvider,
+						os.Stdout,
+					),
+				)
+			}
+		})
+
+		cacheCmd.Command("prune", "Remove cached container call results", func(pruneCmd *mow.Cmd) {
+			olderThan := pruneCmd.StringOpt("older-than", "", "Only remove results cached longer ago than this duration (e.g. 24h)")
+
+			pruneCmd.Action = func() {
+				exitWith(
+					cachePrune(
+						ctx,
+						nodeProvider,
+						*olderThan,
+					),
+				)
+			}
+		})
+	})
+
 	cli.Command("events", "Stream events", func(eventsCmd *mow.Cmd) {
+		callIDs := eventsCmd.StringsOpt("call-id", nil, "Only stream events of these calls & their descendants")
+		containerIDs := eventsCmd.StringsOpt("container-id", nil, "Only stream events of these containers")
+		kinds := eventsCmd.StringsOpt("kind", nil, "Only stream events of these kinds (e.g. callStarted)")
+		outcomes := eventsCmd.StringsOpt("outcome", nil, "Only stream callEnded events w/ these outcomes (e.g. FAILED)")
+
 		eventsCmd.Action = func() {
 			exitWith(
 				"",
@@ -149,6 +229,12 @@ func newCli(
 					ctx,
 					cliOutput,
 					nodeProvider,
+					model.EventFilter{
+						CallIDs:      nilIfEmpty(*callIDs),
+						ContainerIDs: nilIfEmpty(*containerIDs),
+						Kinds:        nilIfEmpty(*kinds),
+						Outcomes:     nilIfEmpty(*outcomes),
+					},
 				),
 			)
 		}
@@ -190,6 +276,26 @@ func newCli(
 				exitWith("", nodeProvider.KillNodeIfExists(""))
 			}
 		})
+
+		nodeCmd.Command("prune", "Remove events & scratch dirs of ended root ops", func(pruneCmd *mow.Cmd) {
+			all := pruneCmd.BoolOpt("all", false, "Remove all ended root ops")
+			olderThan := pruneCmd.StringOpt("older-than", "", "Only remove root ops ended longer ago than this duration (e.g. 24h)")
+			maxRootOps := pruneCmd.IntOpt("max-root-ops", 0, "Only remove root ops beyond this many of the most recently ended")
+			maxSizeBytes := pruneCmd.IntOpt("max-size-bytes", 0, "Only remove the oldest root ops until events total at most this many bytes")
+
+			pruneCmd.Action = func() {
+				exitWith(
+					nodePrune(
+						ctx,
+						nodeProvider,
+						*all,
+						*olderThan,
+						*maxRootOps,
+						*maxSizeBytes,
+					),
+				)
+			}
+		})
 	})
 
 	cli.Command("op", "Manage ops", func(opCmd *mow.Cmd) {
@@ -203,6 +309,26 @@ func newCli(
 			node,
 		)
 
+		opCmd.Command("attach", "Attach to a running op; replays its events from the start", func(attachCmd *mow.Cmd) {
+			kill := attachCmd.BoolOpt("kill", false, "Kill the op on Control-C rather than detaching from it")
+			noProgress := attachCmd.BoolOpt("no-progress", !term.IsTerminal(int(os.Stdout.Fd())), "Disable live call graph for the op")
+			rootID := attachCmd.StringArg("ROOT_ID", "", "Id of the op to attach to")
+
+			attachCmd.Action = func() {
+				exitWith(
+					"",
+					opAttach(
+						ctx,
+						cliOutput,
+						nodeProvider,
+						*rootID,
+						*noProgress,
+						*kill,
+					),
+				)
+			}
+		})
+
 		opCmd.Command("create", "Create an op", func(createCmd *mow.Cmd) {
 			path := createCmd.StringOpt("path", opspec.DotOpspecDirName, "Path the op will be created at")
 			description := createCmd.StringOpt("d description", "", "Op description")
@@ -220,6 +346,22 @@ func newCli(
 			}
 		})
 
+		opCmd.Command("get", "Get the summary of an op", func(getCmd *mow.Cmd) {
+			opID := getCmd.StringArg("OP_ID", "", "Id of the op to get")
+
+			getCmd.Action = func() {
+				exitWith(
+					"",
+					opGet(
+						ctx,
+						nodeProvider,
+						*opID,
+						os.Stdout,
+					),
+				)
+			}
+		})
+
 		opCmd.Command("install", "Install an op", func(installCmd *mow.Cmd) {
 			path := installCmd.StringOpt("path", opspec.DotOpspecDirName, "Path the op will be installed at")
 			opRef := installCmd.StringArg("OP_REF", "", "Op reference (either `relative/path`, `/absolute/path`, `host/path/repo#tag`, or `host/path/repo#tag/path`)")
@@ -260,6 +402,19 @@ func newCli(
 			}
 		})
 
+		opCmd.Command("ls", "List ops run by the node; most recently started first", func(lsCmd *mow.Cmd) {
+			lsCmd.Action = func() {
+				exitWith(
+					"",
+					opLs(
+						ctx,
+						nodeProvider,
+						os.Stdout,
+					),
+				)
+			}
+		})
+
 		opCmd.Command("validate", "Validate an op", func(validateCmd *mow.Cmd) {
 			opRef := validateCmd.StringArg("OP_REF", "", "Op reference (either `relative/path`, `/absolute/path`, `host/path/repo#tag`, or `host/path/repo#tag/path`)")
 
@@ -274,12 +429,32 @@ func newCli(
 				)
 			}
 		})
+
+		opCmd.Command("wait", "Wait on an op to end; exits w/ the same exit code as run", func(waitCmd *mow.Cmd) {
+			timeout := waitCmd.StringOpt("timeout", "", "Give up waiting after this duration (e.g. 1h)")
+			rootID := waitCmd.StringArg("ROOT_ID", "", "Id of the op to wait on")
+
+			waitCmd.Action = func() {
+				exitWith(
+					"",
+					opWait(
+						ctx,
+						nodeProvider,
+						*rootID,
+						*timeout,
+					),
+				)
+			}
+		})
 	})
 
 	cli.Command("run", "Start and wait on an op", func(runCmd *mow.Cmd) {
 		args := runCmd.StringsOpt("a", []string{}, "Explicitly pass args to op in format `-a NAME1=VALUE1 -a NAME2=VALUE2`")
 		argFile := runCmd.StringOpt("arg-file", filepath.Join(opspec.DotOpspecDirName, "args.yml"), "Read in a file of args in yml format")
+		detach := runCmd.BoolOpt("d detach", false, "Start the op & print its id rather than wait on it; wait on it later via `opctl op wait`")
+		interactive := runCmd.BoolOpt("i interactive", false, "Attach the terminal to containers marked interactive as they start")
 		noProgress := runCmd.BoolOpt("no-progress", !term.IsTerminal(int(os.Stdout.Fd())), "Disable live call graph for the op")
+		output := runCmd.StringOpt("o output", outputFormatText, "Output format; either `text`, `json` (a single document once the op ends) or `ndjson` (a line per event)")
 		opRef := runCmd.StringArg("OP_REF", "", "Op reference (either `relative/path`, `/absolute/path`, `host/path/repo#tag`, or `host/path/repo#tag/path`)")
 
 		runCmd.Action = func() {
@@ -294,6 +469,9 @@ func newCli(
 					*argFile,
 					*opRef,
 					*noProgress,
+					*detach,
+					*interactive,
+					*output,
 				),
 			)
 		}
@@ -14,6 +14,7 @@ func events(
 	ctx context.Context,
 	cliOutput clioutput.CliOutput,
 	nodeProvider nodeprovider.NodeProvider,
+	filter model.EventFilter,
 ) error {
 	node, err := nodeProvider.CreateNodeIfNotExists(ctx)
 	if err != nil {
@@ -22,7 +23,9 @@ func events(
 
 	eventChannel, err := node.GetEventStream(
 		ctx,
-		&model.GetEventStreamReq{},
+		&model.GetEventStreamReq{
+			Filter: filter,
+		},
 	)
 	if err != nil {
 		return err
@@ -37,3 +40,13 @@ func events(
 		cliOutput.Event(&event)
 	}
 }
+
+// nilIfEmpty returns nil for empty values so they don't filter out all events
+func nilIfEmpty(
+	values []string,
+) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	return values
+}
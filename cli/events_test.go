@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/cli/internal/clicolorer"
+	"github.com/opctl/opctl/cli/internal/clioutput"
+	nodeproviderFakes "github.com/opctl/opctl/cli/internal/nodeprovider/fakes"
+	"github.com/opctl/opctl/sdks/go/model"
+	nodeFakes "github.com/opctl/opctl/sdks/go/node/fakes"
+)
+
+func TestEvents(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	/* arrange */
+	providedFilter := model.EventFilter{
+		Kinds: []string{model.EventKindCallEnded},
+	}
+
+	eventChannel := make(chan model.Event)
+	// close eventChannel to trigger immediate return
+	close(eventChannel)
+
+	fakeNode := new(nodeFakes.FakeNode)
+	fakeNode.GetEventStreamReturns(eventChannel, nil)
+
+	fakeNodeProvider := new(nodeproviderFakes.FakeNodeProvider)
+	fakeNodeProvider.CreateNodeIfNotExistsReturns(fakeNode, nil)
+
+	/* act */
+	err := events(
+		context.Background(),
+		clioutput.New(clicolorer.New(), ioutil.Discard, ioutil.Discard),
+		fakeNodeProvider,
+		providedFilter,
+	)
+
+	/* assert */
+	g.Expect(err).To(MatchError("Connection to event stream lost"))
+
+	_, actualReq := fakeNode.GetEventStreamArgsForCall(0)
+	g.Expect(actualReq.Filter).To(Equal(providedFilter))
+}
+
+func TestNilIfEmpty(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	/* act/assert */
+	g.Expect(nilIfEmpty([]string{})).To(BeNil())
+	g.Expect(nilIfEmpty([]string{"value"})).To(Equal([]string{"value"}))
+}
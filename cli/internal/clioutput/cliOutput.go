@@ -3,6 +3,7 @@ package clioutput
 import (
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 
 	"github.com/opctl/opctl/cli/internal/clicolorer"
@@ -90,6 +91,12 @@ func (this _cliOutput) Event(event *model.Event) {
 		event.CallStarted.Call.Container != nil:
 		this.containerStarted(event)
 
+	case event.CallReady != nil:
+		this.containerReady(event.CallReady)
+
+	case event.CallOutputsConflicted != nil:
+		this.callOutputsConflicted(event.CallOutputsConflicted)
+
 	case event.ContainerStdErrWrittenTo != nil:
 		this.containerStdErrWrittenTo(event.ContainerStdErrWrittenTo)
 
@@ -118,6 +125,10 @@ func (this _cliOutput) containerExited(event *model.Event) {
 		message = "killed"
 		color = this.cliColorer.Info
 		writer = this.stdWriter
+	case model.OpOutcomeTimedOut:
+		message = "timed out"
+		color = this.cliColorer.Error
+		writer = this.errWriter
 	default:
 		message = "crashed"
 		color = this.cliColorer.Error
@@ -129,6 +140,12 @@ func (this _cliOutput) containerExited(event *model.Event) {
 	} else {
 		message += "unknown container " + message
 	}
+	if event.CallEnded.CacheHit {
+		message += " (cached)"
+	}
+	if event.CallEnded.WillRetry {
+		message += "; retrying"
+	}
 	message = color(message)
 
 	io.WriteString(
@@ -159,6 +176,44 @@ func (this _cliOutput) containerStarted(event *model.Event) {
 	)
 }
 
+func (this _cliOutput) containerReady(event *model.CallReady) {
+	io.WriteString(
+		this.stdWriter,
+		fmt.Sprintf(
+			"%s%s\n",
+			this.outputPrefix(event.CallID, event.OpRef),
+			this.cliColorer.Success("ready"),
+		),
+	)
+}
+
+func (this _cliOutput) callOutputsConflicted(event *model.CallOutputsConflicted) {
+	outputNames := make([]string, 0, len(event.CallLabelsByOutputName))
+	for outputName := range event.CallLabelsByOutputName {
+		outputNames = append(outputNames, outputName)
+	}
+	sort.Strings(outputNames)
+
+	conflicts := make([]string, 0, len(outputNames))
+	for _, outputName := range outputNames {
+		conflicts = append(
+			conflicts,
+			fmt.Sprintf("'%s' produced by calls '%s'", outputName, strings.Join(event.CallLabelsByOutputName[outputName], "', '")),
+		)
+	}
+
+	io.WriteString(
+		this.stdWriter,
+		fmt.Sprintf(
+			"%s%s\n",
+			this.outputPrefix(event.CallID, event.OpRef),
+			this.cliColorer.Error(
+				fmt.Sprintf("conflicting outputs; %s; last call's output kept", strings.Join(conflicts, "; ")),
+			),
+		),
+	)
+}
+
 func (this _cliOutput) outputPrefix(id, opRef string) string {
 	parts := []string{
 		fmt.Sprintf("%.8s", fmt.Sprintf("%-8s", id)),
@@ -205,12 +260,19 @@ func (this _cliOutput) opEnded(event *model.Event) {
 		message = "killed"
 		color = this.cliColorer.Info
 		writer = this.stdWriter
+	case model.OpOutcomeTimedOut:
+		message = "timed out"
+		color = this.cliColorer.Error
+		writer = this.errWriter
 	default:
 		message = "failed"
 		color = this.cliColorer.Error
 		writer = this.errWriter
 	}
 
+	if event.CallEnded.WillRetry {
+		message += "; retrying"
+	}
 	message = color(fmt.Sprintf("op %s", message))
 	if event.CallEnded.Error != nil {
 		message += color(":") + " " + event.CallEnded.Error.Message
@@ -87,6 +87,63 @@ var _ = Context("output", func() {
 		})
 	})
 	Context("Event", func() {
+		Context("CallReady", func() {
+			It("should call stdWriter w/ expected args", func() {
+				/* arrange */
+				providedEvent := &model.Event{
+					CallReady: &model.CallReady{
+						CallID:      "acontainerid",
+						ContainerID: "acontainerid",
+					},
+					Timestamp: time.Now(),
+				}
+				expectedWriteArg := "\x1b[2m[acontain]\x1b[0m " + _cliColorer.Success("ready") + "\n"
+
+				fakeStdWriter := new(fakeWriter)
+				objectUnderTest := New(
+					_cliColorer,
+					new(fakeWriter),
+					fakeStdWriter,
+				)
+
+				/* act */
+				objectUnderTest.Event(providedEvent)
+
+				/* assert */
+				Expect(string(fakeStdWriter.WriteArgsForCall(0))).
+					To(Equal(expectedWriteArg))
+			})
+		})
+		Context("CallOutputsConflicted", func() {
+			It("should call stdWriter w/ expected args", func() {
+				/* arrange */
+				providedEvent := &model.Event{
+					CallOutputsConflicted: &model.CallOutputsConflicted{
+						CallID: "acallid",
+						CallLabelsByOutputName: map[string][]string{
+							"b": {"#0", "#1"},
+							"a": {"build", "test"},
+						},
+					},
+					Timestamp: time.Now(),
+				}
+				expectedWriteArg := "\x1b[2m[acallid ]\x1b[0m " + _cliColorer.Error("conflicting outputs; 'a' produced by calls 'build', 'test'; 'b' produced by calls '#0', '#1'; last call's output kept") + "\n"
+
+				fakeStdWriter := new(fakeWriter)
+				objectUnderTest := New(
+					_cliColorer,
+					new(fakeWriter),
+					fakeStdWriter,
+				)
+
+				/* act */
+				objectUnderTest.Event(providedEvent)
+
+				/* assert */
+				Expect(string(fakeStdWriter.WriteArgsForCall(0))).
+					To(Equal(expectedWriteArg))
+			})
+		})
 		Context("ContainerStdErrWrittenTo", func() {
 			It("should call stdWriter w/ expected args", func() {
 				/* arrange */
@@ -178,6 +235,43 @@ var _ = Context("output", func() {
 						To(Equal(expectedWriteArg))
 				})
 			})
+			Context("CacheHit", func() {
+				It("should call stdWriter w/ expected args", func() {
+					/* arrange */
+					imageRef := "imageRef"
+					providedEvent := &model.Event{
+						CallEnded: &model.CallEnded{
+							CacheHit: true,
+							Call: model.Call{
+								Container: &model.ContainerCall{
+									Image: &model.ContainerCallImage{
+										Ref: &imageRef,
+									},
+								},
+								ID: "acontainerID",
+							},
+							Outcome: model.OpOutcomeSucceeded,
+							Ref:     "ref",
+						},
+						Timestamp: time.Now(),
+					}
+					expectedWriteArg := "\x1b[2m[acontain ref]\x1b[0m \x1b[92;1mimageRef exited (cached)\x1b[0m\n"
+
+					fakeStdWriter := new(fakeWriter)
+					objectUnderTest := New(
+						_cliColorer,
+						new(fakeWriter),
+						fakeStdWriter,
+					)
+
+					/* act */
+					objectUnderTest.Event(providedEvent)
+
+					/* assert */
+					Expect(string(fakeStdWriter.WriteArgsForCall(0))).
+						To(Equal(expectedWriteArg))
+				})
+			})
 			Context("Call.Op truthy", func() {
 
 				Context("Outcome==FAILED", func() {
@@ -288,6 +382,77 @@ var _ = Context("output", func() {
 							To(Equal(expectedWriteArg))
 					})
 				})
+				Context("Outcome==TIMED_OUT", func() {
+					It("should call errWriter w/ expected args", func() {
+						/* arrange */
+						providedEvent := &model.Event{
+							CallEnded: &model.CallEnded{
+								Call: model.Call{
+									ID: "thisisacallID",
+									Op: &model.OpCall{
+										BaseCall: model.BaseCall{
+											OpPath: "opPath",
+										},
+									},
+								},
+								Ref:     "ref",
+								Outcome: "TIMED_OUT",
+							},
+							Timestamp: time.Now(),
+						}
+						expectedWriteArg := "\x1b[2m[thisisac opPath]\x1b[0m \x1b[91;1mop timed out\x1b[0m\n"
+
+						fakeErrWriter := new(fakeWriter)
+						objectUnderTest := New(
+							_cliColorer,
+							fakeErrWriter,
+							new(fakeWriter),
+						)
+
+						/* act */
+						objectUnderTest.Event(providedEvent)
+
+						/* assert */
+						Expect(string(fakeErrWriter.WriteArgsForCall(0))).
+							To(Equal(expectedWriteArg))
+					})
+				})
+			})
+			Context("WillRetry", func() {
+				It("should call errWriter w/ expected args", func() {
+					/* arrange */
+					providedEvent := &model.Event{
+						CallEnded: &model.CallEnded{
+							Call: model.Call{
+								ID: "thisisacallID",
+								Op: &model.OpCall{
+									BaseCall: model.BaseCall{
+										OpPath: "opPath",
+									},
+								},
+							},
+							Ref:       "ref",
+							Outcome:   "FAILED",
+							WillRetry: true,
+						},
+						Timestamp: time.Now(),
+					}
+					expectedWriteArg := "\x1b[2m[thisisac opPath]\x1b[0m \x1b[91;1mop failed; retrying\x1b[0m\n"
+
+					fakeErrWriter := new(fakeWriter)
+					objectUnderTest := New(
+						_cliColorer,
+						fakeErrWriter,
+						new(fakeWriter),
+					)
+
+					/* act */
+					objectUnderTest.Event(providedEvent)
+
+					/* assert */
+					Expect(string(fakeErrWriter.WriteArgsForCall(0))).
+						To(Equal(expectedWriteArg))
+				})
 			})
 			Context("Error truthy", func() {
 				It("should call errWriter w/ expected args", func() {
@@ -0,0 +1,136 @@
+package clioutput
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+// OpResult is the result of a root op as output by a CliOutput returned from NewJSON
+type OpResult struct {
+	DurationSeconds float64               `json:"durationSeconds"`
+	Error           *model.CallEndedError `json:"error,omitempty"`
+	ID              string                `json:"id"`
+	Outcome         string                `json:"outcome"`
+	// format: name => value; file & dir values are host paths
+	Outputs map[string]interface{} `json:"outputs"`
+}
+
+// NewJSON returns a CliOutput which outputs a single JSON document (see OpResult) to stdWriter once the root op ends.
+// All other messages are output as plain text to errWriter so stdWriter remains machine readable.
+func NewJSON(
+	errWriter io.Writer,
+	stdWriter io.Writer,
+) CliOutput {
+	return &_jsonCliOutput{
+		errWriter: errWriter,
+		stdWriter: stdWriter,
+	}
+}
+
+type _jsonCliOutput struct {
+	errWriter io.Writer
+	stdWriter io.Writer
+	// rootCallStartTime is the time the root op started
+	rootCallStartTime time.Time
+}
+
+func (this *_jsonCliOutput) DisableColor() {
+	// output is never colored
+}
+
+func (this *_jsonCliOutput) Attention(s string) {
+	io.WriteString(this.errWriter, fmt.Sprintln(s))
+}
+
+func (this *_jsonCliOutput) Warning(s string) {
+	io.WriteString(this.errWriter, fmt.Sprintln(s))
+}
+
+func (this *_jsonCliOutput) Error(s string) {
+	io.WriteString(this.errWriter, fmt.Sprintln(s))
+}
+
+func (this *_jsonCliOutput) Event(event *model.Event) {
+	switch {
+	case event.CallStarted != nil && event.CallStarted.Call.ID == event.CallStarted.Call.RootID:
+		if this.rootCallStartTime.IsZero() {
+			// retried root ops keep the time of their first attempt
+			this.rootCallStartTime = event.Timestamp
+		}
+	case event.CallEnded != nil && event.CallEnded.Call.ID == event.CallEnded.Call.RootID && !event.CallEnded.WillRetry:
+		opResult := OpResult{
+			Error:   event.CallEnded.Error,
+			ID:      event.CallEnded.Call.ID,
+			Outcome: event.CallEnded.Outcome,
+			Outputs: map[string]interface{}{},
+		}
+
+		if !this.rootCallStartTime.IsZero() {
+			opResult.DurationSeconds = event.Timestamp.Sub(this.rootCallStartTime).Seconds()
+		}
+
+		for name, value := range event.CallEnded.Outputs {
+			opResult.Outputs[name] = toJSONValue(value)
+		}
+
+		if err := json.NewEncoder(this.stdWriter).Encode(opResult); err != nil {
+			this.Error(fmt.Sprintf("unable to encode result: %v", err))
+		}
+	}
+}
+
+func (this *_jsonCliOutput) Success(s string) {
+	io.WriteString(this.errWriter, fmt.Sprintln(s))
+}
+
+// toJSONValue returns the plain JSON representation of value; values nested in arrays & objects are unwrapped too
+func toJSONValue(value *model.Value) interface{} {
+	switch {
+	case value == nil:
+		return nil
+	case value.Array != nil:
+		return toJSONItem(*value.Array)
+	case value.Boolean != nil:
+		return *value.Boolean
+	case value.Dir != nil:
+		return *value.Dir
+	case value.File != nil:
+		return *value.File
+	case value.Number != nil:
+		return *value.Number
+	case value.Object != nil:
+		return toJSONItem(*value.Object)
+	case value.Socket != nil:
+		return *value.Socket
+	case value.String != nil:
+		return *value.String
+	}
+	return nil
+}
+
+// toJSONItem returns the plain JSON representation of an array item or object property
+func toJSONItem(item interface{}) interface{} {
+	switch typedItem := item.(type) {
+	case model.Value:
+		return toJSONValue(&typedItem)
+	case *model.Value:
+		return toJSONValue(typedItem)
+	case []interface{}:
+		items := make([]interface{}, len(typedItem))
+		for index, arrayItem := range typedItem {
+			items[index] = toJSONItem(arrayItem)
+		}
+		return items
+	case map[string]interface{}:
+		properties := make(map[string]interface{}, len(typedItem))
+		for name, property := range typedItem {
+			properties[name] = toJSONItem(property)
+		}
+		return properties
+	}
+	return item
+}
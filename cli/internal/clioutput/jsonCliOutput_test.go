@@ -0,0 +1,133 @@
+package clioutput
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+var _ = Context("jsonCliOutput", func() {
+	Context("Error", func() {
+		It("should write to errWriter only", func() {
+			/* arrange */
+			errWriter := new(bytes.Buffer)
+			stdWriter := new(bytes.Buffer)
+
+			objectUnderTest := NewJSON(errWriter, stdWriter)
+
+			/* act */
+			objectUnderTest.Error("dummyError")
+
+			/* assert */
+			Expect(errWriter.String()).To(Equal("dummyError\n"))
+			Expect(stdWriter.String()).To(BeEmpty())
+		})
+	})
+	Context("Event", func() {
+		It("should write expected result once root op ends", func() {
+			/* arrange */
+			stdWriter := new(bytes.Buffer)
+
+			objectUnderTest := NewJSON(new(bytes.Buffer), stdWriter)
+
+			rootCall := model.Call{
+				ID:     "rootID",
+				RootID: "rootID",
+			}
+			startTime := time.Now()
+			dirPath := "/dir"
+			str := "str"
+
+			expectedResult := OpResult{
+				DurationSeconds: 2,
+				Error:           &model.CallEndedError{Message: "dummyError"},
+				ID:              rootCall.ID,
+				Outcome:         model.OpOutcomeFailed,
+				Outputs: map[string]interface{}{
+					"dir": dirPath,
+					"str": str,
+				},
+			}
+
+			/* act */
+			objectUnderTest.Event(&model.Event{
+				CallStarted: &model.CallStarted{Call: rootCall},
+				Timestamp:   startTime,
+			})
+			objectUnderTest.Event(&model.Event{
+				CallEnded: &model.CallEnded{
+					Call:    model.Call{ID: "childID", RootID: rootCall.ID},
+					Outcome: model.OpOutcomeSucceeded,
+				},
+				Timestamp: startTime.Add(time.Second),
+			})
+
+			// nothing should be written until root op ends
+			Expect(stdWriter.String()).To(BeEmpty())
+
+			objectUnderTest.Event(&model.Event{
+				CallEnded: &model.CallEnded{
+					Call:    rootCall,
+					Error:   expectedResult.Error,
+					Outcome: model.OpOutcomeFailed,
+					Outputs: map[string]*model.Value{
+						"dir": {Dir: &dirPath},
+						"str": {String: &str},
+					},
+				},
+				Timestamp: startTime.Add(2 * time.Second),
+			})
+
+			/* assert */
+			actualResult := OpResult{}
+			if err := json.Unmarshal(stdWriter.Bytes(), &actualResult); err != nil {
+				panic(err)
+			}
+			Expect(actualResult).To(Equal(expectedResult))
+		})
+		Context("root op outputs array of objects", func() {
+			It("should write outputs as plain JSON", func() {
+				/* arrange */
+				stdWriter := new(bytes.Buffer)
+
+				objectUnderTest := NewJSON(new(bytes.Buffer), stdWriter)
+
+				rootCall := model.Call{
+					ID:     "rootID",
+					RootID: "rootID",
+				}
+				name1 := "name1"
+				name2 := "name2"
+				objects := []interface{}{
+					model.Value{Object: &map[string]interface{}{"name": name1}},
+					&model.Value{Object: &map[string]interface{}{"name": model.Value{String: &name2}}},
+				}
+
+				/* act */
+				objectUnderTest.Event(&model.Event{
+					CallEnded: &model.CallEnded{
+						Call:    rootCall,
+						Outcome: model.OpOutcomeSucceeded,
+						Outputs: map[string]*model.Value{
+							"objects": {Array: &objects},
+						},
+					},
+				})
+
+				/* assert */
+				Expect(stdWriter.String()).To(MatchJSON(`{
+					"durationSeconds": 0,
+					"id": "rootID",
+					"outcome": "SUCCEEDED",
+					"outputs": {
+						"objects": [{"name": "name1"}, {"name": "name2"}]
+					}
+				}`))
+			})
+		})
+	})
+})
@@ -0,0 +1,80 @@
+package clioutput
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+// NewNDJSON returns a CliOutput which outputs each event as a line of JSON (see http://ndjson.org) to stdWriter.
+// All other messages are output as plain text to errWriter so stdWriter remains machine readable.
+func NewNDJSON(
+	errWriter io.Writer,
+	stdWriter io.Writer,
+) CliOutput {
+	return _ndjsonCliOutput{
+		errWriter: errWriter,
+		encoder:   json.NewEncoder(stdWriter),
+	}
+}
+
+type _ndjsonCliOutput struct {
+	errWriter io.Writer
+	encoder   *json.Encoder
+}
+
+func (this _ndjsonCliOutput) DisableColor() {
+	// output is never colored
+}
+
+func (this _ndjsonCliOutput) Attention(s string) {
+	io.WriteString(this.errWriter, fmt.Sprintln(s))
+}
+
+func (this _ndjsonCliOutput) Warning(s string) {
+	io.WriteString(this.errWriter, fmt.Sprintln(s))
+}
+
+func (this _ndjsonCliOutput) Error(s string) {
+	io.WriteString(this.errWriter, fmt.Sprintln(s))
+}
+
+func (this _ndjsonCliOutput) Event(event *model.Event) {
+	if event.CallEnded != nil && len(event.CallEnded.Outputs) > 0 {
+		// copy so values nested in array & object outputs are encoded as plain JSON w/out mutating the event
+		callEnded := *event.CallEnded
+		callEnded.Outputs = make(map[string]*model.Value, len(event.CallEnded.Outputs))
+		for name, value := range event.CallEnded.Outputs {
+			callEnded.Outputs[name] = toPlainItemsValue(value)
+		}
+
+		eventCopy := *event
+		eventCopy.CallEnded = &callEnded
+		event = &eventCopy
+	}
+
+	if err := this.encoder.Encode(event); err != nil {
+		this.Error(fmt.Sprintf("unable to encode event: %v", err))
+	}
+}
+
+func (this _ndjsonCliOutput) Success(s string) {
+	io.WriteString(this.errWriter, fmt.Sprintln(s))
+}
+
+// toPlainItemsValue returns value w/ the items of arrays & properties of objects converted to plain JSON
+func toPlainItemsValue(value *model.Value) *model.Value {
+	switch {
+	case value == nil:
+		return nil
+	case value.Array != nil:
+		items, _ := toJSONItem(*value.Array).([]interface{})
+		return &model.Value{Array: &items}
+	case value.Object != nil:
+		properties, _ := toJSONItem(*value.Object).(map[string]interface{})
+		return &model.Value{Object: &properties}
+	}
+	return value
+}
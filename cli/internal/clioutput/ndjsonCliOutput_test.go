@@ -0,0 +1,92 @@
+package clioutput
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+var _ = Context("ndjsonCliOutput", func() {
+	Context("Event", func() {
+		It("should write a line per event", func() {
+			/* arrange */
+			stdWriter := new(bytes.Buffer)
+
+			objectUnderTest := NewNDJSON(new(bytes.Buffer), stdWriter)
+
+			timestamp := time.Now().UTC()
+			expectedEvents := []model.Event{
+				{
+					CallStarted: &model.CallStarted{Call: model.Call{ID: "id", RootID: "id"}},
+					Timestamp:   timestamp,
+				},
+				{
+					ContainerStdOutWrittenTo: &model.ContainerStdOutWrittenTo{Data: []byte("data")},
+					Timestamp:                timestamp,
+				},
+			}
+
+			/* act */
+			for _, event := range expectedEvents {
+				event := event
+				objectUnderTest.Event(&event)
+			}
+
+			/* assert */
+			actualEvents := []model.Event{}
+			scanner := bufio.NewScanner(stdWriter)
+			for scanner.Scan() {
+				actualEvent := model.Event{}
+				if err := json.Unmarshal(scanner.Bytes(), &actualEvent); err != nil {
+					panic(err)
+				}
+				actualEvents = append(actualEvents, actualEvent)
+			}
+			Expect(actualEvents).To(Equal(expectedEvents))
+		})
+		Context("CallEnded outputs array of objects", func() {
+			It("should write array items as plain JSON", func() {
+				/* arrange */
+				stdWriter := new(bytes.Buffer)
+
+				objectUnderTest := NewNDJSON(new(bytes.Buffer), stdWriter)
+
+				name := "name"
+				objects := []interface{}{
+					model.Value{Object: &map[string]interface{}{"name": model.Value{String: &name}}},
+				}
+				providedEvent := &model.Event{
+					CallEnded: &model.CallEnded{
+						Call:    model.Call{ID: "id", RootID: "id"},
+						Outcome: model.OpOutcomeSucceeded,
+						Outputs: map[string]*model.Value{
+							"objects": {Array: &objects},
+						},
+					},
+				}
+
+				/* act */
+				objectUnderTest.Event(providedEvent)
+
+				/* assert */
+				actualEvent := map[string]interface{}{}
+				if err := json.Unmarshal(stdWriter.Bytes(), &actualEvent); err != nil {
+					panic(err)
+				}
+				actualOutputs := actualEvent["callEnded"].(map[string]interface{})["outputs"]
+				Expect(actualOutputs).To(Equal(map[string]interface{}{
+					"objects": map[string]interface{}{
+						"array": []interface{}{
+							map[string]interface{}{"name": "name"},
+						},
+					},
+				}))
+			})
+		})
+	})
+})
@@ -41,12 +41,7 @@ func (np nodeProvider) CreateNodeIfNotExists(ctx context.Context) (node.Node, er
 
 	nodeCmd := exec.Command(
 		pathToOpctlBin,
-		"--data-dir",
-		np.dataDir.Path(),
-		"--listen-address",
-		np.listenAddress,
-		"node",
-		"create",
+		np.getNodeCreateArgs()...,
 	)
 
 	// don't inherit env; some things like jenkins track and kill processes via injecting env vars
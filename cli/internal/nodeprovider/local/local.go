@@ -1,6 +1,8 @@
 package local
 
 import (
+	"strconv"
+
 	"github.com/golang-utils/lockfile"
 	"github.com/opctl/opctl/cli/internal/datadir"
 	"github.com/opctl/opctl/cli/internal/nodeprovider"
@@ -8,11 +10,21 @@ import (
 
 // NodeCreateOpts are options for creating a local opctl node
 type NodeCreateOpts struct {
+	// ContainerPrivilegedByDefault sets if containers which don't explicitly set privileged are run privileged
+	ContainerPrivilegedByDefault bool
 	// DataDir sets the path of dir used to store node data
 	DataDir string
+	// EventRetentionMaxAge sets the max duration events of ended root ops are retained; empty disables the limit
+	EventRetentionMaxAge string
+	// EventRetentionMaxRootOps sets the max number of ended root ops events are retained for; zero disables the limit
+	EventRetentionMaxRootOps int
+	// EventRetentionMaxSizeBytes sets the max total size of events retained; zero disables the limit
+	EventRetentionMaxSizeBytes int
 	// ListenAddress sets the HOST:PORT on which the node will listen
-	ListenAddress    string
-	ContainerRuntime string
+	ListenAddress string
+	// ParallelOutputConflicts sets how parallel calls handle more than one child producing the same output; fail or warn
+	ParallelOutputConflicts string
+	ContainerRuntime        string
 }
 
 // New returns an initialized "local" node provider
@@ -28,6 +40,7 @@ func New(
 		dataDir:       dataDir,
 		listenAddress: opts.ListenAddress,
 		lockfile:      lockfile.New(),
+		opts:          opts,
 	}
 }
 
@@ -35,4 +48,33 @@ type nodeProvider struct {
 	dataDir       datadir.DataDir
 	listenAddress string
 	lockfile      lockfile.LockFile
+	opts          NodeCreateOpts
+}
+
+// getNodeCreateArgs gets args for creating a node w/ the opctl binary
+func (np nodeProvider) getNodeCreateArgs() []string {
+	args := []string{
+		"--data-dir",
+		np.dataDir.Path(),
+		"--listen-address",
+		np.listenAddress,
+	}
+
+	if np.opts.ContainerPrivilegedByDefault {
+		args = append(args, "--container-privileged-by-default")
+	}
+	if np.opts.EventRetentionMaxAge != "" {
+		args = append(args, "--event-retention-max-age", np.opts.EventRetentionMaxAge)
+	}
+	if np.opts.EventRetentionMaxRootOps != 0 {
+		args = append(args, "--event-retention-max-root-ops", strconv.Itoa(np.opts.EventRetentionMaxRootOps))
+	}
+	if np.opts.EventRetentionMaxSizeBytes != 0 {
+		args = append(args, "--event-retention-max-size-bytes", strconv.Itoa(np.opts.EventRetentionMaxSizeBytes))
+	}
+	if np.opts.ParallelOutputConflicts != "" {
+		args = append(args, "--parallel-output-conflicts", np.opts.ParallelOutputConflicts)
+	}
+
+	return append(args, "node", "create")
 }
@@ -21,6 +21,8 @@ type callGraphNode struct {
 	startTime *time.Time
 	endTime   *time.Time
 	state     string
+	cacheHit  bool
+	isReady   bool
 	children  []*callGraphNode
 }
 
@@ -36,6 +38,9 @@ var errNotFoundInGraph = errors.New("not found in graph")
 
 const skippedState = "skipped"
 
+// state of calls waiting on the calls they're after
+const pendingState = "pending"
+
 func (n *callGraphNode) insert(call *model.Call, startTime time.Time, initialState string) error {
 	if call.ParentID == nil {
 		return fmt.Errorf("missing parent ID for %s", call.ID)
@@ -101,8 +106,12 @@ func (n callGraphNode) String(loader LoadingSpinner, now time.Time, collapseComp
 		str.WriteString(failed.Sprint(" ⚠"))
 	case model.OpOutcomeKilled:
 		str.WriteString("️ ☒")
-	case skippedState:
+	case model.OpOutcomeTimedOut:
+		str.WriteString(failed.Sprint(" ⧗"))
+	case skippedState, model.OpOutcomeSkipped:
 		str.WriteString(" ☐")
+	case pendingState:
+		str.WriteString(" ⏸")
 	case "":
 		// only display loading spinner on leaf nodes
 		if n.isLeaf() {
@@ -138,6 +147,8 @@ func (n callGraphNode) String(loader LoadingSpinner, now time.Time, collapseComp
 		desc = "serial"
 	} else if call.SerialLoop != nil {
 		desc = "serial loop"
+	} else if call.Switch != nil {
+		desc = "switch"
 	}
 
 	collapsed := n.state == model.OpOutcomeSucceeded && !n.isLeaf() && collapseCompleted
@@ -161,8 +172,35 @@ func (n callGraphNode) String(loader LoadingSpinner, now time.Time, collapseComp
 		str.WriteString(" " + desc)
 	}
 
+	// Waiting on calls it's after or queued due to max concurrency; skipped due to calls it's after
+	switch n.state {
+	case pendingState:
+		if len(call.After) == 0 {
+			str.WriteString(" " + muted.Sprint("queued"))
+		} else {
+			str.WriteString(" " + muted.Sprintf("pending after %s", strings.Join(call.After, ", ")))
+		}
+	case model.OpOutcomeSkipped:
+		str.WriteString(" " + muted.Sprint("skipped"))
+	}
+
+	// Retry attempt
+	if call.Attempt != nil {
+		str.WriteString(" " + muted.Sprintf("attempt %d/%d", call.Attempt.Number, call.Attempt.Max))
+	}
+
+	// Results replayed from cache
+	if n.cacheHit {
+		str.WriteString(" " + muted.Sprint("cached"))
+	}
+
+	// Passed its readiness probe & still running
+	if n.isReady && n.endTime == nil {
+		str.WriteString(" " + success.Sprint("ready"))
+	}
+
 	// Time elapsed
-	if n.startTime != nil && n.state != skippedState {
+	if n.startTime != nil && n.state != skippedState && n.state != model.OpOutcomeSkipped && n.state != pendingState {
 		if n.endTime != nil { // if done
 			str.WriteString(" " + n.endTime.Sub(*n.startTime).String())
 		} else if n.isLeaf() { // only display live time for leaf nodes, like loading spinner
@@ -225,7 +263,29 @@ func (g CallGraph) String(loader LoadingSpinner, now time.Time, collapseComplete
 
 // HandleEvent accepts an opctl event and updates the call graph appropriately
 func (g *CallGraph) HandleEvent(event *model.Event) error {
-	if event.CallStarted != nil {
+	if event.CallPending != nil {
+		if g.rootNode == nil {
+			return nil
+		}
+		if node := g.rootNode.find(&event.CallPending.Call); node != nil {
+			// a call pending after others may then be queued
+			node.call = &event.CallPending.Call
+			return nil
+		}
+		return g.rootNode.insert(&event.CallPending.Call, event.Timestamp, pendingState)
+	} else if event.CallStarted != nil {
+		if g.rootNode != nil {
+			// a pending or retried call starts w/ an ID already in the graph; reset its node
+			attempt := event.CallStarted.Call.Attempt
+			if node := g.rootNode.find(&event.CallStarted.Call); node != nil && (node.state == pendingState || attempt != nil && attempt.Number > 1) {
+				node.call = &event.CallStarted.Call
+				node.startTime = &event.Timestamp
+				node.endTime = nil
+				node.state = ""
+				node.children = []*callGraphNode{}
+				return nil
+			}
+		}
 		if event.CallStarted.Call.ParentID == nil {
 			if g.rootNode == nil {
 				g.rootNode = newCallGraphNode(&event.CallStarted.Call, event.Timestamp)
@@ -240,10 +300,22 @@ func (g *CallGraph) HandleEvent(event *model.Event) error {
 		}
 		node := g.rootNode.find(&event.CallEnded.Call)
 		if node == nil {
+			if event.CallEnded.Outcome == model.OpOutcomeSkipped {
+				// e.g. branches of a switch which weren't called
+				return g.rootNode.insert(&event.CallEnded.Call, event.Timestamp, model.OpOutcomeSkipped)
+			}
 			return g.rootNode.insert(&event.CallEnded.Call, event.Timestamp, skippedState)
 		}
 		node.endTime = &event.Timestamp
 		node.state = event.CallEnded.Outcome
+		node.cacheHit = event.CallEnded.CacheHit
+	} else if event.CallReady != nil {
+		if g.rootNode == nil {
+			return nil
+		}
+		if node := g.rootNode.find(&model.Call{ID: event.CallReady.CallID}); node != nil {
+			node.isReady = true
+		}
 	}
 	return nil
 }
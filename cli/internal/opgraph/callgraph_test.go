@@ -429,3 +429,390 @@ func TestCallGraph(t *testing.T) {
 ⚠️  this should show up as a warning`
 	g.Expect(expandedStr).To(Equal(expandedCollapsedStr))
 }
+
+func TestCallGraphRetry(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	/* arrange */
+	timestamp, err := time.Parse("Jan 2, 2006 at 3:04pm (MST)", "Feb 4, 2014 at 6:05pm (PST)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	objectUnderTest := CallGraph{}
+	parentID := "parentID"
+	childID := "childID"
+	containerRef := "containerRef"
+	newChildCall := func(attemptNumber int) model.Call {
+		return model.Call{
+			Attempt: &model.CallAttempt{
+				Number: attemptNumber,
+				Max:    3,
+			},
+			ID:       childID,
+			ParentID: &parentID,
+			Container: &model.ContainerCall{
+				ContainerID: "id1234567890",
+				Image: &model.ContainerCallImage{
+					Ref: &containerRef,
+				},
+			},
+		}
+	}
+
+	/* act */
+	objectUnderTest.HandleEvent(&model.Event{
+		CallStarted: &model.CallStarted{
+			Call: model.Call{
+				ID:     parentID,
+				Serial: []*model.CallSpec{},
+			},
+		},
+		Timestamp: timestamp,
+	})
+	objectUnderTest.HandleEvent(&model.Event{
+		CallStarted: &model.CallStarted{
+			Call: newChildCall(1),
+		},
+		Timestamp: timestamp,
+	})
+	objectUnderTest.HandleEvent(&model.Event{
+		CallEnded: &model.CallEnded{
+			Call:      newChildCall(1),
+			Outcome:   model.OpOutcomeFailed,
+			WillRetry: true,
+		},
+		Timestamp: timestamp.Add(time.Second * 5),
+	})
+	objectUnderTest.HandleEvent(&model.Event{
+		CallStarted: &model.CallStarted{
+			Call: newChildCall(2),
+		},
+		Timestamp: timestamp.Add(time.Second * 10),
+	})
+
+	/* assert */
+	// the newline is here just for better test code readability
+	actualStr := "\n" + objectUnderTest.String(
+		StaticLoadingSpinner{},
+		timestamp.Add(time.Second*30),
+		true,
+	)
+	g.Expect(actualStr).To(Equal(`
+◎ serial
+└─◉ ⋰ id123456 containerRef attempt 2/3 20s`))
+}
+
+func TestCallGraphReady(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	/* arrange */
+	timestamp, err := time.Parse("Jan 2, 2006 at 3:04pm (MST)", "Feb 4, 2014 at 6:05pm (PST)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	objectUnderTest := CallGraph{}
+	parentID := "parentID"
+	childID := "childID"
+	containerRef := "containerRef"
+
+	/* act */
+	objectUnderTest.HandleEvent(&model.Event{
+		CallStarted: &model.CallStarted{
+			Call: model.Call{
+				ID:       parentID,
+				Parallel: []*model.CallSpec{},
+			},
+		},
+		Timestamp: timestamp,
+	})
+	objectUnderTest.HandleEvent(&model.Event{
+		CallStarted: &model.CallStarted{
+			Call: model.Call{
+				ID:       childID,
+				ParentID: &parentID,
+				Container: &model.ContainerCall{
+					ContainerID: "id1234567890",
+					Image: &model.ContainerCallImage{
+						Ref: &containerRef,
+					},
+				},
+			},
+		},
+		Timestamp: timestamp,
+	})
+	objectUnderTest.HandleEvent(&model.Event{
+		CallReady: &model.CallReady{
+			CallID:      childID,
+			ContainerID: childID,
+		},
+		Timestamp: timestamp.Add(time.Second * 5),
+	})
+
+	/* assert */
+	// the newline is here just for better test code readability
+	actualStr := "\n" + objectUnderTest.String(
+		StaticLoadingSpinner{},
+		timestamp.Add(time.Second*30),
+		true,
+	)
+	g.Expect(actualStr).To(Equal(`
+◎ parallel
+└─◉ ⋰ id123456 containerRef ready 30s`))
+}
+
+func TestCallGraphAfter(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	/* arrange */
+	timestamp, err := time.Parse("Jan 2, 2006 at 3:04pm (MST)", "Feb 4, 2014 at 6:05pm (PST)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	objectUnderTest := CallGraph{}
+	parentID := "parentID"
+	firstName := "first"
+	secondName := "second"
+	thirdName := "third"
+	containerRef := "containerRef"
+	newChildCall := func(id string, name *string, after ...string) model.Call {
+		return model.Call{
+			After:    after,
+			ID:       id,
+			Name:     name,
+			ParentID: &parentID,
+		}
+	}
+
+	/* act */
+	objectUnderTest.HandleEvent(&model.Event{
+		CallStarted: &model.CallStarted{
+			Call: model.Call{
+				ID:       parentID,
+				Parallel: []*model.CallSpec{},
+			},
+		},
+		Timestamp: timestamp,
+	})
+	objectUnderTest.HandleEvent(&model.Event{
+		CallPending: &model.CallPending{
+			Call: newChildCall("secondID", &secondName, firstName),
+		},
+		Timestamp: timestamp,
+	})
+	objectUnderTest.HandleEvent(&model.Event{
+		CallPending: &model.CallPending{
+			Call: newChildCall("thirdID", &thirdName, firstName, secondName),
+		},
+		Timestamp: timestamp,
+	})
+
+	startedChildCall := newChildCall("secondID", &secondName, firstName)
+	startedChildCall.Container = &model.ContainerCall{
+		ContainerID: "id1234567890",
+		Image: &model.ContainerCallImage{
+			Ref: &containerRef,
+		},
+	}
+	objectUnderTest.HandleEvent(&model.Event{
+		CallStarted: &model.CallStarted{
+			Call: startedChildCall,
+		},
+		Timestamp: timestamp.Add(time.Second * 10),
+	})
+
+	/* assert */
+	// the newline is here just for better test code readability
+	actualStr := "\n" + objectUnderTest.String(
+		StaticLoadingSpinner{},
+		timestamp.Add(time.Second*30),
+		true,
+	)
+	g.Expect(actualStr).To(Equal(`
+◎ parallel
+├─◉ ⋰ second id123456 containerRef 20s
+└─◉ ⏸ third pending after first, second`))
+
+	/* act */
+	objectUnderTest.HandleEvent(&model.Event{
+		CallEnded: &model.CallEnded{
+			Call:    newChildCall("thirdID", &thirdName, firstName, secondName),
+			Outcome: model.OpOutcomeSkipped,
+		},
+		Timestamp: timestamp.Add(time.Second * 20),
+	})
+
+	/* assert */
+	actualStr = "\n" + objectUnderTest.String(
+		StaticLoadingSpinner{},
+		timestamp.Add(time.Second*30),
+		true,
+	)
+	g.Expect(actualStr).To(Equal(`
+◎ parallel
+├─◉ ⋰ second id123456 containerRef 20s
+└─◉ ☐ third skipped`))
+}
+
+func TestCallGraphSwitch(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	/* arrange */
+	timestamp, err := time.Parse("Jan 2, 2006 at 3:04pm (MST)", "Feb 4, 2014 at 6:05pm (PST)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	objectUnderTest := CallGraph{}
+	parentID := "parentID"
+	prodName := "prod"
+	stagingName := "staging"
+	containerRef := "containerRef"
+
+	/* act */
+	objectUnderTest.HandleEvent(&model.Event{
+		CallStarted: &model.CallStarted{
+			Call: model.Call{
+				ID:     parentID,
+				Switch: &model.SwitchCall{},
+			},
+		},
+		Timestamp: timestamp,
+	})
+	objectUnderTest.HandleEvent(&model.Event{
+		CallEnded: &model.CallEnded{
+			Call: model.Call{
+				ID:       "prodID",
+				Name:     &prodName,
+				ParentID: &parentID,
+			},
+			Outcome: model.OpOutcomeSkipped,
+		},
+		Timestamp: timestamp,
+	})
+	objectUnderTest.HandleEvent(&model.Event{
+		CallStarted: &model.CallStarted{
+			Call: model.Call{
+				Container: &model.ContainerCall{
+					ContainerID: "id1234567890",
+					Image: &model.ContainerCallImage{
+						Ref: &containerRef,
+					},
+				},
+				ID:       "stagingID",
+				Name:     &stagingName,
+				ParentID: &parentID,
+			},
+		},
+		Timestamp: timestamp.Add(time.Second * 10),
+	})
+
+	/* assert */
+	// the newline is here just for better test code readability
+	actualStr := "\n" + objectUnderTest.String(
+		StaticLoadingSpinner{},
+		timestamp.Add(time.Second*30),
+		true,
+	)
+	g.Expect(actualStr).To(Equal(`
+◎ switch
+├─◉ ☐ prod skipped
+└─◉ ⋰ staging id123456 containerRef 20s`))
+}
+
+func TestCallGraphQueued(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	/* arrange */
+	timestamp, err := time.Parse("Jan 2, 2006 at 3:04pm (MST)", "Feb 4, 2014 at 6:05pm (PST)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	objectUnderTest := CallGraph{}
+	parentID := "parentID"
+	firstName := "first"
+	secondName := "second"
+	containerRef := "containerRef"
+
+	/* act */
+	objectUnderTest.HandleEvent(&model.Event{
+		CallStarted: &model.CallStarted{
+			Call: model.Call{
+				ID:       parentID,
+				Parallel: []*model.CallSpec{},
+			},
+		},
+		Timestamp: timestamp,
+	})
+	objectUnderTest.HandleEvent(&model.Event{
+		CallPending: &model.CallPending{
+			Call: model.Call{
+				After:    []string{firstName},
+				ID:       "secondID",
+				Name:     &secondName,
+				ParentID: &parentID,
+			},
+		},
+		Timestamp: timestamp,
+	})
+	objectUnderTest.HandleEvent(&model.Event{
+		CallPending: &model.CallPending{
+			Call: model.Call{
+				ID:       "firstID",
+				Name:     &firstName,
+				ParentID: &parentID,
+			},
+		},
+		Timestamp: timestamp,
+	})
+	// once the call it's after ends, the call is queued
+	objectUnderTest.HandleEvent(&model.Event{
+		CallPending: &model.CallPending{
+			Call: model.Call{
+				ID:       "secondID",
+				Name:     &secondName,
+				ParentID: &parentID,
+			},
+		},
+		Timestamp: timestamp,
+	})
+
+	/* assert */
+	// the newline is here just for better test code readability
+	actualStr := "\n" + objectUnderTest.String(
+		StaticLoadingSpinner{},
+		timestamp.Add(time.Second*30),
+		true,
+	)
+	g.Expect(actualStr).To(Equal(`
+◎ parallel
+├─◉ ⏸ second queued
+└─◉ ⏸ first queued`))
+
+	/* act */
+	objectUnderTest.HandleEvent(&model.Event{
+		CallStarted: &model.CallStarted{
+			Call: model.Call{
+				Container: &model.ContainerCall{
+					ContainerID: "id1234567890",
+					Image: &model.ContainerCallImage{
+						Ref: &containerRef,
+					},
+				},
+				ID:       "firstID",
+				Name:     &firstName,
+				ParentID: &parentID,
+			},
+		},
+		Timestamp: timestamp.Add(time.Second * 10),
+	})
+
+	/* assert */
+	actualStr = "\n" + objectUnderTest.String(
+		StaticLoadingSpinner{},
+		timestamp.Add(time.Second*30),
+		true,
+	)
+	g.Expect(actualStr).To(Equal(`
+◎ parallel
+├─◉ ⏸ second queued
+└─◉ ⋰ first id123456 containerRef 20s`))
+}
@@ -2,6 +2,8 @@ package main
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/opctl/opctl/cli/internal/datadir"
 	"github.com/opctl/opctl/cli/internal/nodeprovider/local"
@@ -9,6 +11,7 @@ import (
 	"github.com/opctl/opctl/sdks/go/node/core/containerruntime"
 	"github.com/opctl/opctl/sdks/go/node/core/containerruntime/docker"
 	"github.com/opctl/opctl/sdks/go/node/core/containerruntime/k8s"
+	"github.com/opctl/opctl/sdks/go/pubsub"
 )
 
 // node command
@@ -27,22 +30,40 @@ func node(
 
 	var containerRuntime containerruntime.ContainerRuntime
 	if "k8s" == nodeCreateOpts.ContainerRuntime {
-		containerRuntime, err = k8s.New()
+		containerRuntime, err = k8s.New(nodeCreateOpts.ContainerPrivilegedByDefault)
 		if err != nil {
 			return err
 		}
 	} else {
-		containerRuntime, err = docker.New(ctx)
+		containerRuntime, err = docker.New(ctx, nodeCreateOpts.ContainerPrivilegedByDefault)
 		if err != nil {
 			return err
 		}
 	}
 
+	eventRetentionPolicy := pubsub.RetentionPolicy{
+		MaxRootCalls: nodeCreateOpts.EventRetentionMaxRootOps,
+		MaxSizeBytes: int64(nodeCreateOpts.EventRetentionMaxSizeBytes),
+	}
+	if nodeCreateOpts.EventRetentionMaxAge != "" {
+		eventRetentionPolicy.MaxAge, err = time.ParseDuration(nodeCreateOpts.EventRetentionMaxAge)
+		if err != nil {
+			return fmt.Errorf("invalid event-retention-max-age: %w", err)
+		}
+	}
+
+	outputConflictPolicy := core.OutputConflictPolicy(nodeCreateOpts.ParallelOutputConflicts)
+	if outputConflictPolicy != core.OutputConflictPolicyFail && outputConflictPolicy != core.OutputConflictPolicyWarn {
+		return fmt.Errorf("invalid parallel-output-conflicts: must be %s or %s", core.OutputConflictPolicyFail, core.OutputConflictPolicyWarn)
+	}
+
 	return newHTTPListener(
 		core.New(
 			ctx,
 			containerRuntime,
 			dataDir.Path(),
+			eventRetentionPolicy,
+			outputConflictPolicy,
 		),
 	).
 		listen(
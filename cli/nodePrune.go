@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/opctl/opctl/cli/internal/nodeprovider"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+// nodePrune implements "node prune" command
+func nodePrune(
+	ctx context.Context,
+	nodeProvider nodeprovider.NodeProvider,
+	all bool,
+	olderThan string,
+	maxRootOps int,
+	maxSizeBytes int,
+) (string, error) {
+	isLimited := olderThan != "" || maxRootOps != 0 || maxSizeBytes != 0
+	if all && isLimited {
+		return "", errors.New("all can't be combined w/ older-than, max-root-ops or max-size-bytes")
+	} else if !all && !isLimited {
+		// pruning everything must be explicit
+		return "", errors.New("nothing to prune; provide all or any of older-than, max-root-ops & max-size-bytes")
+	}
+
+	req := model.PruneEventsReq{
+		All:          all,
+		MaxRootCalls: maxRootOps,
+		MaxSizeBytes: int64(maxSizeBytes),
+	}
+	if olderThan != "" {
+		olderThanDuration, err := time.ParseDuration(olderThan)
+		if err != nil {
+			return "", fmt.Errorf("invalid older-than: %w", err)
+		}
+
+		before := time.Now().UTC().Add(-olderThanDuration)
+		req.Before = &before
+	}
+
+	node, err := nodeProvider.CreateNodeIfNotExists(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	prunedCallIDs, err := node.PruneEvents(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("pruned events & scratch dirs of %d calls", len(prunedCallIDs)), nil
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	nodeproviderFakes "github.com/opctl/opctl/cli/internal/nodeprovider/fakes"
+	"github.com/opctl/opctl/sdks/go/model"
+	nodeFakes "github.com/opctl/opctl/sdks/go/node/fakes"
+)
+
+func TestNodePrune(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	/* arrange */
+	fakeNode := new(nodeFakes.FakeNode)
+	fakeNode.PruneEventsReturns([]string{"callID1", "callID2"}, nil)
+
+	fakeNodeProvider := new(nodeproviderFakes.FakeNodeProvider)
+	fakeNodeProvider.CreateNodeIfNotExistsReturns(fakeNode, nil)
+
+	/* act */
+	message, err := nodePrune(context.Background(), fakeNodeProvider, false, "", 5, 1024)
+
+	/* assert */
+	g.Expect(err).To(BeNil())
+	g.Expect(message).To(Equal("pruned events & scratch dirs of 2 calls"))
+
+	_, actualReq := fakeNode.PruneEventsArgsForCall(0)
+	g.Expect(actualReq).To(Equal(model.PruneEventsReq{MaxRootCalls: 5, MaxSizeBytes: 1024}))
+}
+
+func TestNodePruneInvalidOlderThan(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	/* arrange */
+	fakeNodeProvider := new(nodeproviderFakes.FakeNodeProvider)
+
+	/* act */
+	_, err := nodePrune(context.Background(), fakeNodeProvider, false, "notADuration", 0, 0)
+
+	/* assert */
+	g.Expect(err).To(MatchError(ContainSubstring("invalid older-than")))
+	g.Expect(fakeNodeProvider.CreateNodeIfNotExistsCallCount()).To(Equal(0))
+}
+
+func TestNodePruneAll(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	/* arrange */
+	fakeNode := new(nodeFakes.FakeNode)
+
+	fakeNodeProvider := new(nodeproviderFakes.FakeNodeProvider)
+	fakeNodeProvider.CreateNodeIfNotExistsReturns(fakeNode, nil)
+
+	/* act */
+	_, err := nodePrune(context.Background(), fakeNodeProvider, true, "", 0, 0)
+
+	/* assert */
+	g.Expect(err).To(BeNil())
+
+	_, actualReq := fakeNode.PruneEventsArgsForCall(0)
+	g.Expect(actualReq).To(Equal(model.PruneEventsReq{All: true}))
+}
+
+func TestNodePruneNoOptions(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	/* arrange */
+	fakeNodeProvider := new(nodeproviderFakes.FakeNodeProvider)
+
+	/* act */
+	_, err := nodePrune(context.Background(), fakeNodeProvider, false, "", 0, 0)
+
+	/* assert */
+	g.Expect(err).To(MatchError("nothing to prune; provide all or any of older-than, max-root-ops & max-size-bytes"))
+	g.Expect(fakeNodeProvider.CreateNodeIfNotExistsCallCount()).To(Equal(0))
+}
+
+func TestNodePruneAllWithLimits(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	/* arrange */
+	fakeNodeProvider := new(nodeproviderFakes.FakeNodeProvider)
+
+	/* act */
+	_, err := nodePrune(context.Background(), fakeNodeProvider, true, "24h", 0, 0)
+
+	/* assert */
+	g.Expect(err).To(MatchError("all can't be combined w/ older-than, max-root-ops or max-size-bytes"))
+	g.Expect(fakeNodeProvider.CreateNodeIfNotExistsCallCount()).To(Equal(0))
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opctl/opctl/cli/internal/clioutput"
+	"github.com/opctl/opctl/cli/internal/nodeprovider"
+)
+
+// opAttach implements "op attach" command
+func opAttach(
+	ctx context.Context,
+	cliOutput clioutput.CliOutput,
+	nodeProvider nodeprovider.NodeProvider,
+	rootCallID string,
+	disableGraph bool,
+	kill bool,
+) error {
+	node, err := nodeProvider.CreateNodeIfNotExists(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := node.GetOp(ctx, rootCallID); err != nil {
+		return fmt.Errorf("unable to attach to op '%s': %w", rootCallID, err)
+	}
+
+	// replay events of the op from its start
+	return watchOp(
+		ctx,
+		cliOutput,
+		node,
+		rootCallID,
+		nil,
+		disableGraph,
+		false,
+		kill,
+		false,
+	)
+}
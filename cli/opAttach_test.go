@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/cli/internal/clicolorer"
+	"github.com/opctl/opctl/cli/internal/clioutput"
+	nodeproviderFakes "github.com/opctl/opctl/cli/internal/nodeprovider/fakes"
+	"github.com/opctl/opctl/sdks/go/model"
+	nodeFakes "github.com/opctl/opctl/sdks/go/node/fakes"
+)
+
+func TestOpAttach(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	/* arrange */
+	rootCallID := "rootCallID"
+
+	eventChannel := make(chan model.Event, 1)
+	eventChannel <- model.Event{
+		CallEnded: &model.CallEnded{
+			Call: model.Call{
+				ID:     rootCallID,
+				RootID: rootCallID,
+			},
+			Outcome: model.OpOutcomeFailed,
+		},
+	}
+
+	fakeNode := new(nodeFakes.FakeNode)
+	fakeNode.GetOpReturns(&model.OpSummary{ID: rootCallID}, nil)
+	fakeNode.GetEventStreamReturns(eventChannel, nil)
+
+	fakeNodeProvider := new(nodeproviderFakes.FakeNodeProvider)
+	fakeNodeProvider.CreateNodeIfNotExistsReturns(fakeNode, nil)
+
+	/* act */
+	err := opAttach(
+		context.Background(),
+		clioutput.New(clicolorer.New(), ioutil.Discard, ioutil.Discard),
+		fakeNodeProvider,
+		rootCallID,
+		true,
+		false,
+	)
+
+	/* assert */
+	g.Expect(err).To(Equal(&RunError{ExitCode: 1}))
+
+	_, actualReq := fakeNode.GetEventStreamArgsForCall(0)
+	g.Expect(actualReq.Filter).To(Equal(model.EventFilter{Roots: []string{rootCallID}}))
+	g.Expect(fakeNode.KillOpCallCount()).To(Equal(0))
+}
+
+func TestOpAttachOpNotFound(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	/* arrange */
+	fakeNode := new(nodeFakes.FakeNode)
+	fakeNode.GetOpReturns(nil, model.ErrOpNotFound{})
+
+	fakeNodeProvider := new(nodeproviderFakes.FakeNodeProvider)
+	fakeNodeProvider.CreateNodeIfNotExistsReturns(fakeNode, nil)
+
+	/* act */
+	err := opAttach(
+		context.Background(),
+		clioutput.New(clicolorer.New(), ioutil.Discard, ioutil.Discard),
+		fakeNodeProvider,
+		"rootCallID",
+		true,
+		false,
+	)
+
+	/* assert */
+	g.Expect(err).To(MatchError(model.ErrOpNotFound{}))
+	g.Expect(fakeNode.GetEventStreamCallCount()).To(Equal(0))
+}
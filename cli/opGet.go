@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/opctl/opctl/cli/internal/nodeprovider"
+)
+
+// opGet implements "op get" command
+func opGet(
+	ctx context.Context,
+	nodeProvider nodeprovider.NodeProvider,
+	opID string,
+	writer io.Writer,
+) error {
+	node, err := nodeProvider.CreateNodeIfNotExists(ctx)
+	if err != nil {
+		return err
+	}
+
+	opSummary, err := node.GetOp(ctx, opID)
+	if err != nil {
+		return err
+	}
+
+	opSummaryBytes, err := json.MarshalIndent(opSummary, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(writer, string(opSummaryBytes))
+	return err
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/opctl/opctl/cli/internal/nodeprovider"
+)
+
+// opLs implements "op ls" command
+func opLs(
+	ctx context.Context,
+	nodeProvider nodeprovider.NodeProvider,
+	writer io.Writer,
+) error {
+	node, err := nodeProvider.CreateNodeIfNotExists(ctx)
+	if err != nil {
+		return err
+	}
+
+	opSummaries, err := node.ListOps(ctx)
+	if err != nil {
+		return err
+	}
+
+	_tabWriter := new(tabwriter.Writer)
+	defer _tabWriter.Flush()
+	_tabWriter.Init(writer, 0, 8, 1, '\t', 0)
+
+	fmt.Fprintln(_tabWriter, "ID\tREF\tSTARTED\tENDED\tOUTCOME")
+
+	for _, opSummary := range opSummaries {
+		endTime := "-"
+		if opSummary.EndTime != nil {
+			endTime = opSummary.EndTime.Local().Format(time.RFC3339)
+		}
+
+		outcome := "RUNNING"
+		if opSummary.Outcome != "" {
+			outcome = opSummary.Outcome
+		}
+
+		fmt.Fprintf(
+			_tabWriter,
+			"%s\t%s\t%s\t%s\t%s\n",
+			opSummary.ID,
+			opSummary.Ref,
+			opSummary.StartTime.Local().Format(time.RFC3339),
+			endTime,
+			outcome,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	nodeproviderFakes "github.com/opctl/opctl/cli/internal/nodeprovider/fakes"
+	"github.com/opctl/opctl/sdks/go/model"
+	nodeFakes "github.com/opctl/opctl/sdks/go/node/fakes"
+)
+
+func TestOpLs(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	/* arrange */
+	startTime := time.Now()
+	endTime := startTime.Add(time.Minute)
+
+	fakeNode := new(nodeFakes.FakeNode)
+	fakeNode.ListOpsReturns(
+		[]*model.OpSummary{
+			{
+				ID:        "id2",
+				Ref:       "op2",
+				StartTime: startTime,
+			},
+			{
+				EndTime:   &endTime,
+				ID:        "id1",
+				Outcome:   model.OpOutcomeSucceeded,
+				Ref:       "op1",
+				StartTime: startTime,
+			},
+		},
+		nil,
+	)
+
+	fakeNodeProvider := new(nodeproviderFakes.FakeNodeProvider)
+	fakeNodeProvider.CreateNodeIfNotExistsReturns(fakeNode, nil)
+
+	output := new(bytes.Buffer)
+
+	/* act */
+	err := opLs(context.Background(), fakeNodeProvider, output)
+
+	/* assert */
+	g.Expect(err).To(BeNil())
+	g.Expect(output.String()).To(HavePrefix("ID\t"))
+	formattedStartTime := regexp.QuoteMeta(startTime.Local().Format(time.RFC3339))
+	formattedEndTime := regexp.QuoteMeta(endTime.Local().Format(time.RFC3339))
+	g.Expect(output.String()).To(MatchRegexp("\nid2\top2\t" + formattedStartTime + "\t+-\t+RUNNING\n"))
+	g.Expect(output.String()).To(MatchRegexp("\nid1\top1\t" + formattedStartTime + "\t+" + formattedEndTime + "\t+SUCCEEDED\n"))
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/opctl/opctl/cli/internal/dataresolver"
 	"github.com/opctl/opctl/sdks/go/opspec"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call"
+	"github.com/opctl/opctl/sdks/go/opspec/opfile"
 )
 
 func opValidate(
@@ -21,8 +23,21 @@ func opValidate(
 		return err
 	}
 
-	return opspec.Validate(
+	if err := opspec.Validate(
+		ctx,
+		*opDirHandle.Path(),
+	); err != nil {
+		return err
+	}
+
+	// report problems w/ calls known before run time too
+	opFile, err := opfile.Get(
 		ctx,
 		*opDirHandle.Path(),
 	)
+	if err != nil {
+		return err
+	}
+
+	return call.Validate(opFile.Run)
 }
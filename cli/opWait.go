@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/opctl/opctl/cli/internal/nodeprovider"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+// opWait implements "op wait" command
+func opWait(
+	ctx context.Context,
+	nodeProvider nodeprovider.NodeProvider,
+	rootCallID string,
+	timeout string,
+) error {
+	var timeoutDuration time.Duration
+	if timeout != "" {
+		var err error
+		timeoutDuration, err = time.ParseDuration(timeout)
+		if err != nil {
+			return fmt.Errorf("invalid timeout: %w", err)
+		}
+	}
+
+	node, err := nodeProvider.CreateNodeIfNotExists(ctx)
+	if err != nil {
+		return err
+	}
+
+	opSummary, err := node.GetOp(ctx, rootCallID)
+	if err != nil {
+		return fmt.Errorf("unable to wait on op '%s': %w", rootCallID, err)
+	}
+
+	if opSummary.Outcome != "" {
+		// already ended
+		return newOutcomeRunError(opSummary.Outcome)
+	}
+
+	// time spent creating the node doesn't count against the timeout
+	if timeout != "" {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeoutDuration)
+		defer cancel()
+	}
+
+	eventChannel, err := node.GetEventStream(
+		ctx,
+		&model.GetEventStreamReq{
+			Filter: model.EventFilter{
+				Kinds: []string{model.EventKindCallEnded},
+				Roots: []string{rootCallID},
+			},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("error getting event stream: %w", err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting on op '%s'", rootCallID)
+		case event, isEventChannelOpen := <-eventChannel:
+			if !isEventChannelOpen {
+				if ctx.Err() != nil {
+					return fmt.Errorf("timed out waiting on op '%s'", rootCallID)
+				}
+				return errors.New("Event channel closed unexpectedly")
+			}
+
+			if event.CallEnded != nil && event.CallEnded.Call.ID == rootCallID {
+				return newOutcomeRunError(event.CallEnded.Outcome)
+			}
+		}
+	}
+}
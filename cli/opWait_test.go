@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	nodeproviderFakes "github.com/opctl/opctl/cli/internal/nodeprovider/fakes"
+	"github.com/opctl/opctl/sdks/go/model"
+	sdknode "github.com/opctl/opctl/sdks/go/node"
+	nodeFakes "github.com/opctl/opctl/sdks/go/node/fakes"
+)
+
+func TestOpWait(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	/* arrange */
+	rootCallID := "rootCallID"
+
+	eventChannel := make(chan model.Event, 1)
+	eventChannel <- model.Event{
+		CallEnded: &model.CallEnded{
+			Call: model.Call{
+				ID:     rootCallID,
+				RootID: rootCallID,
+			},
+			Outcome: model.OpOutcomeTimedOut,
+		},
+	}
+
+	fakeNode := new(nodeFakes.FakeNode)
+	fakeNode.GetOpReturns(&model.OpSummary{ID: rootCallID}, nil)
+	fakeNode.GetEventStreamReturns(eventChannel, nil)
+
+	fakeNodeProvider := new(nodeproviderFakes.FakeNodeProvider)
+	fakeNodeProvider.CreateNodeIfNotExistsReturns(fakeNode, nil)
+
+	/* act */
+	err := opWait(context.Background(), fakeNodeProvider, rootCallID, "")
+
+	/* assert */
+	g.Expect(err).To(Equal(&RunError{ExitCode: 124}))
+}
+
+func TestOpWaitEnded(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	/* arrange */
+	fakeNode := new(nodeFakes.FakeNode)
+	fakeNode.GetOpReturns(&model.OpSummary{Outcome: model.OpOutcomeSucceeded}, nil)
+
+	fakeNodeProvider := new(nodeproviderFakes.FakeNodeProvider)
+	fakeNodeProvider.CreateNodeIfNotExistsReturns(fakeNode, nil)
+
+	/* act */
+	err := opWait(context.Background(), fakeNodeProvider, "rootCallID", "")
+
+	/* assert */
+	g.Expect(err).To(BeNil())
+	g.Expect(fakeNode.GetEventStreamCallCount()).To(Equal(0))
+}
+
+func TestOpWaitTimeout(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	/* arrange */
+	fakeNode := new(nodeFakes.FakeNode)
+	fakeNode.GetOpReturns(&model.OpSummary{}, nil)
+	fakeNode.GetEventStreamReturns(make(chan model.Event), nil)
+
+	fakeNodeProvider := new(nodeproviderFakes.FakeNodeProvider)
+	fakeNodeProvider.CreateNodeIfNotExistsReturns(fakeNode, nil)
+
+	/* act */
+	err := opWait(context.Background(), fakeNodeProvider, "rootCallID", "10ms")
+
+	/* assert */
+	g.Expect(err).To(MatchError("timed out waiting on op 'rootCallID'"))
+}
+
+func TestOpWaitTimeoutExcludesNodeCreation(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	/* arrange */
+	rootCallID := "rootCallID"
+
+	eventChannel := make(chan model.Event, 1)
+	eventChannel <- model.Event{
+		CallEnded: &model.CallEnded{
+			Call: model.Call{
+				ID:     rootCallID,
+				RootID: rootCallID,
+			},
+			Outcome: model.OpOutcomeSucceeded,
+		},
+	}
+
+	fakeNode := new(nodeFakes.FakeNode)
+	fakeNode.GetOpReturns(&model.OpSummary{ID: rootCallID}, nil)
+	fakeNode.GetEventStreamReturns(eventChannel, nil)
+
+	fakeNodeProvider := new(nodeproviderFakes.FakeNodeProvider)
+	fakeNodeProvider.CreateNodeIfNotExistsStub = func(ctx context.Context) (sdknode.Node, error) {
+		// starting the node takes longer than the timeout
+		time.Sleep(50 * time.Millisecond)
+		return fakeNode, ctx.Err()
+	}
+
+	/* act */
+	err := opWait(context.Background(), fakeNodeProvider, rootCallID, "20ms")
+
+	/* assert */
+	g.Expect(err).To(BeNil())
+}
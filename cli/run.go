@@ -2,23 +2,28 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
-	"os/signal"
-	"syscall"
 	"time"
 
 	"github.com/opctl/opctl/cli/internal/clioutput"
 	"github.com/opctl/opctl/cli/internal/cliparamsatisfier"
 	"github.com/opctl/opctl/cli/internal/dataresolver"
 	"github.com/opctl/opctl/cli/internal/nodeprovider"
-	"github.com/opctl/opctl/cli/internal/opgraph"
 	"github.com/opctl/opctl/sdks/go/model"
 	"github.com/opctl/opctl/sdks/go/opspec/opfile"
 )
 
+// output formats of "run" command
+const (
+	outputFormatJSON   = "json"
+	outputFormatNDJSON = "ndjson"
+	outputFormatText   = "text"
+)
+
 // run implements "run" command
 func run(
 	ctx context.Context,
@@ -29,9 +34,31 @@ func run(
 	argFile string,
 	opRef string,
 	disableGraph bool,
+	detach bool,
+	interactive bool,
+	outputFormat string,
 ) error {
 	startTime := time.Now().UTC()
 
+	isMachineReadable := true
+	switch outputFormat {
+	case outputFormatText:
+		isMachineReadable = false
+	case outputFormatJSON:
+		cliOutput = clioutput.NewJSON(os.Stderr, os.Stdout)
+	case outputFormatNDJSON:
+		cliOutput = clioutput.NewNDJSON(os.Stderr, os.Stdout)
+	default:
+		return fmt.Errorf("unsupported output format '%s'", outputFormat)
+	}
+
+	if interactive && detach {
+		return errors.New("interactive & detach are mutually exclusive")
+	}
+	if interactive && isMachineReadable {
+		return fmt.Errorf("interactive is unsupported w/ output format '%s'", outputFormat)
+	}
+
 	node, err := nodeProvider.CreateNodeIfNotExists(ctx)
 	if err != nil {
 		return err
@@ -90,29 +117,6 @@ func run(
 		return err
 	}
 
-	// init signal channels
-	aSigIntWasReceivedAlready := false
-	sigIntChannel := make(chan os.Signal, 1)
-	defer close(sigIntChannel)
-	signal.Notify(
-		sigIntChannel,
-		syscall.SIGINT,
-	)
-
-	sigTermChannel := make(chan os.Signal, 1)
-	defer close(sigTermChannel)
-	signal.Notify(
-		sigTermChannel,
-		syscall.SIGTERM,
-	)
-
-	sigInfoChannel := make(chan os.Signal, 1)
-	defer close(sigInfoChannel)
-	signal.Notify(
-		sigInfoChannel,
-		syscall.Signal(0x1d), // portable version of syscall.SIGINFO
-	)
-
 	// start op
 	rootCallID, err := node.StartOp(
 		ctx,
@@ -127,123 +131,30 @@ func run(
 		return err
 	}
 
-	// "request animation frame" like loop to force refresh of display loading spinners
-	animationFrame := make(chan bool)
-	if !disableGraph {
-		go func() {
-			for {
-				time.Sleep(time.Second / 10)
-				animationFrame <- true
-			}
-		}()
-	}
-
-	var state opgraph.CallGraph
-	var loadingSpinner opgraph.DotLoadingSpinner
-	output := opgraph.NewOutputManager()
-
-	defer func() {
-		output.Print(state.String(loadingSpinner, time.Now(), false))
-		fmt.Println()
-	}()
-
-	clearGraph := func() {
-		if !disableGraph {
-			output.Clear()
+	if detach {
+		if isMachineReadable {
+			return json.NewEncoder(os.Stdout).Encode(
+				struct {
+					ID string `json:"id"`
+				}{
+					ID: rootCallID,
+				},
+			)
 		}
-	}
 
-	displayGraph := func() {
-		if !disableGraph {
-			output.Print(state.String(loadingSpinner, time.Now(), true))
-		}
+		fmt.Println(rootCallID)
+		return nil
 	}
 
-	// start event loop
-	eventChannel, err := node.GetEventStream(
+	return watchOp(
 		ctx,
-		&model.GetEventStreamReq{
-			Filter: model.EventFilter{
-				Roots: []string{rootCallID},
-				Since: &startTime,
-			},
-		},
+		cliOutput,
+		node,
+		rootCallID,
+		&startTime,
+		disableGraph || isMachineReadable,
+		isMachineReadable,
+		true,
+		interactive,
 	)
-	if err != nil {
-		return fmt.Errorf("error getting event stream: %w", err)
-	}
-
-	for {
-		select {
-		case <-sigIntChannel:
-			clearGraph()
-			if !aSigIntWasReceivedAlready {
-				cliOutput.Warning("Gracefully stopping... (signal Control-C again to force)")
-				aSigIntWasReceivedAlready = true
-
-				node.KillOp(
-					ctx,
-					model.KillOpReq{
-						OpID:       rootCallID,
-						RootCallID: rootCallID,
-					},
-				)
-
-				// events will continue to stream in, make sure we continue to display the graph while this happens
-				displayGraph()
-			} else {
-				return &RunError{
-					ExitCode: 130,
-					message:  "Terminated by Control-C",
-				}
-			}
-
-		case <-sigInfoChannel:
-			clearGraph()
-			// clear two more lines
-			fmt.Print("\033[1A\033[K\033[1A\033[K")
-			fmt.Println(state.String(opgraph.StaticLoadingSpinner{}, time.Now(), false))
-			displayGraph()
-
-		case <-sigTermChannel:
-			clearGraph()
-			cliOutput.Error("Gracefully stopping...")
-			node.KillOp(
-				ctx,
-				model.KillOpReq{
-					OpID:       rootCallID,
-					RootCallID: rootCallID,
-				},
-			)
-			displayGraph()
-
-		case event, isEventChannelOpen := <-eventChannel:
-			clearGraph()
-			if !isEventChannelOpen {
-				return errors.New("Event channel closed unexpectedly")
-			}
-
-			if err := state.HandleEvent(&event); err != nil {
-				cliOutput.Error(fmt.Sprintf("%v", err))
-			}
-
-			cliOutput.Event(&event)
-			if event.CallEnded != nil {
-				if event.CallEnded.Call.ID == rootCallID {
-					switch event.CallEnded.Outcome {
-					case model.OpOutcomeSucceeded:
-						return nil
-					case model.OpOutcomeKilled:
-						return &RunError{ExitCode: 137}
-					default:
-						return &RunError{ExitCode: 1}
-					}
-				}
-			}
-			displayGraph()
-		case <-animationFrame:
-			clearGraph()
-			displayGraph()
-		}
-	}
 }
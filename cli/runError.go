@@ -1,5 +1,7 @@
 package main
 
+import "github.com/opctl/opctl/sdks/go/model"
+
 // RunError is an error type that can be returned to allow specifying a specific
 // exit code
 type RunError struct {
@@ -10,3 +12,18 @@ type RunError struct {
 func (e *RunError) Error() string {
 	return e.message
 }
+
+// newOutcomeRunError returns an error conveying the outcome of a root call via exit code;
+// nil is returned if the root call succeeded
+func newOutcomeRunError(outcome string) error {
+	switch outcome {
+	case model.OpOutcomeSucceeded:
+		return nil
+	case model.OpOutcomeKilled:
+		return &RunError{ExitCode: 137}
+	case model.OpOutcomeTimedOut:
+		return &RunError{ExitCode: 124}
+	default:
+		return &RunError{ExitCode: 1}
+	}
+}
@@ -1,6 +1,10 @@
 package main
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/opctl/opctl/sdks/go/model"
+)
 
 func TestRunError(t *testing.T) {
 	err := RunError{message: "testing"}
@@ -8,3 +12,20 @@ func TestRunError(t *testing.T) {
 		t.Error("run error Error() method is broken")
 	}
 }
+
+func TestNewOutcomeRunError(t *testing.T) {
+	if err := newOutcomeRunError(model.OpOutcomeSucceeded); err != nil {
+		t.Error("expected nil error for succeeded outcome")
+	}
+
+	for outcome, expectedExitCode := range map[string]int{
+		model.OpOutcomeFailed:   1,
+		model.OpOutcomeKilled:   137,
+		model.OpOutcomeTimedOut: 124,
+	} {
+		err, ok := newOutcomeRunError(outcome).(*RunError)
+		if !ok || err.ExitCode != expectedExitCode {
+			t.Errorf("expected exit code %d for %s outcome", expectedExitCode, outcome)
+		}
+	}
+}
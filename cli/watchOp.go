@@ -0,0 +1,271 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/opctl/opctl/cli/internal/clioutput"
+	"github.com/opctl/opctl/cli/internal/opgraph"
+	"github.com/opctl/opctl/sdks/go/model"
+	sdknode "github.com/opctl/opctl/sdks/go/node"
+)
+
+// watchOp displays events of the op w/ rootCallID as they stream in & returns once the op ends.
+// Events from since are displayed; nil since displays all events of the op.
+// If disableGraph is false a live call graph is displayed; a summary of it is displayed on return unless disableGraphSummary is true.
+// If killOnSignal is false, SIGINT/SIGTERM detach from the op rather than kill it.
+// If interactive is true, the terminal is attached to interactive containers one at a time as they start;
+// display is suspended while attached.
+func watchOp(
+	ctx context.Context,
+	cliOutput clioutput.CliOutput,
+	node sdknode.Node,
+	rootCallID string,
+	since *time.Time,
+	disableGraph bool,
+	disableGraphSummary bool,
+	killOnSignal bool,
+	interactive bool,
+) error {
+	// init signal channels
+	aSigIntWasReceivedAlready := false
+	sigIntChannel := make(chan os.Signal, 1)
+	defer close(sigIntChannel)
+	signal.Notify(
+		sigIntChannel,
+		syscall.SIGINT,
+	)
+	defer signal.Stop(sigIntChannel)
+
+	sigTermChannel := make(chan os.Signal, 1)
+	defer close(sigTermChannel)
+	signal.Notify(
+		sigTermChannel,
+		syscall.SIGTERM,
+	)
+	defer signal.Stop(sigTermChannel)
+
+	sigInfoChannel := make(chan os.Signal, 1)
+	defer close(sigInfoChannel)
+	signal.Notify(
+		sigInfoChannel,
+		syscall.Signal(0x1d), // portable version of syscall.SIGINFO
+	)
+	defer signal.Stop(sigInfoChannel)
+
+	// "request animation frame" like loop to force refresh of display loading spinners
+	animationFrame := make(chan bool)
+	if !disableGraph {
+		go func() {
+			for {
+				time.Sleep(time.Second / 10)
+				animationFrame <- true
+			}
+		}()
+	}
+
+	var state opgraph.CallGraph
+	var loadingSpinner opgraph.DotLoadingSpinner
+	output := opgraph.NewOutputManager()
+
+	defer func() {
+		if !disableGraphSummary {
+			output.Print(state.String(loadingSpinner, time.Now(), false))
+			fmt.Println()
+		}
+	}()
+
+	isGraphDisplayed := false
+	clearGraph := func() {
+		if isGraphDisplayed {
+			output.Clear()
+			isGraphDisplayed = false
+		}
+	}
+
+	// interactive containers are attached one at a time in the order they start
+	attachedContainerID := ""
+	attachEnded := make(chan error, 1)
+	pendingAttachContainerIDs := []string{}
+	// output of attached containers is displayed via the attachment so is omitted from events
+	wasAttachedByContainerID := map[string]bool{}
+	// events received while attached are displayed once detached
+	suspendedEvents := []*model.Event{}
+
+	attachCtx, cancelAttach := context.WithCancel(ctx)
+	defer func() {
+		// ensure the terminal is restored before returning
+		cancelAttach()
+		if attachedContainerID != "" {
+			<-attachEnded
+		}
+	}()
+
+	displayGraph := func() {
+		if !disableGraph && attachedContainerID == "" {
+			output.Print(state.String(loadingSpinner, time.Now(), true))
+			isGraphDisplayed = true
+		}
+	}
+
+	displayEvent := func(event *model.Event) {
+		switch {
+		case event.ContainerStdOutWrittenTo != nil && wasAttachedByContainerID[event.ContainerStdOutWrittenTo.ContainerID]:
+		case event.ContainerStdErrWrittenTo != nil && wasAttachedByContainerID[event.ContainerStdErrWrittenTo.ContainerID]:
+		case attachedContainerID != "":
+			suspendedEvents = append(suspendedEvents, event)
+		default:
+			cliOutput.Event(event)
+		}
+	}
+
+	handleAttachEnded := func(err error) {
+		if err != nil {
+			cliOutput.Error(fmt.Sprintf("unable to attach container: %v", err))
+		}
+		attachedContainerID = ""
+
+		for _, suspendedEvent := range suspendedEvents {
+			displayEvent(suspendedEvent)
+		}
+		suspendedEvents = []*model.Event{}
+	}
+
+	attachNextContainer := func() {
+		if attachedContainerID != "" || len(pendingAttachContainerIDs) == 0 {
+			return
+		}
+
+		attachedContainerID = pendingAttachContainerIDs[0]
+		pendingAttachContainerIDs = pendingAttachContainerIDs[1:]
+		wasAttachedByContainerID[attachedContainerID] = true
+
+		containerID := attachedContainerID
+		go func() {
+			attachEnded <- attachContainer(attachCtx, node, containerID)
+		}()
+	}
+
+	detach := func() error {
+		cliOutput.Warning(fmt.Sprintf("Detached; op continues running (re-attach via `opctl op attach %s`)", rootCallID))
+		return nil
+	}
+
+	// start event loop
+	eventChannel, err := node.GetEventStream(
+		ctx,
+		&model.GetEventStreamReq{
+			Filter: model.EventFilter{
+				Roots: []string{rootCallID},
+				Since: since,
+			},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("error getting event stream: %w", err)
+	}
+
+	for {
+		select {
+		case <-sigIntChannel:
+			clearGraph()
+			if !killOnSignal {
+				return detach()
+			}
+
+			if !aSigIntWasReceivedAlready {
+				cliOutput.Warning("Gracefully stopping... (signal Control-C again to force)")
+				aSigIntWasReceivedAlready = true
+
+				node.KillOp(
+					ctx,
+					model.KillOpReq{
+						OpID:       rootCallID,
+						RootCallID: rootCallID,
+					},
+				)
+
+				// events will continue to stream in, make sure we continue to display the graph while this happens
+				displayGraph()
+			} else {
+				return &RunError{
+					ExitCode: 130,
+					message:  "Terminated by Control-C",
+				}
+			}
+
+		case <-sigInfoChannel:
+			clearGraph()
+			// clear two more lines
+			fmt.Print("\033[1A\033[K\033[1A\033[K")
+			fmt.Println(state.String(opgraph.StaticLoadingSpinner{}, time.Now(), false))
+			displayGraph()
+
+		case <-sigTermChannel:
+			clearGraph()
+			if !killOnSignal {
+				return detach()
+			}
+
+			cliOutput.Error("Gracefully stopping...")
+			node.KillOp(
+				ctx,
+				model.KillOpReq{
+					OpID:       rootCallID,
+					RootCallID: rootCallID,
+				},
+			)
+			displayGraph()
+
+		case event, isEventChannelOpen := <-eventChannel:
+			clearGraph()
+			if !isEventChannelOpen {
+				return errors.New("Event channel closed unexpectedly")
+			}
+
+			if err := state.HandleEvent(&event); err != nil {
+				cliOutput.Error(fmt.Sprintf("%v", err))
+			}
+
+			if interactive && event.CallStarted != nil && event.CallStarted.Call.Container != nil && event.CallStarted.Call.Container.Interactive {
+				pendingAttachContainerIDs = append(pendingAttachContainerIDs, event.CallStarted.Call.Container.ContainerID)
+			}
+
+			if event.CallEnded != nil && event.CallEnded.Call.Container != nil {
+				// don't attach to containers which ended before their turn
+				for i, containerID := range pendingAttachContainerIDs {
+					if containerID == event.CallEnded.Call.Container.ContainerID {
+						pendingAttachContainerIDs = append(pendingAttachContainerIDs[:i], pendingAttachContainerIDs[i+1:]...)
+						break
+					}
+				}
+			}
+
+			displayEvent(&event)
+			if event.CallEnded != nil {
+				if event.CallEnded.Call.ID == rootCallID {
+					if attachedContainerID != "" {
+						// the attached container has exited; wait on its remaining output
+						handleAttachEnded(<-attachEnded)
+					}
+					return newOutcomeRunError(event.CallEnded.Outcome)
+				}
+			}
+			attachNextContainer()
+			displayGraph()
+		case err := <-attachEnded:
+			handleAttachEnded(err)
+
+			attachNextContainer()
+			displayGraph()
+		case <-animationFrame:
+			clearGraph()
+			displayGraph()
+		}
+	}
+}
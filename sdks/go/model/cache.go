@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+// CacheEntry is the cached result of a container call
+type CacheEntry struct {
+	Key       string    `json:"key"`
+	ImageRef  string    `json:"imageRef"`
+	OpRef     string    `json:"opRef"`
+	CreatedAt time.Time `json:"createdAt"`
+	// SizeBytes is the size of the cached outputs
+	SizeBytes int64 `json:"sizeBytes"`
+}
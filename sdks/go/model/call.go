@@ -9,22 +9,44 @@ type Auth struct {
 
 //Call is a node of a call graph; see https://en.wikipedia.org/wiki/Call_graph
 type Call struct {
+	// names of sibling calls which must succeed before the call starts
+	After []string `json:"after,omitempty"`
+	// attempt of call; only set when call has a retry policy
+	Attempt   *CallAttempt   `json:"attempt,omitempty"`
 	Container *ContainerCall `json:"container,omitempty"`
+	// failure of the call is recorded but doesn't fail its parent
+	ContinueOnError bool `json:"continueOnError,omitempty"`
+	// calls run in serial after an op or serial call ends regardless of outcome
+	Finally []*CallSpec `json:"finally,omitempty"`
 	// id of call
-	ID           string            `json:"id"`
-	If           *bool             `json:"if,omitempty"`
-	IsKilled     bool              `json:"isKilled"`
-	Name         *string           `json:"name,omitempty"`
-	Needs        []string          `json:"needs,omitempty"`
-	Op           *OpCall           `json:"op,omitempty"`
-	Parallel     []*CallSpec       `json:"parallel,omitempty"`
-	ParallelLoop *ParallelLoopCall `json:"parallelLoop,omitempty"`
+	ID       string `json:"id"`
+	If       *bool  `json:"if,omitempty"`
+	IsKilled bool   `json:"isKilled"`
+	// max children of a parallel call run at once; nil if unbounded
+	MaxConcurrency *int              `json:"maxConcurrency,omitempty"`
+	Name           *string           `json:"name,omitempty"`
+	Needs          []string          `json:"needs,omitempty"`
+	NeedsReady     []string          `json:"needsReady,omitempty"`
+	Op             *OpCall           `json:"op,omitempty"`
+	Parallel       []*CallSpec       `json:"parallel,omitempty"`
+	ParallelLoop   *ParallelLoopCall `json:"parallelLoop,omitempty"`
 	// id of parent call
 	ParentID *string `json:"parentId,omitempty"`
 	// id of root call
 	RootID     string          `json:"rootId"`
+	Retry      *Retry          `json:"retry,omitempty"`
 	Serial     []*CallSpec     `json:"serial,omitempty"`
 	SerialLoop *SerialLoopCall `json:"serialLoop,omitempty"`
+	Switch     *SwitchCall     `json:"switch,omitempty"`
+	// duration after which the call will be timed out
+	Timeout *string `json:"timeout,omitempty"`
+}
+
+//CallAttempt identifies an attempt of a call w/ a retry policy
+type CallAttempt struct {
+	// 1 based attempt number
+	Number int `json:"number"`
+	Max    int `json:"max"`
 }
 
 type BaseCall struct {
@@ -34,6 +56,12 @@ type BaseCall struct {
 //ContainerCall is a call of a container
 type ContainerCall struct {
 	BaseCall
+	// AllowNonZeroExit indicates a nonzero exit code won't fail the call
+	AllowNonZeroExit bool `json:"allowNonZeroExit,omitempty"`
+	// Cache indicates results may be reused for identical calls
+	Cache       bool     `json:"cache,omitempty"`
+	CapAdd      []string `json:"capAdd,omitempty"`
+	CapDrop     []string `json:"capDrop,omitempty"`
 	ContainerID string   `json:"containerId"`
 	Cmd         []string `json:"cmd"`
 	// format: containerPath => hostPath
@@ -43,13 +71,59 @@ type ContainerCall struct {
 	// format: containerPath => hostPath
 	Files map[string]string   `json:"files"`
 	Image *ContainerCallImage `json:"image"`
+	// Interactive indicates the container is run w/ an open std in & TTY which terminals can attach to
+	Interactive bool `json:"interactive,omitempty"`
+	// Privileged is nil if not explicitly set; in which case the default of the node applies
+	Privileged *bool `json:"privileged,omitempty"`
+	// ReadOnlyPaths are container paths of dirs & files which are mounted read only
+	ReadOnlyPaths  []string                `json:"readOnlyPaths,omitempty"`
+	ReadOnlyRootFs bool                    `json:"readOnlyRootFs,omitempty"`
+	Readiness      *ContainerCallReadiness `json:"readiness,omitempty"`
+	Resources      *ContainerCallResources `json:"resources,omitempty"`
 	// format: containerSocket => hostSocket
 	Sockets map[string]string `json:"sockets"`
+	// host path of a file whose content will be fed to std in of the container
+	Stdin *string `json:"stdin,omitempty"`
+	// format: containerPath => max size in bytes; 0 is unlimited
+	Tmpfs map[string]int64 `json:"tmpfs,omitempty"`
+	// format: user[:group]
+	User    string            `json:"user,omitempty"`
 	WorkDir string            `json:"workDir"`
 	Name    *string           `json:"name,omitempty"`
 	Ports   map[string]string `json:"ports,omitempty"`
 }
 
+//ContainerCallReadiness probes whether a container is ready; exactly one of Exec, HTTPGet & TCPPort is set
+type ContainerCallReadiness struct {
+	// Exec is a command run in the container; ready once it exits zero
+	Exec    []string                       `json:"exec,omitempty"`
+	HTTPGet *ContainerCallReadinessHTTPGet `json:"httpGet,omitempty"`
+	// duration waited between probes
+	Interval string `json:"interval"`
+	// TCPPort is a port of the container; ready once it accepts connections
+	TCPPort *int `json:"tcpPort,omitempty"`
+	// duration after which the call fails if the container isn't ready
+	Timeout *string `json:"timeout,omitempty"`
+}
+
+//ContainerCallReadinessHTTPGet probes a container via HTTP GET; ready once it responds w/ a 2xx or 3xx status
+type ContainerCallReadinessHTTPGet struct {
+	Path string `json:"path"`
+	Port int    `json:"port"`
+}
+
+//ContainerCallResources limits the resources available to a container; zero values are unlimited
+type ContainerCallResources struct {
+	Cpus float64 `json:"cpus,omitempty"`
+	// in bytes
+	Memory int64 `json:"memory,omitempty"`
+	// in bytes; -1 is unlimited
+	MemorySwap int64 `json:"memorySwap,omitempty"`
+	PidsLimit  int64 `json:"pidsLimit,omitempty"`
+	// in bytes
+	ShmSize int64 `json:"shmSize,omitempty"`
+}
+
 //ContainerCallImage is the image used when calling a container
 type ContainerCallImage struct {
 	Src       *Value  `json:"src,omitempty"`
@@ -81,6 +155,8 @@ type OpCall struct {
 
 //ParallelLoopCall is a call of a parallel loop
 type ParallelLoopCall struct {
+	// max iterations run at once; nil if unbounded
+	MaxConcurrency *int `json:"maxConcurrency,omitempty"`
 	// an array or object
 	Range *Value    `json:"range,omitempty"`
 	Run   Call      `json:"run,omitempty"`
@@ -93,6 +169,21 @@ type Predicate struct {
 	Ne []*Value `json:"ne"`
 }
 
+//Retry is a retry policy of a call
+type Retry struct {
+	MaxAttempts int `json:"maxAttempts"`
+	// duration waited before the first retry
+	Delay   *string  `json:"delay,omitempty"`
+	Backoff *float64 `json:"backoff,omitempty"`
+	On      *RetryOn `json:"on,omitempty"`
+}
+
+//RetryOn determines which failures of a call will be retried
+type RetryOn struct {
+	ErrorMatches *string `json:"errorMatches,omitempty"`
+	ExitCodes    []int64 `json:"exitCodes,omitempty"`
+}
+
 //SerialLoopCall is a call of a serial loop
 type SerialLoopCall struct {
 	// an array or object
@@ -101,3 +192,9 @@ type SerialLoopCall struct {
 	Until *bool     `json:"until,omitempty"`
 	Vars  *LoopVars `json:"vars,omitempty"`
 }
+
+//SwitchCall is a call of a switch
+type SwitchCall struct {
+	// index of the case whose predicates were true; nil if no case's were (so the default, if any, is called)
+	CaseIndex *int `json:"caseIndex,omitempty"`
+}
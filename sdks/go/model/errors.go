@@ -28,3 +28,10 @@ func IsAuthError(err error) bool {
 	return errors.Is(err, ErrDataProviderAuthorization{}) ||
 		errors.Is(err, ErrDataProviderAuthentication{})
 }
+
+// ErrOpNotFound conveys no such op could be found
+type ErrOpNotFound struct{}
+
+func (ErrOpNotFound) Error() string {
+	return "op not found"
+}
@@ -6,17 +6,38 @@ import "time"
 type Event struct {
 	AuthAdded                *AuthAdded                `json:"authAdded,omitempty"`
 	CallEnded                *CallEnded                `json:"callEnded,omitempty"`
+	CallPending              *CallPending              `json:"callPending,omitempty"`
+	CallReady                *CallReady                `json:"callReady,omitempty"`
 	CallStarted              *CallStarted              `json:"callStarted,omitempty"`
 	ContainerStdErrWrittenTo *ContainerStdErrWrittenTo `json:"containerStdErrWrittenTo,omitempty"`
 	ContainerStdOutWrittenTo *ContainerStdOutWrittenTo `json:"containerStdOutWrittenTo,omitempty"`
 	CallKillRequested        *CallKillRequested        `json:"callKillRequested,omitempty"`
-	Timestamp                time.Time                 `json:"timestamp"`
+	CallOutputsConflicted    *CallOutputsConflicted    `json:"callOutputsConflicted,omitempty"`
+	// Sequence is assigned on publish; it's unique & monotonically increasing
+	Sequence  uint64    `json:"sequence,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
 }
 
+// kinds of events; named per their Event property
+const (
+	EventKindAuthAdded                = "authAdded"
+	EventKindCallEnded                = "callEnded"
+	EventKindCallKillRequested        = "callKillRequested"
+	EventKindCallOutputsConflicted    = "callOutputsConflicted"
+	EventKindCallPending              = "callPending"
+	EventKindCallReady                = "callReady"
+	EventKindCallStarted              = "callStarted"
+	EventKindContainerStdErrWrittenTo = "containerStdErrWrittenTo"
+	EventKindContainerStdOutWrittenTo = "containerStdOutWrittenTo"
+)
+
 const (
 	OpOutcomeSucceeded = "SUCCEEDED"
 	OpOutcomeFailed    = "FAILED"
 	OpOutcomeKilled    = "KILLED"
+	OpOutcomeTimedOut  = "TIMED_OUT"
+	// calls end w/ this outcome w/out starting when a call they're after didn't succeed
+	OpOutcomeSkipped = "SKIPPED"
 )
 
 // AuthAdded represents auth was added for external resources
@@ -31,11 +52,39 @@ type CallKillRequested struct {
 
 // CallEnded represents a call ended; no further events will occur for the call
 type CallEnded struct {
-	Call    Call              `json:"call"`
-	Ref     string            `json:"ref"`
-	Error   *CallEndedError   `json:"error,omitempty"`
-	Outputs map[string]*Value `json:"outputs"`
-	Outcome string            `json:"outcome"`
+	// true if the call's results were replayed from the cache rather than the call being run
+	CacheHit bool              `json:"cacheHit,omitempty"`
+	Call     Call              `json:"call"`
+	Ref      string            `json:"ref"`
+	Error    *CallEndedError   `json:"error,omitempty"`
+	Outputs  map[string]*Value `json:"outputs"`
+	Outcome  string            `json:"outcome"`
+	// true if the call failed but will be attempted again
+	WillRetry bool `json:"willRetry,omitempty"`
+}
+
+// CallOutputsConflicted represents more than one child of a parallel call producing the same outputs; the output of the last child wins
+type CallOutputsConflicted struct {
+	CallID string `json:"callId"`
+	// labels of the children producing each conflicting output; unnamed children are labeled by index e.g. #1
+	CallLabelsByOutputName map[string][]string `json:"callLabelsByOutputName"`
+	OpRef                  string              `json:"opRef"`
+	RootCallID             string              `json:"rootCallId"`
+}
+
+// CallPending represents a call which will start once the calls it's after have succeeded or, if queued
+// due to max concurrency, once a running call ends
+type CallPending struct {
+	Call Call   `json:"call"`
+	Ref  string `json:"ref"`
+}
+
+// CallReady represents a call passed its readiness probe; calls needing it to be ready may start
+type CallReady struct {
+	CallID      string `json:"callId"`
+	ContainerID string `json:"containerId"`
+	OpRef       string `json:"opRef"`
+	RootCallID  string `json:"rootCallId"`
 }
 
 // CallStarted represents the start of an op
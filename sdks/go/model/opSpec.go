@@ -12,34 +12,72 @@ type OpSpec struct {
 
 //CallSpec is a spec for a node of a call graph; see https://en.wikipedia.org/wiki/Call_graph
 type CallSpec struct {
-	Container    *ContainerCallSpec    `json:"container,omitempty"`
-	Description  string                `json:"description,omitempty"`
-	If           *[]*PredicateSpec     `json:"if,omitempty"`
-	Name         *string               `json:"name,omitempty"`
-	Needs        []string              `json:"needs,omitempty"`
-	Op           *OpCallSpec           `json:"op,omitempty"`
-	Parallel     *[]*CallSpec          `json:"parallel,omitempty"`
-	ParallelLoop *ParallelLoopCallSpec `json:"parallelLoop,omitempty"`
-	Serial       *[]*CallSpec          `json:"serial,omitempty"`
-	SerialLoop   *SerialLoopCallSpec   `json:"serialLoop,omitempty"`
+	After     []string           `json:"after,omitempty"`
+	Container *ContainerCallSpec `json:"container,omitempty"`
+	// ContinueOnError will be interpreted to a boolean
+	ContinueOnError interface{} `json:"continueOnError,omitempty"`
+	Description     string      `json:"description,omitempty"`
+	// Finally calls run in serial after the call ends regardless of outcome; only applies to op & serial calls
+	Finally *[]*CallSpec      `json:"finally,omitempty"`
+	If      *[]*PredicateSpec `json:"if,omitempty"`
+	// MaxConcurrency will be interpreted to a number; only applies to parallel calls
+	MaxConcurrency interface{}           `json:"maxConcurrency,omitempty"`
+	Name           *string               `json:"name,omitempty"`
+	Needs          []string              `json:"needs,omitempty"`
+	NeedsReady     []string              `json:"needsReady,omitempty"`
+	Op             *OpCallSpec           `json:"op,omitempty"`
+	Parallel       *[]*CallSpec          `json:"parallel,omitempty"`
+	ParallelLoop   *ParallelLoopCallSpec `json:"parallelLoop,omitempty"`
+	Retry          *RetrySpec            `json:"retry,omitempty"`
+	Serial         *[]*CallSpec          `json:"serial,omitempty"`
+	SerialLoop     *SerialLoopCallSpec   `json:"serialLoop,omitempty"`
+	Switch         *SwitchCallSpec       `json:"switch,omitempty"`
+	// Timeout will be interpreted to a duration string e.g. "30s", "5m", "1h30m"
+	Timeout *string `json:"timeout,omitempty"`
 }
 
 //ContainerCallSpec is a spec for calling a container
 type ContainerCallSpec struct {
+	// AllowNonZeroExit will be interpreted to a boolean; if true, a nonzero exit code won't fail the call
+	AllowNonZeroExit interface{} `json:"allowNonZeroExit,omitempty"`
+	// Cache will be interpreted to a boolean; if true, results will be reused for identical calls
+	Cache interface{} `json:"cache,omitempty"`
+	// CapAdd entries will be interpreted to strings; linux capabilities added to the container
+	CapAdd []interface{} `json:"capAdd,omitempty"`
+	// CapDrop entries will be interpreted to strings; linux capabilities dropped from the container
+	CapDrop []interface{} `json:"capDrop,omitempty"`
 	// Cmd entries will be interpreted to strings
 	Cmd []interface{} `json:"cmd,omitempty"`
-	// Dirs entries will be interpreted to dirs
+	// Dirs entries will be interpreted to dirs; entries may also be a ContainerCallMountSpec
 	Dirs map[string]interface{} `json:"dirs,omitempty"`
 
 	// EnvVars entries will be interpreted to strings
 	EnvVars interface{} `json:"envVars,omitempty"`
-	// Dirs entries will be interpreted to files
-	Files   map[string]interface{}  `json:"files,omitempty"`
-	Image   *ContainerCallImageSpec `json:"image"`
-	Sockets map[string]string       `json:"sockets,omitempty"`
-	WorkDir string                  `json:"workDir,omitempty"`
-	Name    *string                 `json:"name,omitempty"`
-	Ports   map[string]string       `json:"ports,omitempty"`
+	// ExitCode is a variable reference to which the exit code of the container will be bound as a number upon exit
+	ExitCode *string `json:"exitCode,omitempty"`
+	// Files entries will be interpreted to files; entries may also be a ContainerCallMountSpec
+	Files map[string]interface{}  `json:"files,omitempty"`
+	Image *ContainerCallImageSpec `json:"image"`
+	// Interactive will be interpreted to a boolean; if true, the container will be run w/ an open std in & TTY which terminals can attach to
+	Interactive interface{} `json:"interactive,omitempty"`
+	// Privileged will be interpreted to a boolean; if true, the container will be run privileged
+	Privileged interface{} `json:"privileged,omitempty"`
+	// ReadOnlyRootFs will be interpreted to a boolean; if true, the root filesystem of the container will be mounted read only
+	ReadOnlyRootFs interface{}                 `json:"readOnlyRootFs,omitempty"`
+	Readiness      *ContainerCallReadinessSpec `json:"readiness,omitempty"`
+	Resources      *ContainerCallResourcesSpec `json:"resources,omitempty"`
+	Sockets        map[string]string           `json:"sockets,omitempty"`
+	// Stdin will be interpreted to a file; its content will be fed to std in of the container
+	Stdin interface{} `json:"stdin,omitempty"`
+	// StdErr binds std err of the container to variables upon exit
+	StdErr *ContainerCallStdioSpec `json:"stderr,omitempty"`
+	// StdOut binds std out of the container to variables upon exit
+	StdOut *ContainerCallStdioSpec `json:"stdout,omitempty"`
+	// User will be interpreted to a string; the user (& optionally group) the container is run as in format user[:group]
+	User    *string           `json:"user,omitempty"`
+	WorkDir string            `json:"workDir,omitempty"`
+	Name    *string           `json:"name,omitempty"`
+	Ports   map[string]string `json:"ports,omitempty"`
 }
 
 //ContainerCallImageSpec is a spec for the image when calling a container
@@ -48,6 +86,65 @@ type ContainerCallImageSpec struct {
 	PullCreds *CredsSpec `json:"pullCreds,omitempty"`
 }
 
+//ContainerCallMountSpec is a spec for mounting a dir or file into a container w/ options
+type ContainerCallMountSpec struct {
+	// Ref will be interpreted to a dir or file; if nil it's bound implicitly to the same path in the op
+	Ref interface{} `json:"ref,omitempty"`
+	// ReadOnly will be interpreted to a boolean; if true, the dir or file will be mounted read only
+	ReadOnly interface{} `json:"readOnly,omitempty"`
+	// Tmpfs, if not nil, mounts an empty in memory dir in place of ref; dirs only
+	Tmpfs *ContainerCallTmpfsSpec `json:"tmpfs,omitempty"`
+}
+
+//ContainerCallStdioSpec is a spec for binding a std stream of a container to variables
+type ContainerCallStdioSpec struct {
+	// File is a variable reference to which the stream will be bound as a file
+	File *string `json:"file,omitempty"`
+	// String is a variable reference to which the stream will be bound as a string
+	String *string `json:"string,omitempty"`
+}
+
+//ContainerCallTmpfsSpec is a spec for a tmpfs mount
+type ContainerCallTmpfsSpec struct {
+	// Size will be interpreted to a number of bytes; either a number or a string w/ unit e.g. 64m
+	Size interface{} `json:"size,omitempty"`
+}
+
+//ContainerCallReadinessSpec is a spec for probing whether a container is ready; exactly one of Exec, HTTPGet & TCPPort must be set
+type ContainerCallReadinessSpec struct {
+	// Exec entries will be interpreted to strings; a command run in the container which is ready once it exits zero
+	Exec    []interface{}                      `json:"exec,omitempty"`
+	HTTPGet *ContainerCallReadinessHTTPGetSpec `json:"httpGet,omitempty"`
+	// Interval will be interpreted to a duration string e.g. "1s"; duration waited between probes
+	Interval *string `json:"interval,omitempty"`
+	// TCPPort will be interpreted to a number; a port of the container which is ready once it accepts connections
+	TCPPort interface{} `json:"tcpPort,omitempty"`
+	// Timeout will be interpreted to a duration string e.g. "30s"; duration after which the call fails if the container isn't ready
+	Timeout *string `json:"timeout,omitempty"`
+}
+
+//ContainerCallReadinessHTTPGetSpec is a spec for probing a container via HTTP GET
+type ContainerCallReadinessHTTPGetSpec struct {
+	// Path will be interpreted to a string; defaults to /
+	Path interface{} `json:"path,omitempty"`
+	// Port will be interpreted to a number
+	Port interface{} `json:"port"`
+}
+
+//ContainerCallResourcesSpec is a spec for limiting the resources available to a container
+type ContainerCallResourcesSpec struct {
+	// Cpus will be interpreted to a number; max cpus the container can use e.g. 1.5
+	Cpus interface{} `json:"cpus,omitempty"`
+	// Memory will be interpreted to a number of bytes; either a number or a string w/ unit e.g. 512m
+	Memory interface{} `json:"memory,omitempty"`
+	// MemorySwap will be interpreted to a number of bytes; max memory plus swap the container can use; -1 is unlimited
+	MemorySwap interface{} `json:"memorySwap,omitempty"`
+	// PidsLimit will be interpreted to a number; max processes the container can run
+	PidsLimit interface{} `json:"pidsLimit,omitempty"`
+	// ShmSize will be interpreted to a number of bytes; size of /dev/shm
+	ShmSize interface{} `json:"shmSize,omitempty"`
+}
+
 //LoopVarsSpec is a spec for a loops vars
 type LoopVarsSpec struct {
 	Index *string `json:"index,omitempty"`
@@ -69,17 +166,30 @@ type OpCallSpec struct {
 
 //ParallelLoopCallSpec is a spec for calling a parallel loop
 type ParallelLoopCallSpec struct {
-	Range interface{}   `json:"range,omitempty"`
-	Run   CallSpec      `json:"run,omitempty"`
-	Vars  *LoopVarsSpec `json:"vars,omitempty"`
+	// binds outputs of each iteration, in iteration order, to an array (or object keyed by loop key)
+	Collect map[string]string `json:"collect,omitempty"`
+	// MaxConcurrency will be interpreted to a number
+	MaxConcurrency interface{}   `json:"maxConcurrency,omitempty"`
+	Range          interface{}   `json:"range,omitempty"`
+	Run            CallSpec      `json:"run,omitempty"`
+	Vars           *LoopVarsSpec `json:"vars,omitempty"`
 }
 
 //PredicateSpec is a spec for a predicate
 type PredicateSpec struct {
-	Eq        *[]interface{} `json:"eq,omitempty"`
-	Exists    *string        `json:"exists,omitempty"`
-	Ne        *[]interface{} `json:"ne,omitempty"`
-	NotExists *string        `json:"notExists,omitempty"`
+	AllOf     *[]*PredicateSpec `json:"allOf,omitempty"`
+	AnyOf     *[]*PredicateSpec `json:"anyOf,omitempty"`
+	Contains  *[]interface{}    `json:"contains,omitempty"`
+	Eq        *[]interface{}    `json:"eq,omitempty"`
+	Exists    *string           `json:"exists,omitempty"`
+	Gt        *[]interface{}    `json:"gt,omitempty"`
+	Gte       *[]interface{}    `json:"gte,omitempty"`
+	Lt        *[]interface{}    `json:"lt,omitempty"`
+	Lte       *[]interface{}    `json:"lte,omitempty"`
+	Matches   *[]interface{}    `json:"matches,omitempty"`
+	Ne        *[]interface{}    `json:"ne,omitempty"`
+	Not       *PredicateSpec    `json:"not,omitempty"`
+	NotExists *string           `json:"notExists,omitempty"`
 }
 
 //CredsSpec is a spec for authentication credentials
@@ -90,12 +200,47 @@ type CredsSpec struct {
 	Password string `json:"password"`
 }
 
+//RetrySpec is a spec for retrying a call
+type RetrySpec struct {
+	// MaxAttempts will be interpreted to a number; includes the initial attempt
+	MaxAttempts interface{} `json:"maxAttempts"`
+	// Delay will be interpreted to a duration string e.g. "5s"; delay before the first retry
+	Delay *string `json:"delay,omitempty"`
+	// Backoff will be interpreted to a number; multiplies the delay after each retry
+	Backoff interface{} `json:"backoff,omitempty"`
+	// On limits retries to failures matching it; if nil any failure will be retried
+	On *RetryOnSpec `json:"on,omitempty"`
+}
+
+//RetryOnSpec is a spec for which failures of a call will be retried
+type RetryOnSpec struct {
+	// ErrorMatches will be interpreted to a string & used as a regular expression against the error message
+	ErrorMatches *string `json:"errorMatches,omitempty"`
+	// ExitCodes entries will be interpreted to numbers & compared against container exit codes
+	ExitCodes []interface{} `json:"exitCodes,omitempty"`
+}
+
 //SerialLoopCallSpec is a spec for calling a serial loop
 type SerialLoopCallSpec struct {
-	Range interface{}      `json:"range,omitempty"`
-	Run   CallSpec         `json:"run,omitempty"`
-	Until []*PredicateSpec `json:"until,omitempty"`
-	Vars  *LoopVarsSpec    `json:"vars,omitempty"`
+	// binds outputs of each iteration, in iteration order, to an array (or object keyed by loop key)
+	Collect map[string]string `json:"collect,omitempty"`
+	Range   interface{}       `json:"range,omitempty"`
+	Run     CallSpec          `json:"run,omitempty"`
+	Until   []*PredicateSpec  `json:"until,omitempty"`
+	Vars    *LoopVarsSpec     `json:"vars,omitempty"`
+}
+
+//SwitchCallSpec is a spec for calling the first case whose predicates are true
+type SwitchCallSpec struct {
+	Cases []*SwitchCaseSpec `json:"cases,omitempty"`
+	// Default is called if no case's predicates are true
+	Default *CallSpec `json:"default,omitempty"`
+}
+
+//SwitchCaseSpec is a spec for a case of a switch call
+type SwitchCaseSpec struct {
+	If  []*PredicateSpec `json:"if"`
+	Run CallSpec         `json:"run"`
 }
 
 type ReferenceOpts struct {
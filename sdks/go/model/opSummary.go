@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+// OpSummary summarizes a run of an op i.e. a root call
+type OpSummary struct {
+	// format: name => value; secrets are redacted
+	Args map[string]*Value `json:"args"`
+	// EndTime is nil while the op is running
+	EndTime *time.Time      `json:"endTime,omitempty"`
+	Error   *CallEndedError `json:"error,omitempty"`
+	// ID of the root call
+	ID string `json:"id"`
+	// Outcome is empty while the op is running
+	Outcome string `json:"outcome,omitempty"`
+	// format: name => value; secrets are redacted
+	Outputs   map[string]*Value `json:"outputs,omitempty"`
+	Ref       string            `json:"ref"`
+	StartTime time.Time         `json:"startTime"`
+}
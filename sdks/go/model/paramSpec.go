@@ -80,3 +80,24 @@ type StringParamSpec struct {
 }
 
 type Constraints map[string]interface{}
+
+// IsSecret returns true if values of the param are secret
+func (ps ParamSpec) IsSecret() bool {
+	switch {
+	case ps.Array != nil:
+		return ps.Array.IsSecret
+	case ps.Dir != nil:
+		return ps.Dir.IsSecret
+	case ps.File != nil:
+		return ps.File.IsSecret
+	case ps.Number != nil:
+		return ps.Number.IsSecret
+	case ps.Object != nil:
+		return ps.Object.IsSecret
+	case ps.Socket != nil:
+		return ps.Socket.IsSecret
+	case ps.String != nil:
+		return ps.String.IsSecret
+	}
+	return false
+}
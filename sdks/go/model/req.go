@@ -1,6 +1,7 @@
 package model
 
 import (
+	"io"
 	"time"
 )
 
@@ -11,7 +12,28 @@ type AddAuthReq struct {
 	Creds
 }
 
+// AttachContainerReq holds data for attaching a terminal to the TTY of an interactive container
+type AttachContainerReq struct {
+	ContainerID string `json:"containerId"`
+	// Stdin will be fed to the TTY of the container
+	Stdin io.Reader `json:"-"`
+	// Stdout will be written to from the TTY of the container
+	Stdout io.Writer `json:"-"`
+	// Resizes of the attached terminal will be propagated to the TTY of the container; nil if none
+	Resizes <-chan TerminalSize `json:"-"`
+}
+
 type EventFilter struct {
+	// filter to events w/ a sequence greater than this
+	AfterSequence uint64
+	// filter to events of these calls & their descendants
+	CallIDs []string
+	// filter to events of these containers
+	ContainerIDs []string
+	// filter to events of these kinds; kinds are named per their Event property (e.g. callStarted)
+	Kinds []string
+	// filter CallEnded events to those w/ these outcomes; other events are unaffected
+	Outcomes []string
 	// filter to events from these root op id's
 	Roots []string
 	// filter to events occurring after & including this time
@@ -37,6 +59,23 @@ type KillOpReq struct {
 	RootCallID string `json:"rootCallId"`
 }
 
+type PruneCacheReq struct {
+	// prune entries created before this time; if nil, all entries will be pruned
+	Before *time.Time `json:"before,omitempty"`
+}
+
+// PruneEventsReq holds limits for pruning events of ended root calls; if no limits are provided & All isn't set, nothing will be pruned
+type PruneEventsReq struct {
+	// prune events of all ended root calls; other limits are ignored
+	All bool `json:"all,omitempty"`
+	// prune events of root calls ended before this time
+	Before *time.Time `json:"before,omitempty"`
+	// prune events of the oldest root calls until total size of events is within this
+	MaxSizeBytes int64 `json:"maxSizeBytes,omitempty"`
+	// prune events of all but this many of the most recently ended root calls
+	MaxRootCalls int `json:"maxRootCalls,omitempty"`
+}
+
 type StartOpReq struct {
 	// map of args keyed by input name
 	Args map[string]*Value `json:"args,omitempty"`
@@ -48,3 +87,9 @@ type StartOpReqOp struct {
 	Ref       string
 	PullCreds *Creds `json:"pullCreds,omitempty"`
 }
+
+// TerminalSize is the size of a terminal in characters
+type TerminalSize struct {
+	Height uint16 `json:"height"`
+	Width  uint16 `json:"width"`
+}
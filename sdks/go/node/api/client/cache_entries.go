@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"path"
+
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/node/api"
+)
+
+func (c apiClient) ListCacheEntries(
+	ctx context.Context,
+) (
+	[]*model.CacheEntry,
+	error,
+) {
+
+	reqURL := c.baseURL
+	reqURL.Path = path.Join(reqURL.Path, api.URLCache_Entries)
+
+	httpReq, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		reqURL.String(),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	httpResp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	// don't leak resources
+	defer httpResp.Body.Close()
+
+	if http.StatusOK != httpResp.StatusCode {
+		bodyBytes, err := ioutil.ReadAll(httpResp.Body)
+		if err != nil {
+			return nil, err
+		}
+		return nil, errors.New(string(bodyBytes))
+	}
+
+	var entries []*model.CacheEntry
+	return entries, json.NewDecoder(httpResp.Body).Decode(&entries)
+}
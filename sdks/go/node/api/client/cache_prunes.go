@@ -0,0 +1,59 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"path"
+
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/node/api"
+)
+
+func (c apiClient) PruneCache(
+	ctx context.Context,
+	req model.PruneCacheReq,
+) (
+	[]*model.CacheEntry,
+	error,
+) {
+
+	reqBytes, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	reqURL := c.baseURL
+	reqURL.Path = path.Join(reqURL.Path, api.URLCache_Prunes)
+
+	httpReq, err := http.NewRequestWithContext(
+		ctx,
+		"POST",
+		reqURL.String(),
+		bytes.NewBuffer(reqBytes),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	httpResp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	// don't leak resources
+	defer httpResp.Body.Close()
+
+	if http.StatusCreated != httpResp.StatusCode {
+		bodyBytes, err := ioutil.ReadAll(httpResp.Body)
+		if err != nil {
+			return nil, err
+		}
+		return nil, errors.New(string(bodyBytes))
+	}
+
+	var prunedEntries []*model.CacheEntry
+	return prunedEntries, json.NewDecoder(httpResp.Body).Decode(&prunedEntries)
+}
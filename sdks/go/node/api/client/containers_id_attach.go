@@ -0,0 +1,122 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/websocket"
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/node/api"
+)
+
+func (c apiClient) AttachContainer(
+	ctx context.Context,
+	req model.AttachContainerReq,
+) error {
+
+	reqURL := c.baseURL
+	reqURL.Scheme = "ws"
+	reqURL.Path = path.Join(reqURL.Path, strings.Replace(api.URLContainers_ID_Attach, "{id}", url.PathEscape(req.ContainerID), 1))
+
+	wsConn, _, err := c.wsDialer.DialContext(
+		ctx,
+		reqURL.String(),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	// ensure web socket closed on exit
+	defer wsConn.Close()
+
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		// unblock reads once ctx is done
+		select {
+		case <-ctx.Done():
+			wsConn.Close()
+		case <-done:
+		}
+	}()
+
+	// web sockets support at most one concurrent writer
+	var writeMutex sync.Mutex
+	writeMessage := func(messageType int, data []byte) error {
+		writeMutex.Lock()
+		defer writeMutex.Unlock()
+		return wsConn.WriteMessage(messageType, data)
+	}
+
+	if req.Resizes != nil {
+		go func() {
+			for {
+				select {
+				case <-done:
+					return
+				case size, ok := <-req.Resizes:
+					if !ok {
+						return
+					}
+
+					sizeBytes, err := json.Marshal(size)
+					if err != nil {
+						return
+					}
+
+					if err := writeMessage(websocket.TextMessage, sizeBytes); err != nil {
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	if req.Stdin != nil {
+		go func() {
+			buffer := make([]byte, 4096)
+			for {
+				n, err := req.Stdin.Read(buffer)
+				if n > 0 {
+					if err := writeMessage(websocket.BinaryMessage, buffer[:n]); err != nil {
+						return
+					}
+				}
+				if err != nil {
+					return
+				}
+			}
+		}()
+	}
+
+	for {
+		messageType, message, err := wsConn.ReadMessage()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			var closeErr *websocket.CloseError
+			if errors.As(err, &closeErr) {
+				if closeErr.Code == websocket.CloseNormalClosure {
+					return nil
+				}
+				return errors.New(closeErr.Text)
+			}
+			return err
+		}
+
+		if messageType == websocket.BinaryMessage && req.Stdout != nil {
+			if _, err := req.Stdout.Write(message); err != nil {
+				return err
+			}
+		}
+	}
+}
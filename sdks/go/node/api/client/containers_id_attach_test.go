@@ -0,0 +1,48 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/url"
+
+	iwebsocket "github.com/golang-interfaces/github.com-gorilla-websocket"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+var _ = Context("AttachContainer", func() {
+	It("should call wsDialer.DialContext() w/ expected args", func() {
+		/* arrange */
+		providedCtx := context.Background()
+
+		expectedReqURL := url.URL{
+			Scheme: "ws",
+			Path:   "/containers/dummyContainerID/attach",
+		}
+
+		fakeWSDialer := new(iwebsocket.FakeDialer)
+		// error to trigger immediate return
+		fakeWSDialer.DialContextReturns(nil, nil, errors.New("dummyError"))
+
+		objectUnderTest := apiClient{
+			wsDialer: fakeWSDialer,
+		}
+
+		/* act */
+		actualErr := objectUnderTest.AttachContainer(
+			providedCtx,
+			model.AttachContainerReq{
+				ContainerID: "dummyContainerID",
+			},
+		)
+
+		/* assert */
+		actualCtx,
+			actualReqURL, _ := fakeWSDialer.DialContextArgsForCall(0)
+
+		Expect(actualErr).To(MatchError("dummyError"))
+		Expect(actualCtx).To(Equal(providedCtx))
+		Expect(actualReqURL).To(Equal(expectedReqURL.String()))
+	})
+})
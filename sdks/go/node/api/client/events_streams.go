@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"path"
+	"strconv"
 	"strings"
 	"time"
 
@@ -20,6 +21,21 @@ func (c apiClient) GetEventStream(
 	reqURL.Path = path.Join(reqURL.Path, api.URLEvents_Stream)
 
 	queryValues := reqURL.Query()
+	if req.Filter.AfterSequence != 0 {
+		queryValues.Add("afterSequence", strconv.FormatUint(req.Filter.AfterSequence, 10))
+	}
+	if req.Filter.CallIDs != nil {
+		queryValues.Add("callIds", strings.Join(req.Filter.CallIDs, ","))
+	}
+	if req.Filter.ContainerIDs != nil {
+		queryValues.Add("containerIds", strings.Join(req.Filter.ContainerIDs, ","))
+	}
+	if req.Filter.Kinds != nil {
+		queryValues.Add("kinds", strings.Join(req.Filter.Kinds, ","))
+	}
+	if req.Filter.Outcomes != nil {
+		queryValues.Add("outcomes", strings.Join(req.Filter.Outcomes, ","))
+	}
 	if req.Filter.Since != nil {
 		queryValues.Add("since", req.Filter.Since.Format(time.RFC3339))
 	}
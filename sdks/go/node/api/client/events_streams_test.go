@@ -23,7 +23,12 @@ var _ = Context("GetEventStream", func() {
 		providedSince := time.Now().UTC()
 		providedReq := &model.GetEventStreamReq{
 			Filter: model.EventFilter{
-				Since: &providedSince,
+				AfterSequence: 2,
+				CallIDs:       []string{"dummyCallID"},
+				ContainerIDs:  []string{"dummyContainerID"},
+				Kinds:         []string{model.EventKindCallEnded},
+				Outcomes:      []string{model.OpOutcomeFailed},
+				Since:         &providedSince,
 				Roots: []string{
 					"dummyRoot",
 				},
@@ -36,6 +41,11 @@ var _ = Context("GetEventStream", func() {
 		expectedReqURL.Path = api.URLEvents_Stream
 
 		queryValues := expectedReqURL.Query()
+		queryValues.Add("afterSequence", "2")
+		queryValues.Add("callIds", "dummyCallID")
+		queryValues.Add("containerIds", "dummyContainerID")
+		queryValues.Add("kinds", model.EventKindCallEnded)
+		queryValues.Add("outcomes", model.OpOutcomeFailed)
 		if providedReq.Filter.Since != nil {
 			queryValues.Add("since", providedReq.Filter.Since.Format(time.RFC3339))
 		}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/node/api"
+)
+
+func (c apiClient) GetOp(
+	ctx context.Context,
+	id string,
+) (
+	*model.OpSummary,
+	error,
+) {
+
+	reqURL := c.baseURL
+	reqURL.Path = path.Join(reqURL.Path, strings.Replace(api.URLOps_ID, "{id}", url.PathEscape(id), 1))
+
+	httpReq, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		reqURL.String(),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	httpResp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	// don't leak resources
+	defer httpResp.Body.Close()
+
+	switch httpResp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, model.ErrOpNotFound{}
+	default:
+		bodyBytes, err := ioutil.ReadAll(httpResp.Body)
+		if err != nil {
+			return nil, err
+		}
+		return nil, errors.New(string(bodyBytes))
+	}
+
+	opSummary := &model.OpSummary{}
+	return opSummary, json.NewDecoder(httpResp.Body).Decode(opSummary)
+}
@@ -0,0 +1,76 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/golang-interfaces/ihttp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/node/api"
+)
+
+var _ = Context("GetOp", func() {
+
+	It("should call httpClient.Do() with expected args & return result", func() {
+
+		/* arrange */
+		providedCtx := context.TODO()
+		providedID := "dummyID"
+
+		expectedReqURL := url.URL{}
+		expectedReqURL.Path = api.URLOps + "/" + providedID
+
+		fakeHttpClient := new(ihttp.FakeClient)
+		fakeHttpClient.DoReturns(
+			&http.Response{
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"id":"dummyID","outcome":"SUCCEEDED"}`))),
+				StatusCode: http.StatusOK,
+			},
+			nil,
+		)
+
+		objectUnderTest := apiClient{
+			httpClient: fakeHttpClient,
+		}
+
+		/* act */
+		actualOpSummary, actualErr := objectUnderTest.GetOp(providedCtx, providedID)
+
+		/* assert */
+		actualHTTPReq := fakeHttpClient.DoArgsForCall(0)
+
+		Expect(actualHTTPReq.URL.String()).To(Equal(expectedReqURL.String()))
+		Expect(actualHTTPReq.Context()).To(Equal(providedCtx))
+		Expect(actualErr).To(BeNil())
+		Expect(*actualOpSummary).To(Equal(model.OpSummary{ID: providedID, Outcome: model.OpOutcomeSucceeded}))
+	})
+	Context("StatusCode is 404", func() {
+		It("should return ErrOpNotFound", func() {
+
+			/* arrange */
+			fakeHttpClient := new(ihttp.FakeClient)
+			fakeHttpClient.DoReturns(
+				&http.Response{
+					Body:       ioutil.NopCloser(bytes.NewReader([]byte("op not found"))),
+					StatusCode: http.StatusNotFound,
+				},
+				nil,
+			)
+
+			objectUnderTest := apiClient{
+				httpClient: fakeHttpClient,
+			}
+
+			/* act */
+			_, actualErr := objectUnderTest.GetOp(context.TODO(), "dummyID")
+
+			/* assert */
+			Expect(actualErr).To(Equal(model.ErrOpNotFound{}))
+		})
+	})
+})
@@ -0,0 +1,2 @@
+// Package cache exposes functionality for handling "cache" requests.
+package cache
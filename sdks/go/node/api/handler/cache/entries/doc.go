@@ -0,0 +1,2 @@
+// Package entries exposes functionality for handling "cache/entries" requests.
+package entries
@@ -0,0 +1,79 @@
+package entries
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/node/api"
+	nodeFakes "github.com/opctl/opctl/sdks/go/node/fakes"
+)
+
+var _ = Context("Handler", func() {
+	Context("NewHandler", func() {
+		It("should not return nil", func() {
+			/* arrange/act/assert */
+			Expect(NewHandler(new(nodeFakes.FakeNode))).Should(Not(BeNil()))
+		})
+	})
+	Context("Handle", func() {
+		Context("node.ListCacheEntries errors", func() {
+			It("should return StatusCode of 500", func() {
+				/* arrange */
+				fakeNode := new(nodeFakes.FakeNode)
+				fakeNode.ListCacheEntriesReturns(nil, errors.New("dummyError"))
+
+				objectUnderTest := _handler{
+					node: fakeNode,
+				}
+				providedHTTPResp := httptest.NewRecorder()
+
+				providedHTTPReq, err := http.NewRequest(http.MethodGet, api.URLCache_Entries, nil)
+				if err != nil {
+					panic(err.Error())
+				}
+
+				/* act */
+				objectUnderTest.Handle(providedHTTPResp, providedHTTPReq)
+
+				/* assert */
+				Expect(providedHTTPResp.Code).To(Equal(http.StatusInternalServerError))
+			})
+		})
+		Context("node.ListCacheEntries doesn't error", func() {
+			It("should return expected result", func() {
+				/* arrange */
+				expectedEntries := []*model.CacheEntry{{Key: "dummyKey"}}
+
+				fakeNode := new(nodeFakes.FakeNode)
+				fakeNode.ListCacheEntriesReturns(expectedEntries, nil)
+
+				objectUnderTest := _handler{
+					node: fakeNode,
+				}
+				providedHTTPResp := httptest.NewRecorder()
+
+				providedHTTPReq, err := http.NewRequest(http.MethodGet, api.URLCache_Entries, nil)
+				if err != nil {
+					panic(err.Error())
+				}
+
+				/* act */
+				objectUnderTest.Handle(providedHTTPResp, providedHTTPReq)
+
+				/* assert */
+				Expect(providedHTTPResp.Code).To(Equal(http.StatusOK))
+
+				actualEntries := []*model.CacheEntry{}
+				if err := json.NewDecoder(providedHTTPResp.Body).Decode(&actualEntries); err != nil {
+					panic(err)
+				}
+				Expect(actualEntries).To(Equal(expectedEntries))
+			})
+		})
+	})
+})
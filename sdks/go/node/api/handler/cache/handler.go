@@ -0,0 +1,62 @@
+package cache
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
+
+import (
+	"net/http"
+
+	"github.com/opctl/opctl/sdks/go/internal/urlpath"
+	"github.com/opctl/opctl/sdks/go/node"
+	"github.com/opctl/opctl/sdks/go/node/api/handler/cache/entries"
+	"github.com/opctl/opctl/sdks/go/node/api/handler/cache/prunes"
+)
+
+//counterfeiter:generate -o fakes/handler.go . Handler
+type Handler interface {
+	Handle(
+		httpResp http.ResponseWriter,
+		httpReq *http.Request,
+	)
+}
+
+// NewHandler returns an initialized Handler instance
+func NewHandler(
+	node node.Node,
+) Handler {
+	return _handler{
+		entriesHandler: entries.NewHandler(node),
+		prunesHandler:  prunes.NewHandler(node),
+	}
+}
+
+type _handler struct {
+	entriesHandler entries.Handler
+	prunesHandler  prunes.Handler
+}
+
+func (hdlr _handler) Handle(
+	httpResp http.ResponseWriter,
+	httpReq *http.Request,
+) {
+	pathSegment, err := urlpath.NextSegment(httpReq.URL)
+	if err != nil {
+		http.Error(httpResp, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	switch pathSegment {
+	case "entries":
+		hdlr.entriesHandler.Handle(
+			httpResp,
+			httpReq,
+		)
+	case "prunes":
+		hdlr.prunesHandler.Handle(
+			httpResp,
+			httpReq,
+		)
+	default:
+		http.NotFoundHandler().ServeHTTP(httpResp, httpReq)
+		return
+	}
+}
@@ -0,0 +1,101 @@
+package cache
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	entriesFakes "github.com/opctl/opctl/sdks/go/node/api/handler/cache/entries/fakes"
+	prunesFakes "github.com/opctl/opctl/sdks/go/node/api/handler/cache/prunes/fakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	nodeFakes "github.com/opctl/opctl/sdks/go/node/fakes"
+)
+
+var _ = Context("Handler", func() {
+	Context("NewHandler", func() {
+		It("should not return nil", func() {
+			/* arrange/act/assert */
+			Expect(NewHandler(new(nodeFakes.FakeNode))).Should(Not(BeNil()))
+		})
+	})
+	Context("Handle", func() {
+		Context("next URL path segment isn't entries or prunes", func() {
+			It("should return expected result", func() {
+				/* arrange */
+				objectUnderTest := _handler{}
+				providedHTTPResp := httptest.NewRecorder()
+
+				providedHTTPReq, err := http.NewRequest("dummyMethod", "", nil)
+				if err != nil {
+					panic(err.Error())
+				}
+
+				/* act */
+				objectUnderTest.Handle(providedHTTPResp, providedHTTPReq)
+
+				/* assert */
+				Expect(providedHTTPResp.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+		Context("next URL path segment is entries", func() {
+			It("should call entriesHandler.Handle w/ expected args", func() {
+				/* arrange */
+				fakeEntriesHandler := new(entriesFakes.FakeHandler)
+
+				objectUnderTest := _handler{
+					entriesHandler: fakeEntriesHandler,
+				}
+
+				providedPath := "entries/dummy"
+				providedHTTPReq, err := http.NewRequest("dummyMethod", providedPath, nil)
+				if err != nil {
+					panic(err.Error())
+				}
+
+				expectedURLPath := strings.SplitN(providedPath, "/", 2)[1]
+
+				/* act */
+				objectUnderTest.Handle(httptest.NewRecorder(), providedHTTPReq)
+
+				/* assert */
+				_, actualHTTPReq := fakeEntriesHandler.HandleArgsForCall(0)
+
+				Expect(actualHTTPReq.URL.Path).To(Equal(expectedURLPath))
+
+				// this works because our URL path set mutates the httpRequest
+				Expect(actualHTTPReq).To(Equal(providedHTTPReq))
+			})
+		})
+		Context("next URL path segment is prunes", func() {
+			It("should call prunesHandler.Handle w/ expected args", func() {
+				/* arrange */
+				fakePrunesHandler := new(prunesFakes.FakeHandler)
+
+				objectUnderTest := _handler{
+					prunesHandler: fakePrunesHandler,
+				}
+
+				providedPath := "prunes/dummy"
+				providedHTTPReq, err := http.NewRequest("dummyMethod", providedPath, nil)
+				if err != nil {
+					panic(err.Error())
+				}
+
+				expectedURLPath := strings.SplitN(providedPath, "/", 2)[1]
+
+				/* act */
+				objectUnderTest.Handle(httptest.NewRecorder(), providedHTTPReq)
+
+				/* assert */
+				_, actualHTTPReq := fakePrunesHandler.HandleArgsForCall(0)
+
+				Expect(actualHTTPReq.URL.Path).To(Equal(expectedURLPath))
+
+				// this works because our URL path set mutates the httpRequest
+				Expect(actualHTTPReq).To(Equal(providedHTTPReq))
+			})
+		})
+	})
+})
@@ -0,0 +1,2 @@
+// Package prunes exposes functionality for handling "cache/prunes" requests.
+package prunes
@@ -0,0 +1,59 @@
+package prunes
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/node"
+)
+
+//counterfeiter:generate -o fakes/handler.go . Handler
+type Handler interface {
+	Handle(
+		res http.ResponseWriter,
+		req *http.Request,
+	)
+}
+
+// NewHandler returns an initialized Handler instance
+func NewHandler(
+	node node.Node,
+) Handler {
+	return _handler{
+		node: node,
+	}
+}
+
+type _handler struct {
+	node node.Node
+}
+
+func (hdlr _handler) Handle(
+	httpResp http.ResponseWriter,
+	httpReq *http.Request,
+) {
+	pruneCacheReq := model.PruneCacheReq{}
+
+	err := json.NewDecoder(httpReq.Body).Decode(&pruneCacheReq)
+	if err != nil {
+		http.Error(httpResp, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	prunedEntries, err := hdlr.node.PruneCache(httpReq.Context(), pruneCacheReq)
+	if err != nil {
+		http.Error(httpResp, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	httpResp.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	httpResp.WriteHeader(http.StatusCreated)
+
+	if err := json.NewEncoder(httpResp).Encode(prunedEntries); err != nil {
+		http.Error(httpResp, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
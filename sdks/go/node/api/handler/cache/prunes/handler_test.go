@@ -0,0 +1,80 @@
+package prunes
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/node/api"
+	nodeFakes "github.com/opctl/opctl/sdks/go/node/fakes"
+)
+
+var _ = Context("Handler", func() {
+	Context("NewHandler", func() {
+		It("should not return nil", func() {
+			/* arrange/act/assert */
+			Expect(NewHandler(new(nodeFakes.FakeNode))).Should(Not(BeNil()))
+		})
+	})
+	Context("Handle", func() {
+		Context("json.Decoder.Decode errors", func() {
+			It("should return StatusCode of 400", func() {
+				/* arrange */
+				objectUnderTest := _handler{
+					node: new(nodeFakes.FakeNode),
+				}
+				providedHTTPResp := httptest.NewRecorder()
+
+				providedHTTPReq, err := http.NewRequest(http.MethodPost, api.URLCache_Prunes, bytes.NewReader([]byte{}))
+				if err != nil {
+					panic(err.Error())
+				}
+
+				/* act */
+				objectUnderTest.Handle(providedHTTPResp, providedHTTPReq)
+
+				/* assert */
+				Expect(providedHTTPResp.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+		Context("json.Decoder.Decode doesn't error", func() {
+			It("should call node.PruneCache w/ expected args", func() {
+				/* arrange */
+				providedBefore := time.Now().UTC().Truncate(time.Second)
+				expectedReq := model.PruneCacheReq{
+					Before: &providedBefore,
+				}
+
+				fakeNode := new(nodeFakes.FakeNode)
+
+				objectUnderTest := _handler{
+					node: fakeNode,
+				}
+				providedHTTPResp := httptest.NewRecorder()
+
+				reqBytes, err := json.Marshal(expectedReq)
+				if err != nil {
+					panic(err)
+				}
+
+				providedHTTPReq, err := http.NewRequest(http.MethodPost, api.URLCache_Prunes, bytes.NewReader(reqBytes))
+				if err != nil {
+					panic(err.Error())
+				}
+
+				/* act */
+				objectUnderTest.Handle(providedHTTPResp, providedHTTPReq)
+
+				/* assert */
+				_, actualReq := fakeNode.PruneCacheArgsForCall(0)
+				Expect(*actualReq.Before).To(BeTemporally("==", providedBefore))
+				Expect(providedHTTPResp.Code).To(Equal(http.StatusCreated))
+			})
+		})
+	})
+})
@@ -0,0 +1,2 @@
+// Package attach exposes functionality for handling "containers/{id}/attach" requests.
+package attach
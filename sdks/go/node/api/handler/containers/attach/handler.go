@@ -0,0 +1,138 @@
+package attach
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	iwebsocket "github.com/golang-interfaces/github.com-gorilla-websocket"
+	"github.com/gorilla/websocket"
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/node"
+)
+
+// max length of the reason of a websocket close message
+const maxCloseReasonLength = 123
+
+//counterfeiter:generate -o fakes/handler.go . Handler
+type Handler interface {
+	Handle(
+		containerID string,
+		res http.ResponseWriter,
+		req *http.Request,
+	)
+}
+
+// NewHandler returns an initialized Handler instance
+func NewHandler(
+	node node.Node,
+) Handler {
+	return _handler{
+		node: node,
+		upgrader: &websocket.Upgrader{
+			ReadBufferSize:  4096,
+			WriteBufferSize: 4096,
+			CheckOrigin: func(r *http.Request) bool {
+				return true
+			},
+		},
+	}
+}
+
+type _handler struct {
+	node     node.Node
+	upgrader iwebsocket.Upgrader
+}
+
+// Handle proxies a websocket to the TTY of a container.
+// Binary messages are std in/out of the TTY; text messages from the client are JSON encoded model.TerminalSize resizes.
+func (hdlr _handler) Handle(
+	containerID string,
+	httpResp http.ResponseWriter,
+	httpReq *http.Request,
+) {
+	conn, err := hdlr.upgrader.Upgrade(httpResp, httpReq, nil)
+	if err != nil {
+		http.Error(httpResp, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(httpReq.Context())
+	defer cancel()
+
+	stdinReader, stdinWriter := io.Pipe()
+	defer stdinReader.Close()
+
+	// buffered so the initial size isn't lost while the container is starting
+	resizes := make(chan model.TerminalSize, 1)
+
+	go func() {
+		// end the attachment once the client detaches
+		defer cancel()
+		defer stdinWriter.Close()
+
+		for {
+			messageType, message, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+
+			switch messageType {
+			case websocket.BinaryMessage:
+				if _, err := stdinWriter.Write(message); err != nil {
+					return
+				}
+			case websocket.TextMessage:
+				var size model.TerminalSize
+				if err := json.Unmarshal(message, &size); err != nil {
+					// ignore malformed resizes
+					continue
+				}
+
+				select {
+				case resizes <- size:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	err = hdlr.node.AttachContainer(
+		ctx,
+		model.AttachContainerReq{
+			ContainerID: containerID,
+			Stdin:       stdinReader,
+			Stdout:      wsWriter{conn: conn},
+			Resizes:     resizes,
+		},
+	)
+
+	closeMessage := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+	if err != nil {
+		reason := err.Error()
+		if len(reason) > maxCloseReasonLength {
+			reason = reason[:maxCloseReasonLength]
+		}
+		closeMessage = websocket.FormatCloseMessage(websocket.CloseInternalServerErr, reason)
+	}
+	conn.WriteControl(websocket.CloseMessage, closeMessage, time.Now().Add(time.Second))
+}
+
+// wsWriter writes to a websocket as binary messages
+type wsWriter struct {
+	conn *websocket.Conn
+}
+
+func (w wsWriter) Write(p []byte) (int, error) {
+	if err := w.conn.WriteMessage(websocket.BinaryMessage, p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -0,0 +1,148 @@
+package attach
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+
+	iwebsocket "github.com/golang-interfaces/github.com-gorilla-websocket"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/node/api"
+	"github.com/opctl/opctl/sdks/go/node/api/client"
+	nodeFakes "github.com/opctl/opctl/sdks/go/node/fakes"
+)
+
+var _ = Context("Handler", func() {
+	Context("NewHandler", func() {
+		It("should not return nil", func() {
+			/* arrange/act/assert */
+			Expect(NewHandler(new(nodeFakes.FakeNode))).Should(Not(BeNil()))
+		})
+	})
+	Context("Handle", func() {
+		Context("upgrader.Upgrade errors", func() {
+			It("should return StatusCode of 400", func() {
+				/* arrange */
+				fakeUpgrader := new(iwebsocket.FakeUpgrader)
+				fakeUpgrader.UpgradeReturns(nil, errors.New("dummyError"))
+
+				objectUnderTest := _handler{
+					node:     new(nodeFakes.FakeNode),
+					upgrader: fakeUpgrader,
+				}
+
+				providedHTTPResp := httptest.NewRecorder()
+
+				providedHTTPReq, err := http.NewRequest(http.MethodGet, api.URLContainers_ID_Attach, bytes.NewReader([]byte{}))
+				if err != nil {
+					panic(err.Error())
+				}
+
+				/* act */
+				objectUnderTest.Handle("dummyContainerID", providedHTTPResp, providedHTTPReq)
+
+				/* assert */
+				Expect(providedHTTPResp.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+		Context("upgrader.Upgrade doesn't error", func() {
+			It("should proxy stdin, stdout & resizes between client & node", func() {
+				/* arrange */
+				providedContainerID := "dummyContainerID"
+				providedResize := model.TerminalSize{Height: 24, Width: 80}
+
+				fakeNode := new(nodeFakes.FakeNode)
+				fakeNode.AttachContainerStub = func(
+					ctx context.Context,
+					req model.AttachContainerReq,
+				) error {
+					if req.ContainerID != providedContainerID {
+						return errors.New("unexpected containerID")
+					}
+
+					// echo a line of stdin
+					line := make([]byte, len("input\n"))
+					if _, err := io.ReadFull(req.Stdin, line); err != nil {
+						return err
+					}
+
+					if <-req.Resizes != providedResize {
+						return errors.New("unexpected resize")
+					}
+
+					_, err := req.Stdout.Write(line)
+					return err
+				}
+
+				server := httptest.NewServer(
+					http.HandlerFunc(func(httpResp http.ResponseWriter, httpReq *http.Request) {
+						NewHandler(fakeNode).Handle(providedContainerID, httpResp, httpReq)
+					}),
+				)
+				defer server.Close()
+
+				serverURL, err := url.Parse(server.URL)
+				if err != nil {
+					panic(err)
+				}
+
+				resizes := make(chan model.TerminalSize, 1)
+				resizes <- providedResize
+
+				actualStdout := new(bytes.Buffer)
+
+				/* act */
+				actualErr := client.New(*serverURL, nil).AttachContainer(
+					context.Background(),
+					model.AttachContainerReq{
+						ContainerID: providedContainerID,
+						Stdin:       strings.NewReader("input\n"),
+						Stdout:      actualStdout,
+						Resizes:     resizes,
+					},
+				)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualStdout.String()).To(Equal("input\n"))
+			})
+		})
+		Context("node.AttachContainer errors", func() {
+			It("should return expected error to client", func() {
+				/* arrange */
+				fakeNode := new(nodeFakes.FakeNode)
+				fakeNode.AttachContainerReturns(errors.New("dummyError"))
+
+				server := httptest.NewServer(
+					http.HandlerFunc(func(httpResp http.ResponseWriter, httpReq *http.Request) {
+						NewHandler(fakeNode).Handle("dummyContainerID", httpResp, httpReq)
+					}),
+				)
+				defer server.Close()
+
+				serverURL, err := url.Parse(server.URL)
+				if err != nil {
+					panic(err)
+				}
+
+				/* act */
+				actualErr := client.New(*serverURL, nil).AttachContainer(
+					context.Background(),
+					model.AttachContainerReq{
+						ContainerID: "dummyContainerID",
+					},
+				)
+
+				/* assert */
+				Expect(actualErr).To(MatchError("dummyError"))
+			})
+		})
+	})
+})
@@ -0,0 +1,2 @@
+// Package containers exposes functionality for handling "containers" requests.
+package containers
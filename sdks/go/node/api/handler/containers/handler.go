@@ -0,0 +1,60 @@
+package containers
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
+
+import (
+	"net/http"
+
+	"github.com/opctl/opctl/sdks/go/internal/urlpath"
+	"github.com/opctl/opctl/sdks/go/node"
+	"github.com/opctl/opctl/sdks/go/node/api/handler/containers/attach"
+)
+
+//counterfeiter:generate -o fakes/handler.go . Handler
+type Handler interface {
+	Handle(
+		httpResp http.ResponseWriter,
+		httpReq *http.Request,
+	)
+}
+
+// NewHandler returns an initialized Handler instance
+func NewHandler(
+	node node.Node,
+) Handler {
+	return _handler{
+		attachHandler: attach.NewHandler(node),
+	}
+}
+
+type _handler struct {
+	attachHandler attach.Handler
+}
+
+func (hdlr _handler) Handle(
+	httpResp http.ResponseWriter,
+	httpReq *http.Request,
+) {
+	containerID, err := urlpath.NextSegment(httpReq.URL)
+	if err != nil {
+		http.Error(httpResp, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	pathSegment, err := urlpath.NextSegment(httpReq.URL)
+	if err != nil {
+		http.Error(httpResp, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if containerID == "" || pathSegment != "attach" {
+		http.NotFoundHandler().ServeHTTP(httpResp, httpReq)
+		return
+	}
+
+	hdlr.attachHandler.Handle(
+		containerID,
+		httpResp,
+		httpReq,
+	)
+}
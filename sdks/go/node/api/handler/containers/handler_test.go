@@ -0,0 +1,72 @@
+package containers
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	attachFakes "github.com/opctl/opctl/sdks/go/node/api/handler/containers/attach/fakes"
+	nodeFakes "github.com/opctl/opctl/sdks/go/node/fakes"
+)
+
+var _ = Context("Handler", func() {
+	Context("NewHandler", func() {
+		It("should not return nil", func() {
+			/* arrange/act/assert */
+			Expect(NewHandler(new(nodeFakes.FakeNode))).Should(Not(BeNil()))
+		})
+	})
+	Context("Handle", func() {
+		Context("next URL path segment after id isn't attach", func() {
+			It("should return StatusCode of 404", func() {
+				/* arrange */
+				objectUnderTest := _handler{
+					attachHandler: new(attachFakes.FakeHandler),
+				}
+
+				providedHTTPResp := httptest.NewRecorder()
+
+				providedHTTPReq, err := http.NewRequest(http.MethodGet, "dummyContainerID/dummySegment", nil)
+				if err != nil {
+					panic(err.Error())
+				}
+
+				/* act */
+				objectUnderTest.Handle(providedHTTPResp, providedHTTPReq)
+
+				/* assert */
+				Expect(providedHTTPResp.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+		Context("next URL path segment after id is attach", func() {
+			It("should call attachHandler.Handle w/ expected args", func() {
+				/* arrange */
+				fakeAttachHandler := new(attachFakes.FakeHandler)
+
+				objectUnderTest := _handler{
+					attachHandler: fakeAttachHandler,
+				}
+
+				providedHTTPResp := httptest.NewRecorder()
+
+				providedHTTPReq, err := http.NewRequest(http.MethodGet, "dummyContainerID/attach", nil)
+				if err != nil {
+					panic(err.Error())
+				}
+
+				/* act */
+				objectUnderTest.Handle(providedHTTPResp, providedHTTPReq)
+
+				/* assert */
+				actualContainerID,
+					actualHTTPResp,
+					actualHTTPReq := fakeAttachHandler.HandleArgsForCall(0)
+
+				Expect(actualContainerID).To(Equal("dummyContainerID"))
+				Expect(actualHTTPResp).To(Equal(providedHTTPResp))
+				Expect(actualHTTPReq).To(Equal(providedHTTPReq))
+			})
+		})
+	})
+})
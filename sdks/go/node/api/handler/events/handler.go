@@ -7,6 +7,7 @@ import (
 
 	"github.com/opctl/opctl/sdks/go/internal/urlpath"
 	"github.com/opctl/opctl/sdks/go/node"
+	"github.com/opctl/opctl/sdks/go/node/api/handler/events/prunes"
 	"github.com/opctl/opctl/sdks/go/node/api/handler/events/stream"
 )
 
@@ -23,11 +24,13 @@ func NewHandler(
 	node node.Node,
 ) Handler {
 	return _handler{
+		prunesHandler: prunes.NewHandler(node),
 		streamHandler: stream.NewHandler(node),
 	}
 }
 
 type _handler struct {
+	prunesHandler prunes.Handler
 	streamHandler stream.Handler
 }
 
@@ -42,6 +45,11 @@ func (hdlr _handler) Handle(
 	}
 
 	switch pathSegment {
+	case "prunes":
+		hdlr.prunesHandler.Handle(
+			httpResp,
+			httpReq,
+		)
 	case "stream":
 		hdlr.streamHandler.Handle(
 			httpResp,
@@ -7,6 +7,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	prunesFakes "github.com/opctl/opctl/sdks/go/node/api/handler/events/prunes/fakes"
 	streamFakes "github.com/opctl/opctl/sdks/go/node/api/handler/events/stream/fakes"
 	nodeFakes "github.com/opctl/opctl/sdks/go/node/fakes"
 )
@@ -19,7 +20,7 @@ var _ = Context("Handler", func() {
 		})
 	})
 	Context("Handle", func() {
-		Context("next URL path segment isn't stream", func() {
+		Context("next URL path segment isn't prunes or stream", func() {
 			It("should return expected result", func() {
 				/* arrange */
 				objectUnderTest := _handler{}
@@ -37,6 +38,31 @@ var _ = Context("Handler", func() {
 				Expect(providedHTTPResp.Code).To(Equal(http.StatusNotFound))
 			})
 		})
+		Context("next URL path segment is prunes", func() {
+			It("should call prunesHandler.Handle w/ expected args", func() {
+				/* arrange */
+				fakePrunesHandler := new(prunesFakes.FakeHandler)
+
+				objectUnderTest := _handler{
+					prunesHandler: fakePrunesHandler,
+				}
+
+				providedPath := "prunes"
+				providedHTTPReq, err := http.NewRequest("dummyMethod", providedPath, nil)
+				if err != nil {
+					panic(err.Error())
+				}
+
+				/* act */
+				objectUnderTest.Handle(httptest.NewRecorder(), providedHTTPReq)
+
+				/* assert */
+				_, actualHTTPReq := fakePrunesHandler.HandleArgsForCall(0)
+
+				// this works because our URL path set mutates the httpRequest
+				Expect(actualHTTPReq).To(Equal(providedHTTPReq))
+			})
+		})
 		Context("next URL path segment is stream", func() {
 			It("should call refHandler.Handle w/ expected args", func() {
 				/* arrange */
@@ -0,0 +1,2 @@
+// Package prunes exposes functionality for handling "events/prunes" requests.
+package prunes
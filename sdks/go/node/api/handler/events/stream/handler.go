@@ -5,6 +5,7 @@ package stream
 import (
 	"context"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -56,6 +57,15 @@ func (hdlr _handler) Handle(
 	defer conn.Close()
 
 	req := &model.GetEventStreamReq{Filter: model.EventFilter{}}
+	if afterSequenceString := httpReq.URL.Query().Get("afterSequence"); afterSequenceString != "" {
+		afterSequence, err := strconv.ParseUint(afterSequenceString, 10, 64)
+		if err != nil {
+			http.Error(httpResp, err.Error(), http.StatusBadRequest)
+			return
+		}
+		req.Filter.AfterSequence = afterSequence
+	}
+
 	if sinceString := httpReq.URL.Query().Get("since"); sinceString != "" {
 		sinceTime, err := time.Parse(time.RFC3339, sinceString)
 		if err != nil {
@@ -65,6 +75,22 @@ func (hdlr _handler) Handle(
 		req.Filter.Since = &sinceTime
 	}
 
+	if callIDsString := httpReq.URL.Query().Get("callIds"); callIDsString != "" {
+		req.Filter.CallIDs = strings.Split(callIDsString, ",")
+	}
+
+	if containerIDsString := httpReq.URL.Query().Get("containerIds"); containerIDsString != "" {
+		req.Filter.ContainerIDs = strings.Split(containerIDsString, ",")
+	}
+
+	if kindsString := httpReq.URL.Query().Get("kinds"); kindsString != "" {
+		req.Filter.Kinds = strings.Split(kindsString, ",")
+	}
+
+	if outcomesString := httpReq.URL.Query().Get("outcomes"); outcomesString != "" {
+		req.Filter.Outcomes = strings.Split(outcomesString, ",")
+	}
+
 	if rootsString := httpReq.URL.Query().Get("roots"); rootsString != "" {
 		rootsArray := strings.Split(rootsString, ",")
 		req.Filter.Roots = rootsArray
@@ -51,6 +51,81 @@ var _ = Context("Handler", func() {
 
 			})
 		})
+		Context("nonempty afterSequence", func() {
+			Context("strconv.ParseUint errors", func() {
+				It("should return StatusCode of 400", func() {
+
+					/* arrange */
+					objectUnderTest := _handler{
+						node: new(nodeFakes.FakeNode),
+					}
+
+					providedHTTPResp := httptest.NewRecorder()
+
+					providedHTTPReq, err := http.NewRequest(
+						http.MethodGet,
+						fmt.Sprintf("%v?afterSequence=%v", api.URLEvents_Stream, "notValidSequence"),
+						bytes.NewReader([]byte{}),
+					)
+					if err != nil {
+						panic(err.Error())
+					}
+
+					/* act */
+					defer func() {
+						// conn.Close() will panic so recover (no way to fake it)
+						recover()
+					}()
+					objectUnderTest.Handle(providedHTTPResp, providedHTTPReq)
+
+					/* assert */
+					Expect(providedHTTPResp.Code).To(Equal(http.StatusBadRequest))
+
+				})
+			})
+			Context("strconv.ParseUint doesn't error", func() {
+				It("should call core.GetEventStream w/ expected args", func() {
+
+					/* arrange */
+					fakeCore := new(nodeFakes.FakeNode)
+					eventChannel := make(chan model.Event)
+					// close eventChannel to trigger immediate return
+					close(eventChannel)
+					fakeCore.GetEventStreamReturns(eventChannel, nil)
+
+					objectUnderTest := _handler{
+						node: fakeCore,
+					}
+
+					expectedReq := &model.GetEventStreamReq{
+						Filter: model.EventFilter{
+							AfterSequence: 2,
+						},
+					}
+
+					providedHTTPReq, err := http.NewRequest(
+						http.MethodGet,
+						fmt.Sprintf("%v?afterSequence=%v", api.URLEvents_Stream, expectedReq.Filter.AfterSequence),
+						bytes.NewReader([]byte{}),
+					)
+					if err != nil {
+						panic(err.Error())
+					}
+
+					/* act */
+					defer func() {
+						// conn.Close() will panic so recover (no way to fake it)
+						recover()
+					}()
+					objectUnderTest.Handle(httptest.NewRecorder(), providedHTTPReq)
+
+					/* assert */
+					_, actualReq := fakeCore.GetEventStreamArgsForCall(0)
+					Expect(*actualReq).To(Equal(*expectedReq))
+
+				})
+			})
+		})
 		Context("nonempty since", func() {
 			Context("time.Parse errors", func() {
 				It("should return StatusCode of 400", func() {
@@ -129,6 +204,54 @@ var _ = Context("Handler", func() {
 				})
 			})
 		})
+		Context("nonempty callIds, containerIds, kinds & outcomes", func() {
+			It("should call core.GetEventStream w/ expected args", func() {
+
+				/* arrange */
+				fakeCore := new(nodeFakes.FakeNode)
+				eventChannel := make(chan model.Event)
+				// close eventChannel to trigger immediate return
+				close(eventChannel)
+				fakeCore.GetEventStreamReturns(eventChannel, nil)
+
+				objectUnderTest := _handler{
+					node: fakeCore,
+				}
+
+				expectedReq := &model.GetEventStreamReq{
+					Filter: model.EventFilter{
+						CallIDs:      []string{"callID1", "callID2"},
+						ContainerIDs: []string{"containerID"},
+						Kinds:        []string{model.EventKindCallStarted, model.EventKindCallEnded},
+						Outcomes:     []string{model.OpOutcomeFailed},
+					},
+				}
+
+				providedHTTPReq, err := http.NewRequest(
+					http.MethodGet,
+					fmt.Sprintf(
+						"%v?callIds=callID1,callID2&containerIds=containerID&kinds=callStarted,callEnded&outcomes=FAILED",
+						api.URLEvents_Stream,
+					),
+					bytes.NewReader([]byte{}),
+				)
+				if err != nil {
+					panic(err.Error())
+				}
+
+				/* act */
+				defer func() {
+					// conn.Close() will panic so recover (no way to fake it)
+					recover()
+				}()
+				objectUnderTest.Handle(httptest.NewRecorder(), providedHTTPReq)
+
+				/* assert */
+				_, actualReq := fakeCore.GetEventStreamArgsForCall(0)
+				Expect(*actualReq).To(Equal(*expectedReq))
+
+			})
+		})
 		Context("nonempty roots", func() {
 			It("should call core.GetEventStream w/ expected args", func() {
 
@@ -5,6 +5,8 @@ import (
 
 	"github.com/opctl/opctl/sdks/go/internal/urlpath"
 	"github.com/opctl/opctl/sdks/go/node/api/handler/auths"
+	"github.com/opctl/opctl/sdks/go/node/api/handler/cache"
+	"github.com/opctl/opctl/sdks/go/node/api/handler/containers"
 	"github.com/opctl/opctl/sdks/go/node/api/handler/data"
 	"github.com/opctl/opctl/sdks/go/node/api/handler/events"
 	"github.com/opctl/opctl/sdks/go/node/api/handler/liveness"
@@ -21,20 +23,24 @@ func New(
 	core core.Core,
 ) http.Handler {
 	return _handler{
-		authsHandler:    auths.NewHandler(core),
-		dataHandler:     data.NewHandler(core),
-		eventsHandler:   events.NewHandler(core),
-		livenessHandler: liveness.NewHandler(core),
-		opsHandler:      ops.NewHandler(core),
+		authsHandler:      auths.NewHandler(core),
+		cacheHandler:      cache.NewHandler(core),
+		containersHandler: containers.NewHandler(core),
+		dataHandler:       data.NewHandler(core),
+		eventsHandler:     events.NewHandler(core),
+		livenessHandler:   liveness.NewHandler(core),
+		opsHandler:        ops.NewHandler(core),
 	}
 }
 
 type _handler struct {
-	authsHandler    auths.Handler
-	dataHandler     data.Handler
-	eventsHandler   events.Handler
-	livenessHandler liveness.Handler
-	opsHandler      ops.Handler
+	authsHandler      auths.Handler
+	cacheHandler      cache.Handler
+	containersHandler containers.Handler
+	dataHandler       data.Handler
+	eventsHandler     events.Handler
+	livenessHandler   liveness.Handler
+	opsHandler        ops.Handler
 }
 
 func (hdlr _handler) ServeHTTP(
@@ -53,6 +59,10 @@ func (hdlr _handler) ServeHTTP(
 	switch pathSegment {
 	case "auths":
 		hdlr.authsHandler.Handle(httpResp, httpReq)
+	case "cache":
+		hdlr.cacheHandler.Handle(httpResp, httpReq)
+	case "containers":
+		hdlr.containersHandler.Handle(httpResp, httpReq)
 	case "data":
 		hdlr.dataHandler.Handle(httpResp, httpReq)
 	case "events":
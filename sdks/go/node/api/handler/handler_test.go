@@ -8,6 +8,8 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	authsFakes "github.com/opctl/opctl/sdks/go/node/api/handler/auths/fakes"
+	cacheFakes "github.com/opctl/opctl/sdks/go/node/api/handler/cache/fakes"
+	containersFakes "github.com/opctl/opctl/sdks/go/node/api/handler/containers/fakes"
 	dataFakes "github.com/opctl/opctl/sdks/go/node/api/handler/data/fakes"
 	eventsFakes "github.com/opctl/opctl/sdks/go/node/api/handler/events/fakes"
 	livenessFakes "github.com/opctl/opctl/sdks/go/node/api/handler/liveness/fakes"
@@ -70,6 +72,64 @@ var _ = Context("Handler", func() {
 				Expect(actualHTTPReq).To(Equal(providedHTTPReq))
 			})
 		})
+		Context("next URL path segment is cache", func() {
+			It("should call cacheHandler.Handle w/ expected args", func() {
+				/* arrange */
+				fakeCacheHandler := new(cacheFakes.FakeHandler)
+
+				objectUnderTest := _handler{
+					cacheHandler: fakeCacheHandler,
+				}
+
+				providedPath := "cache/entries"
+				providedHTTPReq, err := http.NewRequest("dummyMethod", providedPath, nil)
+				if err != nil {
+					panic(err.Error())
+				}
+
+				expectedURLPath := strings.SplitN(providedPath, "/", 2)[1]
+
+				/* act */
+				objectUnderTest.ServeHTTP(httptest.NewRecorder(), providedHTTPReq)
+
+				/* assert */
+				_, actualHTTPReq := fakeCacheHandler.HandleArgsForCall(0)
+
+				Expect(actualHTTPReq.URL.Path).To(Equal(expectedURLPath))
+
+				// this works because our URL path set mutates the httpRequest
+				Expect(actualHTTPReq).To(Equal(providedHTTPReq))
+			})
+		})
+		Context("next URL path segment is containers", func() {
+			It("should call containersHandler.Handle w/ expected args", func() {
+				/* arrange */
+				fakeContainersHandler := new(containersFakes.FakeHandler)
+
+				objectUnderTest := _handler{
+					containersHandler: fakeContainersHandler,
+				}
+
+				providedPath := "containers/dummyID/attach"
+				providedHTTPReq, err := http.NewRequest("dummyMethod", providedPath, nil)
+				if err != nil {
+					panic(err.Error())
+				}
+
+				expectedURLPath := strings.SplitN(providedPath, "/", 2)[1]
+
+				/* act */
+				objectUnderTest.ServeHTTP(httptest.NewRecorder(), providedHTTPReq)
+
+				/* assert */
+				_, actualHTTPReq := fakeContainersHandler.HandleArgsForCall(0)
+
+				Expect(actualHTTPReq.URL.Path).To(Equal(expectedURLPath))
+
+				// this works because our URL path set mutates the httpRequest
+				Expect(actualHTTPReq).To(Equal(providedHTTPReq))
+			})
+		})
 		Context("next URL path segment is data", func() {
 			It("should call dataHandler.Handle w/ expected args", func() {
 				/* arrange */
@@ -3,10 +3,12 @@ package ops
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
 
 import (
+	"encoding/json"
 	"net/http"
 
 	"github.com/opctl/opctl/sdks/go/internal/urlpath"
 	"github.com/opctl/opctl/sdks/go/node"
+	"github.com/opctl/opctl/sdks/go/node/api/handler/ops/id"
 	"github.com/opctl/opctl/sdks/go/node/api/handler/ops/kills"
 	"github.com/opctl/opctl/sdks/go/node/api/handler/ops/starts"
 )
@@ -24,14 +26,18 @@ func NewHandler(
 	node node.Node,
 ) Handler {
 	return _handler{
+		idHandler:     id.NewHandler(node),
 		startsHandler: starts.NewHandler(node),
 		killsHandler:  kills.NewHandler(node),
+		node:          node,
 	}
 }
 
 type _handler struct {
+	idHandler     id.Handler
 	startsHandler starts.Handler
 	killsHandler  kills.Handler
+	node          node.Node
 }
 
 func (hdlr _handler) Handle(
@@ -45,6 +51,11 @@ func (hdlr _handler) Handle(
 	}
 
 	switch pathSegment {
+	case "":
+		hdlr.handleList(
+			httpResp,
+			httpReq,
+		)
 	case "kills":
 		hdlr.killsHandler.Handle(
 			httpResp,
@@ -56,7 +67,29 @@ func (hdlr _handler) Handle(
 			httpReq,
 		)
 	default:
-		http.NotFoundHandler().ServeHTTP(httpResp, httpReq)
+		hdlr.idHandler.Handle(
+			pathSegment,
+			httpResp,
+			httpReq,
+		)
+	}
+}
+
+func (hdlr _handler) handleList(
+	httpResp http.ResponseWriter,
+	httpReq *http.Request,
+) {
+	opSummaries, err := hdlr.node.ListOps(httpReq.Context())
+	if err != nil {
+		http.Error(httpResp, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	httpResp.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	httpResp.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(httpResp).Encode(opSummaries); err != nil {
+		http.Error(httpResp, err.Error(), http.StatusInternalServerError)
 		return
 	}
 }
@@ -1,15 +1,18 @@
 package ops
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 
+	idFakes "github.com/opctl/opctl/sdks/go/node/api/handler/ops/id/fakes"
 	killsFakes "github.com/opctl/opctl/sdks/go/node/api/handler/ops/kills/fakes"
 	startsFakes "github.com/opctl/opctl/sdks/go/node/api/handler/ops/starts/fakes"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
 	nodeFakes "github.com/opctl/opctl/sdks/go/node/fakes"
 )
 
@@ -21,13 +24,20 @@ var _ = Context("Handler", func() {
 		})
 	})
 	Context("Handle", func() {
-		Context("next URL path segment isn't starts or kills", func() {
+		Context("next URL path segment is empty", func() {
 			It("should return expected result", func() {
 				/* arrange */
-				objectUnderTest := _handler{}
+				expectedOpSummaries := []*model.OpSummary{{ID: "dummyID"}}
+
+				fakeNode := new(nodeFakes.FakeNode)
+				fakeNode.ListOpsReturns(expectedOpSummaries, nil)
+
+				objectUnderTest := _handler{
+					node: fakeNode,
+				}
 				providedHTTPResp := httptest.NewRecorder()
 
-				providedHTTPReq, err := http.NewRequest("dummyMethod", "", nil)
+				providedHTTPReq, err := http.NewRequest(http.MethodGet, "", nil)
 				if err != nil {
 					panic(err.Error())
 				}
@@ -36,7 +46,38 @@ var _ = Context("Handler", func() {
 				objectUnderTest.Handle(providedHTTPResp, providedHTTPReq)
 
 				/* assert */
-				Expect(providedHTTPResp.Code).To(Equal(http.StatusNotFound))
+				Expect(providedHTTPResp.Code).To(Equal(http.StatusOK))
+
+				actualOpSummaries := []*model.OpSummary{}
+				if err := json.NewDecoder(providedHTTPResp.Body).Decode(&actualOpSummaries); err != nil {
+					panic(err)
+				}
+				Expect(actualOpSummaries).To(Equal(expectedOpSummaries))
+			})
+		})
+		Context("next URL path segment isn't starts or kills", func() {
+			It("should call idHandler.Handle w/ expected args", func() {
+				/* arrange */
+				fakeIDHandler := new(idFakes.FakeHandler)
+
+				objectUnderTest := _handler{
+					idHandler: fakeIDHandler,
+				}
+
+				providedOpID := "dummyID"
+				providedHTTPReq, err := http.NewRequest(http.MethodGet, providedOpID, nil)
+				if err != nil {
+					panic(err.Error())
+				}
+
+				/* act */
+				objectUnderTest.Handle(httptest.NewRecorder(), providedHTTPReq)
+
+				/* assert */
+				actualOpID, _, actualHTTPReq := fakeIDHandler.HandleArgsForCall(0)
+
+				Expect(actualOpID).To(Equal(providedOpID))
+				Expect(actualHTTPReq).To(Equal(providedHTTPReq))
 			})
 		})
 		Context("next URL path segment is starts", func() {
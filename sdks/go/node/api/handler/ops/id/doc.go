@@ -0,0 +1,2 @@
+// Package id exposes functionality for handling "ops/{id}" requests.
+package id
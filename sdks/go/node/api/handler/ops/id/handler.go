@@ -0,0 +1,70 @@
+package id
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/opctl/opctl/sdks/go/internal/urlpath"
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/node"
+)
+
+//counterfeiter:generate -o fakes/handler.go . Handler
+type Handler interface {
+	Handle(
+		opID string,
+		res http.ResponseWriter,
+		req *http.Request,
+	)
+}
+
+// NewHandler returns an initialized Handler instance
+func NewHandler(
+	node node.Node,
+) Handler {
+	return _handler{
+		node: node,
+	}
+}
+
+type _handler struct {
+	node node.Node
+}
+
+func (hdlr _handler) Handle(
+	opID string,
+	httpResp http.ResponseWriter,
+	httpReq *http.Request,
+) {
+	pathSegment, err := urlpath.NextSegment(httpReq.URL)
+	if err != nil {
+		http.Error(httpResp, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if pathSegment != "" {
+		http.NotFoundHandler().ServeHTTP(httpResp, httpReq)
+		return
+	}
+
+	opSummary, err := hdlr.node.GetOp(httpReq.Context(), opID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, model.ErrOpNotFound{}) {
+			status = http.StatusNotFound
+		}
+		http.Error(httpResp, err.Error(), status)
+		return
+	}
+
+	httpResp.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	httpResp.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(httpResp).Encode(opSummary); err != nil {
+		http.Error(httpResp, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
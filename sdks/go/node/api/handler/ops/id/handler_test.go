@@ -0,0 +1,105 @@
+package id
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+	nodeFakes "github.com/opctl/opctl/sdks/go/node/fakes"
+)
+
+var _ = Context("Handler", func() {
+	Context("NewHandler", func() {
+		It("should not return nil", func() {
+			/* arrange/act/assert */
+			Expect(NewHandler(new(nodeFakes.FakeNode))).Should(Not(BeNil()))
+		})
+	})
+	Context("Handle", func() {
+		Context("node.GetOp returns ErrOpNotFound", func() {
+			It("should return StatusCode of 404", func() {
+				/* arrange */
+				fakeNode := new(nodeFakes.FakeNode)
+				fakeNode.GetOpReturns(nil, model.ErrOpNotFound{})
+
+				objectUnderTest := _handler{
+					node: fakeNode,
+				}
+				providedHTTPResp := httptest.NewRecorder()
+
+				providedHTTPReq, err := http.NewRequest(http.MethodGet, "", nil)
+				if err != nil {
+					panic(err.Error())
+				}
+
+				/* act */
+				objectUnderTest.Handle("dummyID", providedHTTPResp, providedHTTPReq)
+
+				/* assert */
+				Expect(providedHTTPResp.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+		Context("node.GetOp errors", func() {
+			It("should return StatusCode of 500", func() {
+				/* arrange */
+				fakeNode := new(nodeFakes.FakeNode)
+				fakeNode.GetOpReturns(nil, errors.New("dummyError"))
+
+				objectUnderTest := _handler{
+					node: fakeNode,
+				}
+				providedHTTPResp := httptest.NewRecorder()
+
+				providedHTTPReq, err := http.NewRequest(http.MethodGet, "", nil)
+				if err != nil {
+					panic(err.Error())
+				}
+
+				/* act */
+				objectUnderTest.Handle("dummyID", providedHTTPResp, providedHTTPReq)
+
+				/* assert */
+				Expect(providedHTTPResp.Code).To(Equal(http.StatusInternalServerError))
+			})
+		})
+		Context("node.GetOp doesn't error", func() {
+			It("should call node.GetOp w/ expected args & return expected result", func() {
+				/* arrange */
+				providedOpID := "dummyID"
+				expectedOpSummary := model.OpSummary{ID: providedOpID}
+
+				fakeNode := new(nodeFakes.FakeNode)
+				fakeNode.GetOpReturns(&expectedOpSummary, nil)
+
+				objectUnderTest := _handler{
+					node: fakeNode,
+				}
+				providedHTTPResp := httptest.NewRecorder()
+
+				providedHTTPReq, err := http.NewRequest(http.MethodGet, "", nil)
+				if err != nil {
+					panic(err.Error())
+				}
+
+				/* act */
+				objectUnderTest.Handle(providedOpID, providedHTTPResp, providedHTTPReq)
+
+				/* assert */
+				_, actualOpID := fakeNode.GetOpArgsForCall(0)
+				Expect(actualOpID).To(Equal(providedOpID))
+
+				Expect(providedHTTPResp.Code).To(Equal(http.StatusOK))
+
+				actualOpSummary := model.OpSummary{}
+				if err := json.NewDecoder(providedHTTPResp.Body).Decode(&actualOpSummary); err != nil {
+					panic(err)
+				}
+				Expect(actualOpSummary).To(Equal(expectedOpSummary))
+			})
+		})
+	})
+})
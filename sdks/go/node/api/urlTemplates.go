@@ -2,10 +2,16 @@ package api
 
 /* resources */
 const (
-	URLAuths_Adds    string = "/auths/adds"
-	URLData_Ref      string = "/data/{ref}"
-	URLEvents_Stream string = "/events/stream"
-	URLLiveness      string = "/liveness"
-	URLOps_Kills     string = "/ops/kills"
-	URLOps_Starts    string = "/ops/starts"
+	URLAuths_Adds           string = "/auths/adds"
+	URLCache_Entries        string = "/cache/entries"
+	URLCache_Prunes         string = "/cache/prunes"
+	URLContainers_ID_Attach string = "/containers/{id}/attach"
+	URLData_Ref             string = "/data/{ref}"
+	URLEvents_Prunes        string = "/events/prunes"
+	URLEvents_Stream        string = "/events/stream"
+	URLLiveness             string = "/liveness"
+	URLOps                  string = "/ops"
+	URLOps_ID               string = "/ops/{id}"
+	URLOps_Kills            string = "/ops/kills"
+	URLOps_Starts           string = "/ops/starts"
 )
@@ -72,7 +72,8 @@ var _ = Context("core", func() {
 			go func() {
 				for event := range eventChannel {
 					if event.AuthAdded != nil {
-						// ignore timestamp from assertion
+						// ignore sequence & timestamp from assertion
+						event.Sequence = expectedEvent.Sequence
 						event.Timestamp = expectedEvent.Timestamp
 						actualEvent = event
 					}
@@ -0,0 +1,20 @@
+package core
+
+import (
+	"context"
+
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+func (c core) AttachContainer(
+	ctx context.Context,
+	req model.AttachContainerReq,
+) error {
+	return c.containerRuntime.AttachContainer(
+		ctx,
+		req.ContainerID,
+		req.Stdin,
+		req.Stdout,
+		req.Resizes,
+	)
+}
@@ -0,0 +1,52 @@
+package core
+
+import (
+	"bytes"
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+	. "github.com/opctl/opctl/sdks/go/node/core/containerruntime/fakes"
+)
+
+var _ = Context("core", func() {
+	Context("AttachContainer", func() {
+		It("should call containerRuntime.AttachContainer w/ expected args", func() {
+			/* arrange */
+			providedCtx := context.Background()
+			resizes := make(chan model.TerminalSize)
+			providedReq := model.AttachContainerReq{
+				ContainerID: "dummyContainerID",
+				Stdin:       new(bytes.Buffer),
+				Stdout:      new(bytes.Buffer),
+				Resizes:     resizes,
+			}
+
+			fakeContainerRuntime := new(FakeContainerRuntime)
+
+			objectUnderTest := core{
+				containerRuntime: fakeContainerRuntime,
+			}
+
+			/* act */
+			objectUnderTest.AttachContainer(
+				providedCtx,
+				providedReq,
+			)
+
+			/* assert */
+			actualCtx,
+				actualContainerID,
+				actualStdin,
+				actualStdout,
+				actualResizes := fakeContainerRuntime.AttachContainerArgsForCall(0)
+
+			Expect(actualCtx).To(Equal(providedCtx))
+			Expect(actualContainerID).To(Equal(providedReq.ContainerID))
+			Expect(actualStdin).To(Equal(providedReq.Stdin))
+			Expect(actualStdout).To(Equal(providedReq.Stdout))
+			Expect(actualResizes).To(Equal(providedReq.Resizes))
+		})
+	})
+})
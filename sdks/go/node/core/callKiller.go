@@ -12,10 +12,13 @@ import (
 
 //counterfeiter:generate -o internal/fakes/callKiller.go . callKiller
 type callKiller interface {
+	// Kill kills a call & its descendants which started before requestTime; calls started after
+	// (e.g. finally calls) aren't affected by the request
 	Kill(
 		ctx context.Context,
 		callID string,
 		rootCallID string,
+		requestTime time.Time,
 	)
 }
 
@@ -41,13 +44,14 @@ func (ckr _callKiller) Kill(
 	ctx context.Context,
 	callID string,
 	rootCallID string,
+	requestTime time.Time,
 ) {
 	ckr.containerRuntime.DeleteContainerIfExists(
 		ctx,
 		callID,
 	)
 
-	for _, childCallGraph := range ckr.stateStore.ListWithParentID(callID) {
+	for _, childCallGraph := range ckr.stateStore.ListWithParentID(callID, requestTime) {
 		ckr.eventPublisher.Publish(
 			model.Event{
 				CallKillRequested: &model.CallKillRequested{
@@ -56,7 +60,8 @@ func (ckr _callKiller) Kill(
 						RootCallID: rootCallID,
 					},
 				},
-				Timestamp: time.Now().UTC(),
+				// cascaded requests keep the time of the original request
+				Timestamp: requestTime,
 			},
 		)
 	}
@@ -89,6 +89,7 @@ var _ = Context("_callKiller", func() {
 					context.Background(),
 					providedCallID,
 					providedRootCallID,
+					time.Now().UTC(),
 				)
 
 				/* assert */
@@ -108,5 +109,82 @@ var _ = Context("_callKiller", func() {
 				)
 			})
 		})
+		Context("stateStore.ListWithParentID returns nodes started after request", func() {
+			It("should not call pubsub.Publish for them", func() {
+				/* arrange */
+				providedCallID := "providedCallID"
+				providedRootCallID := "providedRootCallID"
+				providedRequestTime := time.Now().UTC()
+
+				dbDir, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				db, err := badger.Open(
+					badger.DefaultOptions(dbDir).WithLogger(nil),
+				)
+				if err != nil {
+					panic(err)
+				}
+
+				pubSub := pubsub.New(db)
+
+				stateStore := newStateStore(context.Background(), db, pubSub)
+
+				// seed child call started after request e.g. a finally call
+				pubSub.Publish(model.Event{
+					CallStarted: &model.CallStarted{
+						Call: model.Call{
+							ID:       "childCallID",
+							ParentID: &providedCallID,
+							RootID:   providedRootCallID,
+						},
+					},
+					Timestamp: providedRequestTime.Add(time.Second),
+				})
+
+				// give stateStore time to receive & apply events
+				time.Sleep(time.Second)
+
+				eventChannel, err := pubSub.Subscribe(
+					context.Background(),
+					model.EventFilter{},
+				)
+				if err != nil {
+					panic(err)
+				}
+
+				objectUnderTest := newCallKiller(
+					stateStore,
+					new(FakeContainerRuntime),
+					pubSub,
+				)
+
+				/* act */
+				objectUnderTest.Kill(
+					context.Background(),
+					providedCallID,
+					providedRootCallID,
+					providedRequestTime,
+				)
+
+				/* assert */
+				actualKillRequestCount := 0
+				go func() {
+					for event := range eventChannel {
+						if event.CallKillRequested != nil {
+							actualKillRequestCount++
+						}
+					}
+				}()
+
+				Consistently(
+					func() int { return actualKillRequestCount },
+				).Should(
+					Equal(0),
+				)
+			})
+		})
 	})
 })
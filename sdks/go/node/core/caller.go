@@ -2,12 +2,16 @@ package core
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"runtime/debug"
 	"time"
 
 	"github.com/opctl/opctl/sdks/go/model"
 	callpkg "github.com/opctl/opctl/sdks/go/opspec/interpreter/call"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/parallel"
 	"github.com/opctl/opctl/sdks/go/pubsub"
 )
 
@@ -32,11 +36,13 @@ func newCaller(
 	containerCaller containerCaller,
 	dataDirPath string,
 	pubSub pubsub.PubSub,
+	outputConflictPolicy OutputConflictPolicy,
 ) caller {
 	instance := &_caller{
-		containerCaller: containerCaller,
-		dataDirPath:     dataDirPath,
-		pubSub:          pubSub,
+		containerCaller:      containerCaller,
+		dataDirPath:          dataDirPath,
+		outputConflictPolicy: outputConflictPolicy,
+		pubSub:               pubSub,
 	}
 
 	instance.opCaller = newOpCaller(
@@ -47,6 +53,7 @@ func newCaller(
 	instance.parallelCaller = newParallelCaller(
 		instance,
 		pubSub,
+		outputConflictPolicy,
 	)
 
 	instance.parallelLoopCaller = newParallelLoopCaller(
@@ -64,18 +71,25 @@ func newCaller(
 		pubSub,
 	)
 
+	instance.switchCaller = newSwitchCaller(
+		instance,
+		pubSub,
+	)
+
 	return instance
 }
 
 type _caller struct {
-	containerCaller    containerCaller
-	dataDirPath        string
-	opCaller           opCaller
-	parallelCaller     parallelCaller
-	parallelLoopCaller parallelLoopCaller
-	pubSub             pubsub.PubSub
-	serialCaller       serialCaller
-	serialLoopCaller   serialLoopCaller
+	containerCaller      containerCaller
+	dataDirPath          string
+	opCaller             opCaller
+	outputConflictPolicy OutputConflictPolicy
+	parallelCaller       parallelCaller
+	parallelLoopCaller   parallelLoopCaller
+	pubSub               pubsub.PubSub
+	serialCaller         serialCaller
+	serialLoopCaller     serialLoopCaller
+	switchCaller         switchCaller
 }
 
 func (clr _caller) Call(
@@ -89,18 +103,117 @@ func (clr _caller) Call(
 ) (
 	map[string]*model.Value,
 	error,
+) {
+	callStartTime := time.Now().UTC()
+
+	for attemptNumber := 1; ; attemptNumber++ {
+		outputs, call, nextAttemptDelay, err := clr.attempt(
+			ctx,
+			attemptNumber,
+			callStartTime,
+			id,
+			scope,
+			callSpec,
+			opPath,
+			parentCallID,
+			rootCallID,
+		)
+		if nextAttemptDelay == nil {
+			if err != nil && call != nil && call.ContinueOnError {
+				// failure is recorded by the call's CallEnded event but mustn't fail its parent
+				return outputs, nil
+			}
+			return outputs, err
+		}
+
+		if !clr.waitForNextAttempt(
+			ctx,
+			*nextAttemptDelay,
+			callStartTime,
+			id,
+			rootCallID,
+		) {
+			// killed while waiting; no further attempt will end the call so end it here
+			clr.pubSub.Publish(
+				model.Event{
+					CallEnded: &model.CallEnded{
+						Call:    *call,
+						Outcome: model.OpOutcomeKilled,
+						Ref:     opPath,
+					},
+					Timestamp: time.Now().UTC(),
+				},
+			)
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return nil, ctxErr
+			}
+			return nil, errors.New("call killed while waiting to retry")
+		}
+
+		// ensure each attempt starts w/ a fresh scratch dir so partial outputs don't leak between attempts
+		if err := os.RemoveAll(filepath.Join(clr.dataDirPath, "dcg", id)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// waitForNextAttempt waits delay before a call is attempted again.
+// false will be returned if the call is killed while waiting.
+func (clr _caller) waitForNextAttempt(
+	ctx context.Context,
+	delay time.Duration,
+	callStartTime time.Time,
+	id string,
+	rootCallID string,
+) bool {
+	waitCtx, cancelWait := context.WithTimeout(ctx, delay)
+	defer cancelWait()
+
+	eventChannel, _ := clr.pubSub.Subscribe(
+		waitCtx,
+		model.EventFilter{
+			Roots: []string{rootCallID},
+			Since: &callStartTime,
+		},
+	)
+
+	for event := range eventChannel {
+		switch {
+		case event.CallKillRequested != nil && event.CallKillRequested.Request.OpID == id:
+			return false
+		}
+	}
+
+	return ctx.Err() == nil
+}
+
+// attempt makes a single attempt at a call.
+// nextAttemptDelay will be non nil if the call failed & should be attempted again after the delay.
+func (clr _caller) attempt(
+	ctx context.Context,
+	attemptNumber int,
+	callStartTime time.Time,
+	id string,
+	scope map[string]*model.Value,
+	callSpec *model.CallSpec,
+	opPath string,
+	parentCallID *string,
+	rootCallID string,
+) (
+	outputs map[string]*model.Value,
+	call *model.Call,
+	nextAttemptDelay *time.Duration,
+	err error,
 ) {
 	callCtx, cancelCall := context.WithCancel(ctx)
 	defer cancelCall()
-	var err error
 	var isKilled bool
-	var outputs map[string]*model.Value
-	var call *model.Call
-	callStartTime := time.Now().UTC()
+	var isCacheHit bool
+	attemptStartTime := time.Now().UTC()
 
 	if callCtx.Err() != nil {
 		// if context done NOOP
-		return nil, nil
+		return nil, nil, nil, nil
 	}
 
 	defer func() {
@@ -115,9 +228,10 @@ func (clr _caller) Call(
 
 		event := model.Event{
 			CallEnded: &model.CallEnded{
-				Call:    *call,
-				Outputs: outputs,
-				Ref:     opPath,
+				CacheHit: isCacheHit,
+				Call:     *call,
+				Outputs:  outputs,
+				Ref:      opPath,
 			},
 			Timestamp: time.Now().UTC(),
 		}
@@ -125,6 +239,11 @@ func (clr _caller) Call(
 		if isKilled || ctx.Err() != nil {
 			// this call or parent call killed/cancelled
 			event.CallEnded.Outcome = model.OpOutcomeKilled
+		} else if errors.Is(callCtx.Err(), context.DeadlineExceeded) {
+			event.CallEnded.Outcome = model.OpOutcomeTimedOut
+			event.CallEnded.Error = &model.CallEndedError{
+				Message: fmt.Sprintf("call timed out after %s", *call.Timeout),
+			}
 		} else if err != nil {
 			event.CallEnded.Outcome = model.OpOutcomeFailed
 			event.CallEnded.Error = &model.CallEndedError{
@@ -134,12 +253,18 @@ func (clr _caller) Call(
 			event.CallEnded.Outcome = model.OpOutcomeSucceeded
 		}
 
+		if call.Attempt != nil && isRetryable(*call.Attempt, *call.Retry, event.CallEnded, err) {
+			delay := getRetryDelay(*call.Attempt, *call.Retry)
+			nextAttemptDelay = &delay
+			event.CallEnded.WillRetry = true
+		}
+
 		clr.pubSub.Publish(event)
 	}()
 
 	if callSpec == nil {
 		// NOOP
-		return outputs, err
+		return outputs, call, nil, err
 	}
 
 	call, err = callpkg.Interpret(
@@ -152,17 +277,49 @@ func (clr _caller) Call(
 		rootCallID,
 		clr.dataDirPath,
 	)
+	var outputConflictsErr parallel.OutputConflictsError
+	if errors.As(err, &outputConflictsErr) && clr.outputConflictPolicy == OutputConflictPolicyWarn {
+		// conflicts known before any child starts only warn; the output of the last child wins
+		clr.pubSub.Publish(
+			model.Event{
+				CallOutputsConflicted: &model.CallOutputsConflicted{
+					CallID:                 id,
+					CallLabelsByOutputName: outputConflictsErr.CallLabelsByOutputName,
+					OpRef:                  opPath,
+					RootCallID:             rootCallID,
+				},
+				Timestamp: time.Now().UTC(),
+			},
+		)
+		err = nil
+	}
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
 
 	if call.If != nil && !*call.If {
-		return outputs, err
+		return outputs, call, nil, err
+	}
+
+	if call.Retry != nil {
+		call.Attempt = &model.CallAttempt{
+			Number: attemptNumber,
+			Max:    call.Retry.MaxAttempts,
+		}
+	}
+
+	if call.Timeout != nil {
+		// already validated during interpretation
+		timeout, _ := time.ParseDuration(*call.Timeout)
+
+		var cancelTimeout context.CancelFunc
+		callCtx, cancelTimeout = context.WithTimeout(callCtx, timeout)
+		defer cancelTimeout()
 	}
 
 	clr.pubSub.Publish(
 		model.Event{
-			Timestamp: callStartTime,
+			Timestamp: attemptStartTime,
 			CallStarted: &model.CallStarted{
 				Call: *call,
 				Ref:  opPath,
@@ -182,6 +339,7 @@ func (clr _caller) Call(
 
 		defer cancelCall()
 
+		// kill requests apply to every attempt of a call so watch for them from the first attempt on
 		eventChannel, _ := clr.pubSub.Subscribe(
 			callCtx,
 			model.EventFilter{
@@ -201,7 +359,7 @@ func (clr _caller) Call(
 
 	switch {
 	case callSpec.Container != nil:
-		outputs, err = clr.containerCaller.Call(
+		outputs, isCacheHit, err = clr.containerCaller.Call(
 			callCtx,
 			call.Container,
 			scope,
@@ -217,6 +375,30 @@ func (clr _caller) Call(
 			rootCallID,
 			callSpec.Op,
 		)
+		if len(call.Finally) != 0 {
+			// finally calls of an op call see its outputs in the scope of the caller
+			finallyScope := map[string]*model.Value{}
+			for varName, varData := range scope {
+				finallyScope[varName] = varData
+			}
+			for varName, varData := range outputs {
+				finallyScope[varName] = varData
+			}
+
+			finallyOutputs, finallyErr := clr.serialCaller.CallFinally(
+				callCtx,
+				id,
+				finallyScope,
+				err,
+				rootCallID,
+				opPath,
+				call.Finally,
+			)
+			if err == nil {
+				// failure of the op call takes precedence over failure of its finally calls
+				outputs, err = finallyOutputs, finallyErr
+			}
+		}
 	case callSpec.Parallel != nil:
 		outputs, err = clr.parallelCaller.Call(
 			callCtx,
@@ -225,6 +407,7 @@ func (clr _caller) Call(
 			rootCallID,
 			opPath,
 			*callSpec.Parallel,
+			call.MaxConcurrency,
 		)
 	case callSpec.ParallelLoop != nil:
 		outputs, err = clr.parallelLoopCaller.Call(
@@ -244,6 +427,7 @@ func (clr _caller) Call(
 			rootCallID,
 			opPath,
 			*callSpec.Serial,
+			call.Finally,
 		)
 	case callSpec.SerialLoop != nil:
 		outputs, err = clr.serialLoopCaller.Call(
@@ -255,9 +439,19 @@ func (clr _caller) Call(
 			parentCallID,
 			rootCallID,
 		)
+	case callSpec.Switch != nil:
+		outputs, err = clr.switchCaller.Call(
+			callCtx,
+			id,
+			scope,
+			rootCallID,
+			opPath,
+			call.Switch,
+			*callSpec.Switch,
+		)
 	default:
 		err = fmt.Errorf("invalid call graph '%+v'", callSpec)
 	}
 
-	return outputs, err
+	return outputs, call, nil, err
 }
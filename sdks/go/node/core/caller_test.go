@@ -2,6 +2,7 @@ package core
 
 import (
 	"context"
+	"errors"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -23,6 +24,7 @@ var _ = Context("caller", func() {
 					new(FakeContainerCaller),
 					"dummyDataDir",
 					new(FakePubSub),
+					OutputConflictPolicyFail,
 				),
 			).To(Not(BeNil()))
 		})
@@ -135,6 +137,179 @@ var _ = Context("caller", func() {
 			})
 		})
 
+		Context("callSpec.Timeout exceeded", func() {
+			It("should call pubSub.Publish w/ expected args", func() {
+				/* arrange */
+				providedCallID := "dummyCallID"
+				providedRootCallID := "dummyRootCallID"
+				providedTimeout := "1ms"
+
+				fakePubSub := new(FakePubSub)
+				// ensure eventChan stays open so call only exits upon timeout
+				fakePubSub.SubscribeReturns(make(chan model.Event), nil)
+
+				fakeSerialCaller := new(FakeSerialCaller)
+				fakeSerialCaller.CallStub = func(
+					ctx context.Context,
+					callID string,
+					inboundScope map[string]*model.Value,
+					rootCallID string,
+					opPath string,
+					callSpecSerialCall []*model.CallSpec,
+					callSpecFinally []*model.CallSpec,
+				) (map[string]*model.Value, error) {
+					<-ctx.Done()
+					return nil, ctx.Err()
+				}
+
+				objectUnderTest := _caller{
+					pubSub:       fakePubSub,
+					serialCaller: fakeSerialCaller,
+				}
+
+				/* act */
+				objectUnderTest.Call(
+					context.Background(),
+					providedCallID,
+					map[string]*model.Value{},
+					&model.CallSpec{
+						Serial:  &[]*model.CallSpec{},
+						Timeout: &providedTimeout,
+					},
+					"dummyOpPath",
+					nil,
+					providedRootCallID,
+				)
+
+				/* assert */
+				actualEvent := fakePubSub.PublishArgsForCall(1)
+
+				Expect(actualEvent.CallEnded.Outcome).To(Equal(model.OpOutcomeTimedOut))
+				Expect(*actualEvent.CallEnded.Error).To(Equal(model.CallEndedError{
+					Message: "call timed out after 1ms",
+				}))
+			})
+		})
+
+		Context("callSpec.Retry not nil", func() {
+			Context("every attempt fails", func() {
+				It("should call pubSub.Publish w/ expected args", func() {
+					/* arrange */
+					providedCallID := "dummyCallID"
+					providedRootCallID := "dummyRootCallID"
+
+					fakePubSub := new(FakePubSub)
+					// ensure eventChan closed so call exits
+					fakePubSub.SubscribeReturns(closedEventChan, nil)
+
+					fakeSerialCaller := new(FakeSerialCaller)
+					fakeSerialCaller.CallReturns(nil, errors.New("dummyError"))
+
+					dataDir, err := ioutil.TempDir("", "")
+					if err != nil {
+						panic(err)
+					}
+
+					objectUnderTest := _caller{
+						dataDirPath:  dataDir,
+						pubSub:       fakePubSub,
+						serialCaller: fakeSerialCaller,
+					}
+
+					/* act */
+					_, actualErr := objectUnderTest.Call(
+						context.Background(),
+						providedCallID,
+						map[string]*model.Value{},
+						&model.CallSpec{
+							Retry: &model.RetrySpec{
+								MaxAttempts: 3,
+							},
+							Serial: &[]*model.CallSpec{},
+						},
+						"dummyOpPath",
+						nil,
+						providedRootCallID,
+					)
+
+					/* assert */
+					Expect(actualErr).To(MatchError("dummyError"))
+					Expect(fakeSerialCaller.CallCallCount()).To(Equal(3))
+					Expect(fakePubSub.PublishCallCount()).To(Equal(6))
+					for i := 0; i < 3; i++ {
+						actualCallStarted := fakePubSub.PublishArgsForCall(i * 2).CallStarted
+						Expect(*actualCallStarted.Call.Attempt).To(Equal(model.CallAttempt{Number: i + 1, Max: 3}))
+
+						actualCallEnded := fakePubSub.PublishArgsForCall(i*2 + 1).CallEnded
+						Expect(actualCallEnded.Outcome).To(Equal(model.OpOutcomeFailed))
+						Expect(actualCallEnded.WillRetry).To(Equal(i < 2))
+					}
+				})
+			})
+			Context("killed while waiting to retry", func() {
+				It("should return expected error & publish KILLED CallEnded", func() {
+					/* arrange */
+					providedCallID := "dummyCallID"
+					providedRootCallID := "dummyRootCallID"
+					providedDelay := "1h"
+
+					killEventChan := make(chan model.Event, 1)
+					killEventChan <- model.Event{
+						CallKillRequested: &model.CallKillRequested{
+							Request: model.KillOpReq{OpID: providedCallID, RootCallID: providedRootCallID},
+						},
+					}
+					close(killEventChan)
+
+					fakePubSub := new(FakePubSub)
+					fakePubSub.SubscribeStub = func(ctx context.Context, filter model.EventFilter) (<-chan model.Event, error) {
+						if _, isWaitingToRetry := ctx.Deadline(); isWaitingToRetry {
+							// only waits for retries have deadlines
+							return killEventChan, nil
+						}
+						return closedEventChan, nil
+					}
+
+					fakeSerialCaller := new(FakeSerialCaller)
+					fakeSerialCaller.CallReturns(nil, errors.New("dummyError"))
+
+					dataDir, err := ioutil.TempDir("", "")
+					if err != nil {
+						panic(err)
+					}
+
+					objectUnderTest := _caller{
+						dataDirPath:  dataDir,
+						pubSub:       fakePubSub,
+						serialCaller: fakeSerialCaller,
+					}
+
+					/* act */
+					_, actualErr := objectUnderTest.Call(
+						context.Background(),
+						providedCallID,
+						map[string]*model.Value{},
+						&model.CallSpec{
+							Retry: &model.RetrySpec{
+								Delay:       &providedDelay,
+								MaxAttempts: 3,
+							},
+							Serial: &[]*model.CallSpec{},
+						},
+						"dummyOpPath",
+						nil,
+						providedRootCallID,
+					)
+
+					/* assert */
+					Expect(actualErr).To(MatchError("call killed while waiting to retry"))
+					Expect(fakeSerialCaller.CallCallCount()).To(Equal(1))
+					actualCallEnded := fakePubSub.PublishArgsForCall(fakePubSub.PublishCallCount() - 1).CallEnded
+					Expect(actualCallEnded.Outcome).To(Equal(model.OpOutcomeKilled))
+				})
+			})
+		})
+
 		Context("Container CallSpec", func() {
 			It("should call containerCaller.Call w/ expected args", func() {
 				/* arrange */
@@ -287,6 +462,92 @@ var _ = Context("caller", func() {
 			})
 		})
 
+		Context("Op CallSpec w/ Finally", func() {
+			It("should call serialCaller.CallFinally w/ expected args & return op error", func() {
+				/* arrange */
+				wd, err := os.Getwd()
+				if err != nil {
+					panic(err)
+				}
+				providedOpPath := filepath.Join(wd, "testdata/caller")
+
+				providedCallID := "dummyCallID"
+				scopeValue := "dummyScopeValue"
+				providedScope := map[string]*model.Value{
+					"scopeName": {String: &scopeValue},
+				}
+				providedFinally := []*model.CallSpec{
+					{Serial: &[]*model.CallSpec{}},
+				}
+				providedCallSpec := &model.CallSpec{
+					Finally: &providedFinally,
+					Op: &model.OpCallSpec{
+						Ref: providedOpPath,
+					},
+				}
+				providedParentID := "providedParentID"
+				providedRootCallID := "dummyRootCallID"
+
+				outputValue := "dummyOutputValue"
+				opOutputs := map[string]*model.Value{
+					"outputName": {String: &outputValue},
+				}
+				opErr := errors.New("dummyOpErr")
+
+				fakeOpCaller := new(FakeOpCaller)
+				fakeOpCaller.CallReturns(opOutputs, opErr)
+
+				fakeSerialCaller := new(FakeSerialCaller)
+				fakeSerialCaller.CallFinallyReturns(nil, errors.New("dummyFinallyErr"))
+
+				fakePubSub := new(FakePubSub)
+				// ensure eventChan closed so call exits
+				fakePubSub.SubscribeReturns(closedEventChan, nil)
+
+				dataDir, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				objectUnderTest := _caller{
+					dataDirPath:  dataDir,
+					opCaller:     fakeOpCaller,
+					pubSub:       fakePubSub,
+					serialCaller: fakeSerialCaller,
+				}
+
+				/* act */
+				_, actualErr := objectUnderTest.Call(
+					context.Background(),
+					providedCallID,
+					providedScope,
+					providedCallSpec,
+					providedOpPath,
+					&providedParentID,
+					providedRootCallID,
+				)
+
+				/* assert */
+				Expect(actualErr).To(MatchError(opErr))
+				_,
+					actualCallID,
+					actualScope,
+					actualCallErr,
+					actualRootCallID,
+					actualOpPath,
+					actualFinally := fakeSerialCaller.CallFinallyArgsForCall(0)
+
+				Expect(actualCallID).To(Equal(providedCallID))
+				Expect(actualScope).To(Equal(map[string]*model.Value{
+					"scopeName":  {String: &scopeValue},
+					"outputName": {String: &outputValue},
+				}))
+				Expect(actualCallErr).To(Equal(opErr))
+				Expect(actualRootCallID).To(Equal(providedRootCallID))
+				Expect(actualOpPath).To(Equal(providedOpPath))
+				Expect(actualFinally).To(Equal(providedFinally))
+			})
+		})
 		Context("Parallel CallSpec", func() {
 			It("should call parallelCaller.Call w/ expected args", func() {
 				/* arrange */
@@ -295,6 +556,7 @@ var _ = Context("caller", func() {
 				providedCallID := "dummyCallID"
 				providedScope := map[string]*model.Value{}
 				providedCallSpec := &model.CallSpec{
+					MaxConcurrency: 2,
 					Parallel: &[]*model.CallSpec{
 						{Container: &model.ContainerCallSpec{}},
 					},
@@ -328,13 +590,15 @@ var _ = Context("caller", func() {
 					actualScope,
 					actualRootCallID,
 					actualOpPath,
-					actualCallSpec := fakeParallelCaller.CallArgsForCall(0)
+					actualCallSpec,
+					actualMaxConcurrency := fakeParallelCaller.CallArgsForCall(0)
 
 				Expect(actualCallID).To(Equal(providedCallID))
 				Expect(actualScope).To(Equal(providedScope))
 				Expect(actualRootCallID).To(Equal(providedRootCallID))
 				Expect(actualOpPath).To(Equal(providedOpPath))
 				Expect(actualCallSpec).To(Equal(*providedCallSpec.Parallel))
+				Expect(*actualMaxConcurrency).To(Equal(2))
 			})
 		})
 
@@ -399,6 +663,9 @@ var _ = Context("caller", func() {
 				providedCallID := "dummyCallID"
 				providedScope := map[string]*model.Value{}
 				providedCallSpec := &model.CallSpec{
+					Finally: &[]*model.CallSpec{
+						{Container: &model.ContainerCallSpec{}},
+					},
 					Serial: &[]*model.CallSpec{
 						{Container: &model.ContainerCallSpec{}},
 					},
@@ -433,13 +700,15 @@ var _ = Context("caller", func() {
 					actualScope,
 					actualRootCallID,
 					actualOpPath,
-					actualCallSpec := fakeSerialCaller.CallArgsForCall(0)
+					actualCallSpec,
+					actualFinallyCallSpec := fakeSerialCaller.CallArgsForCall(0)
 
 				Expect(actualCallID).To(Equal(providedCallID))
 				Expect(actualScope).To(Equal(providedScope))
 				Expect(actualRootCallID).To(Equal(providedRootCallID))
 				Expect(actualOpPath).To(Equal(providedOpPath))
 				Expect(actualCallSpec).To(Equal(*providedCallSpec.Serial))
+				Expect(actualFinallyCallSpec).To(Equal(*providedCallSpec.Finally))
 			})
 		})
 
@@ -496,5 +765,73 @@ var _ = Context("caller", func() {
 				Expect(actualRootCallID).To(Equal(providedRootCallID))
 			})
 		})
+
+		Context("Switch CallSpec", func() {
+			It("should call switchCaller.Call w/ expected args", func() {
+				/* arrange */
+				fakeSwitchCaller := new(FakeSwitchCaller)
+
+				providedCallID := "dummyCallID"
+				providedScope := map[string]*model.Value{}
+				providedCallSpec := &model.CallSpec{
+					Switch: &model.SwitchCallSpec{
+						Cases: []*model.SwitchCaseSpec{
+							{
+								If: []*model.PredicateSpec{
+									{
+										Eq: &[]interface{}{
+											true,
+											true,
+										},
+									},
+								},
+								Run: model.CallSpec{Container: &model.ContainerCallSpec{}},
+							},
+						},
+					},
+				}
+				providedOpPath := "providedOpPath"
+				providedRootCallID := "dummyRootCallID"
+
+				expectedCaseIndex := 0
+
+				fakePubSub := new(FakePubSub)
+				// ensure eventChan closed so call exits
+				fakePubSub.SubscribeReturns(closedEventChan, nil)
+
+				objectUnderTest := _caller{
+					containerCaller: new(FakeContainerCaller),
+					pubSub:          fakePubSub,
+					switchCaller:    fakeSwitchCaller,
+				}
+
+				/* act */
+				objectUnderTest.Call(
+					context.Background(),
+					providedCallID,
+					providedScope,
+					providedCallSpec,
+					providedOpPath,
+					nil,
+					providedRootCallID,
+				)
+
+				/* assert */
+				_,
+					actualCallID,
+					actualScope,
+					actualRootCallID,
+					actualOpPath,
+					actualSwitch,
+					actualSwitchCallSpec := fakeSwitchCaller.CallArgsForCall(0)
+
+				Expect(actualCallID).To(Equal(providedCallID))
+				Expect(actualScope).To(Equal(providedScope))
+				Expect(actualRootCallID).To(Equal(providedRootCallID))
+				Expect(actualOpPath).To(Equal(providedOpPath))
+				Expect(*actualSwitch).To(Equal(model.SwitchCall{CaseIndex: &expectedCaseIndex}))
+				Expect(actualSwitchCallSpec).To(Equal(*providedCallSpec.Switch))
+			})
+		})
 	})
 })
@@ -0,0 +1,99 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/pubsub"
+)
+
+// concurrencyLimiter bounds the number of child calls running at once; calls over the bound are queued
+type concurrencyLimiter struct {
+	pubSub pubsub.PubSub
+	// nil if unbounded
+	slots chan struct{}
+}
+
+// newConcurrencyLimiter returns a limiter allowing maxConcurrency calls at once; nil maxConcurrency is unbounded
+func newConcurrencyLimiter(
+	maxConcurrency *int,
+	pubSub pubsub.PubSub,
+) concurrencyLimiter {
+	limiter := concurrencyLimiter{
+		pubSub: pubSub,
+	}
+
+	if maxConcurrency != nil {
+		limiter.slots = make(chan struct{}, *maxConcurrency)
+	}
+
+	return limiter
+}
+
+// Queue acquires a slot for call if one's free & returns true.
+// Otherwise call is published as pending; it must Acquire a slot before starting.
+func (cl concurrencyLimiter) Queue(
+	call model.Call,
+	opPath string,
+) bool {
+	if cl.slots == nil {
+		return true
+	}
+
+	select {
+	case cl.slots <- struct{}{}:
+		return true
+	default:
+	}
+
+	cl.pubSub.Publish(
+		model.Event{
+			CallPending: &model.CallPending{
+				Call: call,
+				Ref:  opPath,
+			},
+			Timestamp: time.Now().UTC(),
+		},
+	)
+
+	return false
+}
+
+// Acquire waits for a slot for a queued call & returns true.
+// If ctx is done first, call will never start; it's published as skipped & false is returned.
+func (cl concurrencyLimiter) Acquire(
+	ctx context.Context,
+	call model.Call,
+	opPath string,
+) bool {
+	select {
+	case cl.slots <- struct{}{}:
+		if ctx.Err() == nil {
+			return true
+		}
+		// ctx was done too; don't start calls w/ a done ctx
+		cl.Release()
+	case <-ctx.Done():
+	}
+
+	cl.pubSub.Publish(
+		model.Event{
+			CallEnded: &model.CallEnded{
+				Call:    call,
+				Outcome: model.OpOutcomeSkipped,
+				Ref:     opPath,
+			},
+			Timestamp: time.Now().UTC(),
+		},
+	)
+
+	return false
+}
+
+// Release frees the slot of a call which has ended
+func (cl concurrencyLimiter) Release() {
+	if cl.slots != nil {
+		<-cl.slots
+	}
+}
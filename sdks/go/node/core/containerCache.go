@@ -0,0 +1,468 @@
+package core
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-utils/dircopier"
+	"github.com/golang-utils/filecopier"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+type containerCache interface {
+	// GetKey computes the key of a container call from its interpreted form & the contents of the files/dirs it mounts.
+	// imageDigest identifies the image of calls w/ an image ref; tags are mutable so aren't used.
+	// Only calls which exit zero w/out readiness or interactivity are cached so allowNonZeroExit, readiness & interactive aren't keyed.
+	GetKey(
+		containerCall *model.ContainerCall,
+		imageDigest string,
+		outputs map[string]*model.Value,
+	) (string, error)
+
+	// Load restores cached outputs of a container call to the locations referenced by outputs
+	// & returns the captured logs; isHit will be false if no entry exists for the key.
+	Load(
+		key string,
+		outputs map[string]*model.Value,
+	) (
+		logs []*cachedLog,
+		isHit bool,
+		err error,
+	)
+
+	// Store stores outputs & logs of a container call
+	Store(
+		key string,
+		containerCall *model.ContainerCall,
+		outputs map[string]*model.Value,
+		logs []*cachedLog,
+	) error
+
+	// List lists all entries
+	List() ([]*model.CacheEntry, error)
+
+	// Prune removes entries created before the provided time; nil before removes all entries.
+	Prune(
+		before *time.Time,
+	) ([]*model.CacheEntry, error)
+}
+
+func newContainerCache(
+	dataDirPath string,
+) containerCache {
+	return _containerCache{
+		cacheDirPath: filepath.Join(dataDirPath, "cache"),
+	}
+}
+
+type _containerCache struct {
+	cacheDirPath string
+}
+
+// cachedLog is a single write to a containers std out or std err
+type cachedLog struct {
+	Data     []byte `json:"data"`
+	IsStdErr bool   `json:"isStdErr,omitempty"`
+}
+
+// cachedLogRecorder records logs of a container call in the order they were written
+type cachedLogRecorder struct {
+	logs  []*cachedLog
+	mutex sync.Mutex
+}
+
+// record records a log; nil recorders are ignored
+func (lr *cachedLogRecorder) record(
+	data []byte,
+	isStdErr bool,
+) {
+	if lr == nil {
+		return
+	}
+
+	lr.mutex.Lock()
+	defer lr.mutex.Unlock()
+
+	lr.logs = append(lr.logs, &cachedLog{Data: data, IsStdErr: isStdErr})
+}
+
+// cacheEntryFile is the content of an entries metadata file
+type cacheEntryFile struct {
+	model.CacheEntry
+	Logs []*cachedLog `json:"logs"`
+}
+
+const cacheEntryFileName = "entry.json"
+const cacheOutputsDirName = "outputs"
+
+// cacheKeyInputs are all inputs of a container call which affect its outputs.
+// Inputs added after keys were first computed are omitted when empty so keys of calls not setting them are unchanged.
+type cacheKeyInputs struct {
+	CapAdd  []string `json:"capAdd,omitempty"`
+	CapDrop []string `json:"capDrop,omitempty"`
+	Cmd     []string `json:"cmd"`
+	// format: containerPath => contentHash
+	Dirs    map[string]string `json:"dirs"`
+	EnvVars map[string]string `json:"envVars"`
+	// format: containerPath => contentHash
+	Files   map[string]string `json:"files"`
+	Image   string            `json:"image"`
+	Outputs []string          `json:"outputs"`
+	Ports   map[string]string `json:"ports,omitempty"`
+	// nil if not explicitly set; in which case the default of the node applies
+	Privileged     *bool                         `json:"privileged,omitempty"`
+	ReadOnlyPaths  []string                      `json:"readOnlyPaths,omitempty"`
+	ReadOnlyRootFs bool                          `json:"readOnlyRootFs,omitempty"`
+	Resources      *model.ContainerCallResources `json:"resources,omitempty"`
+	Sockets        []string                      `json:"sockets"`
+	// content hash of the file fed to std in
+	Stdin   string           `json:"stdin,omitempty"`
+	Tmpfs   map[string]int64 `json:"tmpfs,omitempty"`
+	User    string           `json:"user,omitempty"`
+	WorkDir string           `json:"workDir"`
+}
+
+func (cc _containerCache) GetKey(
+	containerCall *model.ContainerCall,
+	imageDigest string,
+	outputs map[string]*model.Value,
+) (string, error) {
+	keyInputs := cacheKeyInputs{
+		CapAdd:         containerCall.CapAdd,
+		CapDrop:        containerCall.CapDrop,
+		Cmd:            containerCall.Cmd,
+		Dirs:           map[string]string{},
+		EnvVars:        containerCall.EnvVars,
+		Files:          map[string]string{},
+		Ports:          containerCall.Ports,
+		Privileged:     containerCall.Privileged,
+		ReadOnlyPaths:  containerCall.ReadOnlyPaths,
+		ReadOnlyRootFs: containerCall.ReadOnlyRootFs,
+		Resources:      containerCall.Resources,
+		Tmpfs:          containerCall.Tmpfs,
+		User:           containerCall.User,
+		WorkDir:        containerCall.WorkDir,
+	}
+
+	for containerPath, hostPath := range containerCall.Dirs {
+		hash, err := hashPath(hostPath)
+		if err != nil {
+			return "", fmt.Errorf("unable to hash dir %s: %w", containerPath, err)
+		}
+		keyInputs.Dirs[containerPath] = hash
+	}
+
+	for containerPath, hostPath := range containerCall.Files {
+		hash, err := hashPath(hostPath)
+		if err != nil {
+			return "", fmt.Errorf("unable to hash file %s: %w", containerPath, err)
+		}
+		keyInputs.Files[containerPath] = hash
+	}
+
+	if containerCall.Image.Ref != nil {
+		keyInputs.Image = imageDigest
+	} else if src := containerCall.Image.Src; src != nil && src.Dir != nil {
+		hash, err := hashPath(*src.Dir)
+		if err != nil {
+			return "", fmt.Errorf("unable to hash image: %w", err)
+		}
+		keyInputs.Image = hash
+	}
+
+	if containerCall.Stdin != nil {
+		hash, err := hashPath(*containerCall.Stdin)
+		if err != nil {
+			return "", fmt.Errorf("unable to hash stdin: %w", err)
+		}
+		keyInputs.Stdin = hash
+	}
+
+	for name := range outputs {
+		keyInputs.Outputs = append(keyInputs.Outputs, name)
+	}
+	sort.Strings(keyInputs.Outputs)
+
+	for containerSocket := range containerCall.Sockets {
+		keyInputs.Sockets = append(keyInputs.Sockets, containerSocket)
+	}
+	sort.Strings(keyInputs.Sockets)
+
+	// json.Marshal sorts map keys so encoding is stable
+	keyInputsBytes, err := json.Marshal(keyInputs)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(keyInputsBytes)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+func (cc _containerCache) Load(
+	key string,
+	outputs map[string]*model.Value,
+) (
+	[]*cachedLog,
+	bool,
+	error,
+) {
+	entryDirPath := filepath.Join(cc.cacheDirPath, key)
+
+	entryFile, err := readCacheEntryFile(entryDirPath)
+	if os.IsNotExist(err) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+
+	for name, output := range outputs {
+		cachedOutputPath := filepath.Join(entryDirPath, cacheOutputsDirName, name)
+		switch {
+		case output.File != nil:
+			if err := filecopier.New().OS(cachedOutputPath, *output.File); err != nil {
+				return nil, false, fmt.Errorf("unable to restore cached output %s: %w", name, err)
+			}
+		case output.Dir != nil:
+			if err := dircopier.New().OS(cachedOutputPath, *output.Dir); err != nil {
+				return nil, false, fmt.Errorf("unable to restore cached output %s: %w", name, err)
+			}
+		}
+	}
+
+	return entryFile.Logs, true, nil
+}
+
+func (cc _containerCache) Store(
+	key string,
+	containerCall *model.ContainerCall,
+	outputs map[string]*model.Value,
+	logs []*cachedLog,
+) error {
+	if err := os.MkdirAll(cc.cacheDirPath, 0700); err != nil {
+		return err
+	}
+
+	// build entry in a tmp dir then rename so partial entries are never loaded
+	tmpEntryDirPath, err := ioutil.TempDir(cc.cacheDirPath, ".tmp-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpEntryDirPath)
+
+	outputsDirPath := filepath.Join(tmpEntryDirPath, cacheOutputsDirName)
+	if err := os.MkdirAll(outputsDirPath, 0700); err != nil {
+		return err
+	}
+
+	for name, output := range outputs {
+		cachedOutputPath := filepath.Join(outputsDirPath, name)
+		switch {
+		case output.File != nil:
+			if err := filecopier.New().OS(*output.File, cachedOutputPath); err != nil {
+				return fmt.Errorf("unable to cache output %s: %w", name, err)
+			}
+		case output.Dir != nil:
+			if err := dircopier.New().OS(*output.Dir, cachedOutputPath); err != nil {
+				return fmt.Errorf("unable to cache output %s: %w", name, err)
+			}
+		}
+	}
+
+	sizeBytes, err := getPathSize(outputsDirPath)
+	if err != nil {
+		return err
+	}
+
+	entryFile := cacheEntryFile{
+		CacheEntry: model.CacheEntry{
+			Key:       key,
+			OpRef:     containerCall.OpPath,
+			CreatedAt: time.Now().UTC(),
+			SizeBytes: sizeBytes,
+		},
+		Logs: logs,
+	}
+	if containerCall.Image.Ref != nil {
+		entryFile.ImageRef = *containerCall.Image.Ref
+	}
+
+	entryFileBytes, err := json.Marshal(entryFile)
+	if err != nil {
+		return err
+	}
+
+	if err := ioutil.WriteFile(
+		filepath.Join(tmpEntryDirPath, cacheEntryFileName),
+		entryFileBytes,
+		0600,
+	); err != nil {
+		return err
+	}
+
+	entryDirPath := filepath.Join(cc.cacheDirPath, key)
+
+	// replace any existing entry
+	if err := os.RemoveAll(entryDirPath); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpEntryDirPath, entryDirPath)
+}
+
+func (cc _containerCache) List() ([]*model.CacheEntry, error) {
+	fileInfos, err := ioutil.ReadDir(cc.cacheDirPath)
+	if os.IsNotExist(err) {
+		return []*model.CacheEntry{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	entries := []*model.CacheEntry{}
+	for _, fileInfo := range fileInfos {
+		if !fileInfo.IsDir() || strings.HasPrefix(fileInfo.Name(), ".") {
+			// ignore anything not an entry (e.g. entries being stored)
+			continue
+		}
+
+		entryFile, err := readCacheEntryFile(filepath.Join(cc.cacheDirPath, fileInfo.Name()))
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		entry := entryFile.CacheEntry
+		entries = append(entries, &entry)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].CreatedAt.Before(entries[j].CreatedAt)
+	})
+
+	return entries, nil
+}
+
+func (cc _containerCache) Prune(
+	before *time.Time,
+) ([]*model.CacheEntry, error) {
+	entries, err := cc.List()
+	if err != nil {
+		return nil, err
+	}
+
+	prunedEntries := []*model.CacheEntry{}
+	for _, entry := range entries {
+		if before != nil && !entry.CreatedAt.Before(*before) {
+			continue
+		}
+
+		if err := os.RemoveAll(filepath.Join(cc.cacheDirPath, entry.Key)); err != nil {
+			return nil, err
+		}
+
+		prunedEntries = append(prunedEntries, entry)
+	}
+
+	return prunedEntries, nil
+}
+
+func readCacheEntryFile(
+	entryDirPath string,
+) (*cacheEntryFile, error) {
+	entryFileBytes, err := ioutil.ReadFile(filepath.Join(entryDirPath, cacheEntryFileName))
+	if err != nil {
+		return nil, err
+	}
+
+	entryFile := &cacheEntryFile{}
+	if err := json.Unmarshal(entryFileBytes, entryFile); err != nil {
+		return nil, fmt.Errorf("unable to read cache entry %s: %w", filepath.Base(entryDirPath), err)
+	}
+
+	return entryFile, nil
+}
+
+// hashPath hashes the relative paths, modes & contents of all files at path
+func hashPath(
+	path string,
+) (string, error) {
+	hash := sha256.New()
+
+	if _, err := os.Lstat(path); os.IsNotExist(err) {
+		// nothing to hash
+		return hex.EncodeToString(hash.Sum(nil)), nil
+	}
+
+	// filepath.Walk visits entries in lexical order so hashing is stable
+	err := filepath.Walk(
+		path,
+		func(filePath string, fileInfo os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+
+			relPath, err := filepath.Rel(path, filePath)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(hash, "%s\x00%s\x00", relPath, fileInfo.Mode())
+
+			switch {
+			case fileInfo.Mode()&os.ModeSymlink != 0:
+				target, err := os.Readlink(filePath)
+				if err != nil {
+					return err
+				}
+				io.WriteString(hash, target)
+			case fileInfo.Mode().IsRegular():
+				file, err := os.Open(filePath)
+				if err != nil {
+					return err
+				}
+				defer file.Close()
+
+				if _, err := io.Copy(hash, file); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// getPathSize gets the total size of all files at path
+func getPathSize(
+	path string,
+) (int64, error) {
+	var size int64
+	err := filepath.Walk(
+		path,
+		func(filePath string, fileInfo os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if fileInfo.Mode().IsRegular() {
+				size += fileInfo.Size()
+			}
+			return nil
+		},
+	)
+	return size, err
+}
@@ -0,0 +1,387 @@
+package core
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+var _ = Context("containerCache", func() {
+	newTempDir := func() string {
+		tempDir, err := ioutil.TempDir("", "")
+		if err != nil {
+			panic(err)
+		}
+		return tempDir
+	}
+
+	writeFile := func(path, content string) {
+		if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+			panic(err)
+		}
+	}
+
+	imageRef := "imageRef"
+	imageDigest := "sha256:imageDigest"
+
+	Context("GetKey", func() {
+		Context("mounted file content changes", func() {
+			It("should return a different key", func() {
+				/* arrange */
+				filePath := filepath.Join(newTempDir(), "file")
+				writeFile(filePath, "content1")
+
+				providedContainerCall := &model.ContainerCall{
+					Cmd:   []string{"cmd"},
+					Files: map[string]string{"/file": filePath},
+					Image: &model.ContainerCallImage{Ref: &imageRef},
+				}
+
+				objectUnderTest := newContainerCache(newTempDir())
+
+				firstKey, err := objectUnderTest.GetKey(providedContainerCall, imageDigest, map[string]*model.Value{})
+				if err != nil {
+					panic(err)
+				}
+
+				writeFile(filePath, "content2")
+
+				/* act */
+				actualKey, actualErr := objectUnderTest.GetKey(providedContainerCall, imageDigest, map[string]*model.Value{})
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualKey).NotTo(Equal(firstKey))
+			})
+		})
+		Context("image digest changes", func() {
+			It("should return a different key", func() {
+				/* arrange */
+				providedContainerCall := &model.ContainerCall{
+					Cmd:   []string{"cmd"},
+					Image: &model.ContainerCallImage{Ref: &imageRef},
+				}
+
+				objectUnderTest := newContainerCache(newTempDir())
+
+				firstKey, err := objectUnderTest.GetKey(providedContainerCall, imageDigest, map[string]*model.Value{})
+				if err != nil {
+					panic(err)
+				}
+
+				/* act */
+				actualKey, actualErr := objectUnderTest.GetKey(providedContainerCall, "sha256:otherImageDigest", map[string]*model.Value{})
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualKey).NotTo(Equal(firstKey))
+			})
+		})
+		Context("stdin content changes", func() {
+			It("should return a different key", func() {
+				/* arrange */
+				stdinPath := filepath.Join(newTempDir(), "stdin")
+				writeFile(stdinPath, "content1")
+
+				providedContainerCall := &model.ContainerCall{
+					Cmd:   []string{"cmd"},
+					Image: &model.ContainerCallImage{Ref: &imageRef},
+					Stdin: &stdinPath,
+				}
+
+				objectUnderTest := newContainerCache(newTempDir())
+
+				firstKey, err := objectUnderTest.GetKey(providedContainerCall, imageDigest, map[string]*model.Value{})
+				if err != nil {
+					panic(err)
+				}
+
+				writeFile(stdinPath, "content2")
+
+				/* act */
+				actualKey, actualErr := objectUnderTest.GetKey(providedContainerCall, imageDigest, map[string]*model.Value{})
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualKey).NotTo(Equal(firstKey))
+			})
+		})
+		// each field of a container call affecting its execution mutated to differ from the base call
+		mutationsByFieldName := map[string]func(containerCall *model.ContainerCall){
+			"capAdd":  func(containerCall *model.ContainerCall) { containerCall.CapAdd = []string{"NET_ADMIN"} },
+			"capDrop": func(containerCall *model.ContainerCall) { containerCall.CapDrop = []string{"CHOWN"} },
+			"cmd":     func(containerCall *model.ContainerCall) { containerCall.Cmd = []string{"other"} },
+			"envVars": func(containerCall *model.ContainerCall) { containerCall.EnvVars = map[string]string{"name": "other"} },
+			"ports":   func(containerCall *model.ContainerCall) { containerCall.Ports = map[string]string{"80": "8080"} },
+			"privileged": func(containerCall *model.ContainerCall) {
+				privileged := true
+				containerCall.Privileged = &privileged
+			},
+			"privileged false": func(containerCall *model.ContainerCall) {
+				privileged := false
+				containerCall.Privileged = &privileged
+			},
+			"readOnlyPaths":  func(containerCall *model.ContainerCall) { containerCall.ReadOnlyPaths = []string{"/dir"} },
+			"readOnlyRootFs": func(containerCall *model.ContainerCall) { containerCall.ReadOnlyRootFs = true },
+			"resources cpus": func(containerCall *model.ContainerCall) {
+				containerCall.Resources = &model.ContainerCallResources{Cpus: 1}
+			},
+			"resources memory": func(containerCall *model.ContainerCall) {
+				containerCall.Resources = &model.ContainerCallResources{Memory: 1073741824}
+			},
+			"resources memorySwap": func(containerCall *model.ContainerCall) {
+				containerCall.Resources = &model.ContainerCallResources{MemorySwap: -1}
+			},
+			"resources pidsLimit": func(containerCall *model.ContainerCall) {
+				containerCall.Resources = &model.ContainerCallResources{PidsLimit: 100}
+			},
+			"resources shmSize": func(containerCall *model.ContainerCall) {
+				containerCall.Resources = &model.ContainerCallResources{ShmSize: 1073741824}
+			},
+			"sockets": func(containerCall *model.ContainerCall) {
+				containerCall.Sockets = map[string]string{"/var/run/docker.sock": "/var/run/docker.sock"}
+			},
+			"tmpfs":   func(containerCall *model.ContainerCall) { containerCall.Tmpfs = map[string]int64{"/tmp": 0} },
+			"user":    func(containerCall *model.ContainerCall) { containerCall.User = "1000" },
+			"workDir": func(containerCall *model.ContainerCall) { containerCall.WorkDir = "/other" },
+		}
+		for fieldName, mutate := range mutationsByFieldName {
+			// copy before capturing; range vars have same address for every iteration
+			mutate := mutate
+			Context(fmt.Sprintf("%s differs", fieldName), func() {
+				It("should return a different key", func() {
+					/* arrange */
+					newContainerCall := func() *model.ContainerCall {
+						return &model.ContainerCall{
+							Cmd:     []string{"cmd"},
+							EnvVars: map[string]string{"name": "value"},
+							Image:   &model.ContainerCallImage{Ref: &imageRef},
+							WorkDir: "/workDir",
+						}
+					}
+					providedContainerCall := newContainerCall()
+					mutate(providedContainerCall)
+
+					objectUnderTest := newContainerCache(newTempDir())
+
+					firstKey, err := objectUnderTest.GetKey(newContainerCall(), imageDigest, map[string]*model.Value{})
+					if err != nil {
+						panic(err)
+					}
+
+					/* act */
+					actualKey, actualErr := objectUnderTest.GetKey(providedContainerCall, imageDigest, map[string]*model.Value{})
+
+					/* assert */
+					Expect(actualErr).To(BeNil())
+					Expect(actualKey).NotTo(Equal(firstKey))
+				})
+			})
+		}
+		Context("only mounted host paths differ", func() {
+			It("should return the same key", func() {
+				/* arrange */
+				firstDirPath := newTempDir()
+				writeFile(filepath.Join(firstDirPath, "file"), "content")
+
+				secondDirPath := newTempDir()
+				writeFile(filepath.Join(secondDirPath, "file"), "content")
+
+				objectUnderTest := newContainerCache(newTempDir())
+
+				firstKey, err := objectUnderTest.GetKey(
+					&model.ContainerCall{
+						ContainerID: "firstContainerID",
+						Dirs:        map[string]string{"/dir": firstDirPath},
+						Image:       &model.ContainerCallImage{Ref: &imageRef},
+					},
+					imageDigest,
+					map[string]*model.Value{},
+				)
+				if err != nil {
+					panic(err)
+				}
+
+				/* act */
+				actualKey, actualErr := objectUnderTest.GetKey(
+					&model.ContainerCall{
+						ContainerID: "secondContainerID",
+						Dirs:        map[string]string{"/dir": secondDirPath},
+						Image:       &model.ContainerCallImage{Ref: &imageRef},
+					},
+					imageDigest,
+					map[string]*model.Value{},
+				)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualKey).To(Equal(firstKey))
+			})
+		})
+	})
+	Context("Load", func() {
+		Context("no entry for key", func() {
+			It("should return expected result", func() {
+				/* arrange */
+				objectUnderTest := newContainerCache(newTempDir())
+
+				/* act */
+				actualLogs, actualIsHit, actualErr := objectUnderTest.Load("key", map[string]*model.Value{})
+
+				/* assert */
+				Expect(actualLogs).To(BeNil())
+				Expect(actualIsHit).To(BeFalse())
+				Expect(actualErr).To(BeNil())
+			})
+		})
+		Context("entry stored for key", func() {
+			It("should restore outputs & return logs", func() {
+				/* arrange */
+				storedFilePath := filepath.Join(newTempDir(), "file")
+				writeFile(storedFilePath, "content")
+
+				storedDirPath := newTempDir()
+				writeFile(filepath.Join(storedDirPath, "file"), "dirContent")
+
+				expectedLogs := []*cachedLog{
+					{Data: []byte("stdout\n")},
+					{Data: []byte("stderr\n"), IsStdErr: true},
+				}
+
+				objectUnderTest := newContainerCache(newTempDir())
+				err := objectUnderTest.Store(
+					"key",
+					&model.ContainerCall{Image: &model.ContainerCallImage{Ref: &imageRef}},
+					map[string]*model.Value{
+						"dir":  {Dir: &storedDirPath},
+						"file": {File: &storedFilePath},
+					},
+					expectedLogs,
+				)
+				if err != nil {
+					panic(err)
+				}
+
+				loadedFilePath := filepath.Join(newTempDir(), "file")
+				loadedDirPath := newTempDir()
+
+				/* act */
+				actualLogs, actualIsHit, actualErr := objectUnderTest.Load(
+					"key",
+					map[string]*model.Value{
+						"dir":  {Dir: &loadedDirPath},
+						"file": {File: &loadedFilePath},
+					},
+				)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualIsHit).To(BeTrue())
+				Expect(actualLogs).To(Equal(expectedLogs))
+
+				actualFileContent, err := ioutil.ReadFile(loadedFilePath)
+				if err != nil {
+					panic(err)
+				}
+				Expect(string(actualFileContent)).To(Equal("content"))
+
+				actualDirFileContent, err := ioutil.ReadFile(filepath.Join(loadedDirPath, "file"))
+				if err != nil {
+					panic(err)
+				}
+				Expect(string(actualDirFileContent)).To(Equal("dirContent"))
+			})
+		})
+	})
+	Context("Prune", func() {
+		Context("before nil", func() {
+			It("should remove all entries", func() {
+				/* arrange */
+				dataDirPath := newTempDir()
+				objectUnderTest := newContainerCache(dataDirPath)
+
+				for _, key := range []string{"key1", "key2"} {
+					err := objectUnderTest.Store(
+						key,
+						&model.ContainerCall{
+							BaseCall: model.BaseCall{OpPath: "opPath"},
+							Image:    &model.ContainerCallImage{Ref: &imageRef},
+						},
+						map[string]*model.Value{},
+						nil,
+					)
+					if err != nil {
+						panic(err)
+					}
+				}
+
+				/* act */
+				actualPruned, actualErr := objectUnderTest.Prune(nil)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualPruned).To(HaveLen(2))
+				Expect(actualPruned[0].ImageRef).To(Equal(imageRef))
+				Expect(actualPruned[0].OpRef).To(Equal("opPath"))
+
+				actualEntries, err := objectUnderTest.List()
+				if err != nil {
+					panic(err)
+				}
+				Expect(actualEntries).To(BeEmpty())
+			})
+		})
+		Context("before not nil", func() {
+			It("should only remove entries created before", func() {
+				/* arrange */
+				objectUnderTest := newContainerCache(newTempDir())
+
+				err := objectUnderTest.Store(
+					"key",
+					&model.ContainerCall{Image: &model.ContainerCallImage{Ref: &imageRef}},
+					map[string]*model.Value{},
+					nil,
+				)
+				if err != nil {
+					panic(err)
+				}
+
+				providedBefore := time.Now().Add(-time.Hour)
+
+				/* act */
+				actualPruned, actualErr := objectUnderTest.Prune(&providedBefore)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualPruned).To(BeEmpty())
+
+				actualEntries, err := objectUnderTest.List()
+				if err != nil {
+					panic(err)
+				}
+				Expect(actualEntries).To(HaveLen(1))
+			})
+		})
+	})
+	Context("List", func() {
+		Context("cache dir doesn't exist", func() {
+			It("should return empty result", func() {
+				/* arrange */
+				objectUnderTest := newContainerCache(filepath.Join(os.TempDir(), "doesNotExist"))
+
+				/* act */
+				actualEntries, actualErr := objectUnderTest.List()
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualEntries).To(BeEmpty())
+			})
+		})
+	})
+})
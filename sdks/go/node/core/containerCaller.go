@@ -2,8 +2,12 @@ package core
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/opctl/opctl/sdks/go/model"
@@ -12,6 +16,20 @@ import (
 	"github.com/opctl/opctl/sdks/go/pubsub"
 )
 
+// nonZeroExitCodeError is returned when a container exits w/ a nonzero exit code
+type nonZeroExitCodeError struct {
+	exitCode int64
+	// true if the container was killed for exceeding its memory limit
+	isOOMKilled bool
+}
+
+func (e nonZeroExitCodeError) Error() string {
+	if e.isOOMKilled {
+		return fmt.Sprintf("container killed after exceeding its memory limit (OOM); exit code: %d", e.exitCode)
+	}
+	return fmt.Sprintf("nonzero container exit code: %d", e.exitCode)
+}
+
 //counterfeiter:generate -o internal/fakes/containerCaller.go . containerCaller
 type containerCaller interface {
 	// Executes a container call
@@ -22,19 +40,24 @@ type containerCaller interface {
 		containerCallSpec *model.ContainerCallSpec,
 		rootCallID string,
 	) (
-		map[string]*model.Value,
-		error,
+		outputs map[string]*model.Value,
+		isCacheHit bool,
+		err error,
 	)
 }
 
 func newContainerCaller(
+	containerCache containerCache,
 	containerRuntime containerruntime.ContainerRuntime,
+	dataDirPath string,
 	pubSub pubsub.PubSub,
 	stateStore stateStore,
 ) containerCaller {
 
 	return _containerCaller{
+		containerCache:   containerCache,
 		containerRuntime: containerRuntime,
+		dataDirPath:      dataDirPath,
 		pubSub:           pubSub,
 		stateStore:       stateStore,
 	}
@@ -42,7 +65,9 @@ func newContainerCaller(
 }
 
 type _containerCaller struct {
+	containerCache   containerCache
 	containerRuntime containerruntime.ContainerRuntime
+	dataDirPath      string
 	pubSub           pubsub.PubSub
 	stateStore       stateStore
 }
@@ -55,6 +80,7 @@ func (cc _containerCaller) Call(
 	rootCallID string,
 ) (
 	map[string]*model.Value,
+	bool,
 	error,
 ) {
 	outputs := map[string]*model.Value{}
@@ -66,6 +92,88 @@ func (cc _containerCaller) Call(
 		}
 	}
 
+	outputs = cc.interpretOutputs(
+		containerCallSpec,
+		containerCall,
+	)
+
+	// captured std out/err are written beside the containers scratch fs
+	stdOutCapture, err := newStdioCapture(
+		"stdout",
+		containerCallSpec.StdOut,
+		filepath.Join(cc.dataDirPath, "dcg", containerCall.ContainerID, "stdout"),
+	)
+	if err != nil {
+		return nil, false, fmt.Errorf("unable to capture stdout: %w", err)
+	}
+	defer stdOutCapture.close()
+
+	stdErrCapture, err := newStdioCapture(
+		"stderr",
+		containerCallSpec.StdErr,
+		filepath.Join(cc.dataDirPath, "dcg", containerCall.ContainerID, "stderr"),
+	)
+	if err != nil {
+		return nil, false, fmt.Errorf("unable to capture stderr: %w", err)
+	}
+	defer stdErrCapture.close()
+
+	var cacheKey string
+	var logRecorder *cachedLogRecorder
+	// containers w/ readiness are services; they're awaited by other calls so are never cached.
+	// interactive containers' output depends on what's typed so are never cached either
+	isCacheable := containerCall.Cache && !hasSocketOutput(outputs) && containerCall.Readiness == nil && !containerCall.Interactive
+
+	var imageDigest string
+	if isCacheable && containerCall.Image.Ref != nil {
+		imageDigest, isCacheable, err = cc.resolveImageDigest(ctx, containerCall, rootCallID)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	if isCacheable {
+		var err error
+		cacheKey, err = cc.containerCache.GetKey(containerCall, imageDigest, outputs)
+		if err != nil {
+			return nil, false, fmt.Errorf("unable to get cache key: %w", err)
+		}
+
+		logs, isHit, err := cc.containerCache.Load(cacheKey, outputs)
+		if err != nil {
+			return nil, false, fmt.Errorf("unable to load from cache: %w", err)
+		}
+
+		if isHit {
+			cc.replayLogs(logs, containerCall, rootCallID)
+
+			for _, log := range logs {
+				if log.IsStdErr {
+					stdErrCapture.Write(log.Data)
+				} else {
+					stdOutCapture.Write(log.Data)
+				}
+			}
+			// only calls which exited zero are cached
+			var zero int64
+			cc.addCapturedOutputs(outputs, containerCallSpec, stdOutCapture, stdErrCapture, &zero)
+
+			return outputs, true, nil
+		}
+
+		logRecorder = &cachedLogRecorder{}
+	}
+
+	var stdin io.Reader
+	if containerCall.Stdin != nil {
+		stdinFile, err := os.Open(*containerCall.Stdin)
+		if err != nil {
+			return nil, false, fmt.Errorf("unable to open stdin: %w", err)
+		}
+		defer stdinFile.Close()
+		stdin = stdinFile
+	}
+
 	logStdOutPR, logStdOutPW := io.Pipe()
 	logStdErrPR, logStdErrPW := io.Pipe()
 
@@ -77,19 +185,35 @@ func (cc _containerCaller) Call(
 			logStdErrPR,
 			containerCall,
 			rootCallID,
+			logRecorder,
+			stdOutCapture,
+			stdErrCapture,
 		)
 	}()
 
-	outputs = cc.interpretOutputs(
-		containerCallSpec,
-		containerCall,
-	)
+	runCtx, cancelRun := context.WithCancel(ctx)
+	defer cancelRun()
+
+	readinessChan := make(chan error, 1)
+	if containerCall.Readiness != nil {
+		go func() {
+			readinessErr := cc.awaitReadiness(runCtx, containerCall, rootCallID)
+			if readinessErr != nil {
+				// a container which never becomes ready is killed
+				cancelRun()
+			}
+			readinessChan <- readinessErr
+		}()
+	} else {
+		readinessChan <- nil
+	}
 
 	rawExitCode, err := cc.containerRuntime.RunContainer(
-		ctx,
+		runCtx,
 		containerCall,
 		rootCallID,
 		cc.pubSub,
+		stdin,
 		logStdOutPW,
 		logStdErrPW,
 	)
@@ -100,7 +224,20 @@ func (cc _containerCaller) Call(
 	}
 
 	if exitCode != 0 {
-		err = fmt.Errorf("nonzero container exit code: %d", exitCode)
+		isOOMKilled := errors.As(err, &containerruntime.ErrOOMKilled{})
+		// when allowed, the exit code is data rather than failure; runtime errors still fail the call
+		if !containerCall.AllowNonZeroExit || isOOMKilled {
+			err = nonZeroExitCodeError{
+				exitCode:    exitCode,
+				isOOMKilled: isOOMKilled,
+			}
+		}
+	}
+
+	// stop awaiting readiness; it takes precedence since it causes the container to be killed
+	cancelRun()
+	if readinessErr := <-readinessChan; readinessErr != nil {
+		err = readinessErr
 	}
 
 	// wait on logChan
@@ -109,7 +246,150 @@ func (cc _containerCaller) Call(
 		err = logChanErr
 	}
 
-	return outputs, err
+	for _, capture := range []*stdioCapture{stdOutCapture, stdErrCapture} {
+		if captureErr := capture.Err(); err == nil {
+			err = captureErr
+		}
+	}
+
+	cc.addCapturedOutputs(outputs, containerCallSpec, stdOutCapture, stdErrCapture, rawExitCode)
+
+	// exit codes aren't cached so only cache calls which exited zero
+	if logRecorder != nil && err == nil && exitCode == 0 {
+		if err := cc.containerCache.Store(
+			cacheKey,
+			containerCall,
+			outputs,
+			logRecorder.logs,
+		); err != nil {
+			return outputs, false, fmt.Errorf("unable to store in cache: %w", err)
+		}
+	}
+
+	return outputs, false, err
+}
+
+// awaitReadiness probes a container every interval until it's ready then publishes CallReady.
+// An error is returned if the container isn't ready before the timeout; nil is returned if ctx is done first.
+func (cc _containerCaller) awaitReadiness(
+	ctx context.Context,
+	containerCall *model.ContainerCall,
+	rootCallID string,
+) error {
+	// durations were validated during interpretation
+	interval, _ := time.ParseDuration(containerCall.Readiness.Interval)
+
+	var timeoutChan <-chan time.Time
+	if containerCall.Readiness.Timeout != nil {
+		timeout, _ := time.ParseDuration(*containerCall.Readiness.Timeout)
+		timeoutChan = time.After(timeout)
+	}
+
+	var probeErr error
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-timeoutChan:
+			return fmt.Errorf("container not ready before timeout of %s: %v", *containerCall.Readiness.Timeout, probeErr)
+		case <-time.After(interval):
+		}
+
+		probeCtx, cancelProbe := context.WithTimeout(ctx, interval)
+		probeErr = cc.containerRuntime.ProbeContainer(
+			probeCtx,
+			containerCall.ContainerID,
+			containerCall.Readiness,
+		)
+		cancelProbe()
+
+		if probeErr == nil {
+			cc.pubSub.Publish(
+				model.Event{
+					Timestamp: time.Now().UTC(),
+					CallReady: &model.CallReady{
+						CallID:      containerCall.ContainerID,
+						ContainerID: containerCall.ContainerID,
+						OpRef:       containerCall.OpPath,
+						RootCallID:  rootCallID,
+					},
+				},
+			)
+			return nil
+		}
+	}
+}
+
+// resolveImageDigest resolves the digest of the image of a container call, which its results are cached by.
+// isResolved will be false if the runtime can't resolve digests & the image ref isn't pinned by one;
+// the tag might since have been pushed again so such calls aren't cached.
+func (cc _containerCaller) resolveImageDigest(
+	ctx context.Context,
+	containerCall *model.ContainerCall,
+	rootCallID string,
+) (
+	digest string,
+	isResolved bool,
+	err error,
+) {
+	resolvedDigest, err := cc.containerRuntime.ResolveImageDigest(
+		ctx,
+		containerCall,
+		rootCallID,
+		cc.pubSub,
+	)
+	if err != nil {
+		return "", false, fmt.Errorf("unable to resolve image digest: %w", err)
+	}
+	if resolvedDigest != nil {
+		return *resolvedDigest, true, nil
+	}
+
+	if strings.Contains(*containerCall.Image.Ref, "@sha256:") {
+		return *containerCall.Image.Ref, true, nil
+	}
+	return "", false, nil
+}
+
+// hasSocketOutput determines if any outputs are sockets; sockets only exist while a container runs so can't be cached
+func hasSocketOutput(
+	outputs map[string]*model.Value,
+) bool {
+	for _, output := range outputs {
+		if output.Socket != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// replayLogs publishes logs captured when a container call was cached as if the container wrote them
+func (this _containerCaller) replayLogs(
+	logs []*cachedLog,
+	containerCall *model.ContainerCall,
+	rootCallID string,
+) {
+	for _, log := range logs {
+		event := model.Event{
+			Timestamp: time.Now().UTC(),
+		}
+		if log.IsStdErr {
+			event.ContainerStdErrWrittenTo = &model.ContainerStdErrWrittenTo{
+				Data:        log.Data,
+				ContainerID: containerCall.ContainerID,
+				OpRef:       containerCall.OpPath,
+				RootCallID:  rootCallID,
+			}
+		} else {
+			event.ContainerStdOutWrittenTo = &model.ContainerStdOutWrittenTo{
+				Data:        log.Data,
+				ContainerID: containerCall.ContainerID,
+				OpRef:       containerCall.OpPath,
+				RootCallID:  rootCallID,
+			}
+		}
+		this.pubSub.Publish(event)
+	}
 }
 
 func (this _containerCaller) interpretLogs(
@@ -117,6 +397,9 @@ func (this _containerCaller) interpretLogs(
 	stdErrReader io.Reader,
 	containerCall *model.ContainerCall,
 	rootCallID string,
+	logRecorder *cachedLogRecorder,
+	stdOutCapture *stdioCapture,
+	stdErrCapture *stdioCapture,
 ) error {
 	stdOutLogChan := make(chan error, 1)
 	go func() {
@@ -124,6 +407,8 @@ func (this _containerCaller) interpretLogs(
 		stdOutLogChan <- readChunks(
 			stdOutReader,
 			func(chunk []byte) {
+				logRecorder.record(chunk, false)
+				stdOutCapture.Write(chunk)
 				this.pubSub.Publish(
 					model.Event{
 						Timestamp: time.Now().UTC(),
@@ -144,6 +429,8 @@ func (this _containerCaller) interpretLogs(
 		stdErrLogChan <- readChunks(
 			stdErrReader,
 			func(chunk []byte) {
+				logRecorder.record(chunk, true)
+				stdErrCapture.Write(chunk)
 				this.pubSub.Publish(
 					model.Event{
 						Timestamp: time.Now().UTC(),
@@ -173,6 +460,23 @@ func (this _containerCaller) interpretLogs(
 	return nil
 }
 
+// addCapturedOutputs adds std out, std err & exit code of a container to outputs per spec
+func (this _containerCaller) addCapturedOutputs(
+	outputs map[string]*model.Value,
+	containerCallSpec *model.ContainerCallSpec,
+	stdOutCapture *stdioCapture,
+	stdErrCapture *stdioCapture,
+	exitCode *int64,
+) {
+	stdOutCapture.addOutputs(outputs)
+	stdErrCapture.addOutputs(outputs)
+
+	if containerCallSpec.ExitCode != nil && exitCode != nil {
+		exitCodeNumber := float64(*exitCode)
+		outputs[opspec.RefToName(*containerCallSpec.ExitCode)] = &model.Value{Number: &exitCodeNumber}
+	}
+}
+
 func (this _containerCaller) interpretOutputs(
 	containerCallSpec *model.ContainerCallSpec,
 	containerCall *model.ContainerCall,
@@ -186,7 +490,7 @@ func (this _containerCaller) interpretOutputs(
 		}
 	}
 	for callSpecContainerFilePath, mountSrc := range containerCallSpec.Files {
-		mountSrcStr, ok := mountSrc.(string)
+		mountSrcStr, ok := mountSrcToRef(mountSrc)
 		if !ok {
 			continue
 		}
@@ -206,7 +510,7 @@ func (this _containerCaller) interpretOutputs(
 		}
 	}
 	for callSpecContainerDirPath, mountSrc := range containerCallSpec.Dirs {
-		mountSrcStr, ok := mountSrc.(string)
+		mountSrcStr, ok := mountSrcToRef(mountSrc)
 		if !ok {
 			continue
 		}
@@ -228,3 +532,25 @@ func (this _containerCaller) interpretOutputs(
 
 	return outputs
 }
+
+// mountSrcToRef returns the ref of a dir or file mount which can be set upon exit, if any.
+// Read only mounts can't be changed so have none.
+func mountSrcToRef(
+	mountSrc interface{},
+) (string, bool) {
+	if mountSpec, ok := mountSrc.(map[string]interface{}); ok {
+		for key := range mountSpec {
+			if key != "ref" && key != "readOnly" {
+				// initializer or tmpfs
+				return "", false
+			}
+		}
+		if readOnly, ok := mountSpec["readOnly"].(bool); ok && readOnly {
+			return "", false
+		}
+		mountSrc = mountSpec["ref"]
+	}
+
+	mountSrcStr, ok := mountSrc.(string)
+	return mountSrcStr, ok
+}
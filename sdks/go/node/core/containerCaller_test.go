@@ -10,6 +10,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/node/core/containerruntime"
 	. "github.com/opctl/opctl/sdks/go/node/core/containerruntime/fakes"
 	"github.com/opctl/opctl/sdks/go/pubsub"
 	. "github.com/opctl/opctl/sdks/go/pubsub/fakes"
@@ -32,7 +33,9 @@ var _ = Context("containerCaller", func() {
 		It("should return containerCaller", func() {
 			/* arrange/act/assert */
 			Expect(newContainerCaller(
+				newContainerCache(dbDir),
 				new(FakeContainerRuntime),
+				dbDir,
 				new(FakePubSub),
 				newStateStore(context.Background(), db, new(FakePubSub)),
 			)).To(Not(BeNil()))
@@ -54,6 +57,7 @@ var _ = Context("containerCaller", func() {
 				req *model.ContainerCall,
 				rootCallID string,
 				eventPublisher pubsub.EventPublisher,
+				stdin io.Reader,
 				stdOut io.WriteCloser,
 				stdErr io.WriteCloser,
 			) (*int64, error) {
@@ -85,11 +89,279 @@ var _ = Context("containerCaller", func() {
 				actualContainerCall,
 				actualRootCallID,
 				actualEventPublisher,
+				actualStdin,
 				_,
 				_ := fakeContainerRuntime.RunContainerArgsForCall(0)
 			Expect(actualContainerCall).To(Equal(providedContainerCall))
 			Expect(actualRootCallID).To(Equal(providedRootCallID))
 			Expect(actualEventPublisher).To(Equal(fakePubSub))
+			Expect(actualStdin).To(BeNil())
+		})
+		Context("containerCall.Stdin not nil", func() {
+			It("should call containerRuntime.RunContainer w/ content of stdin", func() {
+				/* arrange */
+				stdinFile, err := ioutil.TempFile("", "")
+				if err != nil {
+					panic(err)
+				}
+				stdinFile.WriteString("input")
+				stdinFile.Close()
+
+				stdinPath := stdinFile.Name()
+				fakeContainerRuntime := new(FakeContainerRuntime)
+
+				var actualStdin []byte
+				fakeContainerRuntime.RunContainerStub = func(
+					ctx context.Context,
+					req *model.ContainerCall,
+					rootCallID string,
+					eventPublisher pubsub.EventPublisher,
+					stdin io.Reader,
+					stdOut io.WriteCloser,
+					stdErr io.WriteCloser,
+				) (*int64, error) {
+
+					actualStdin, _ = ioutil.ReadAll(stdin)
+					stdErr.Close()
+					stdOut.Close()
+
+					return nil, nil
+				}
+
+				objectUnderTest := _containerCaller{
+					containerRuntime: fakeContainerRuntime,
+					pubSub:           new(FakePubSub),
+				}
+
+				/* act */
+				objectUnderTest.Call(
+					context.Background(),
+					&model.ContainerCall{
+						Image: &model.ContainerCallImage{},
+						Stdin: &stdinPath,
+					},
+					map[string]*model.Value{},
+					&model.ContainerCallSpec{},
+					"rootCallID",
+				)
+
+				/* assert */
+				Expect(string(actualStdin)).To(Equal("input"))
+			})
+		})
+		Context("containerCall.Cache true", func() {
+			It("should replay cached results on subsequent calls", func() {
+				/* arrange */
+				imageRef := "imageRef"
+				newContainerCall := func() *model.ContainerCall {
+					return &model.ContainerCall{
+						BaseCall:    model.BaseCall{},
+						Cache:       true,
+						Cmd:         []string{"cmd"},
+						ContainerID: "containerID",
+						Image:       &model.ContainerCallImage{Ref: &imageRef},
+					}
+				}
+
+				imageDigest := "sha256:imageDigest"
+
+				fakeContainerRuntime := new(FakeContainerRuntime)
+				fakeContainerRuntime.ResolveImageDigestReturns(&imageDigest, nil)
+				fakeContainerRuntime.RunContainerStub = func(
+					ctx context.Context,
+					req *model.ContainerCall,
+					rootCallID string,
+					eventPublisher pubsub.EventPublisher,
+					stdin io.Reader,
+					stdOut io.WriteCloser,
+					stdErr io.WriteCloser,
+				) (*int64, error) {
+					io.WriteString(stdOut, "stdout\n")
+					stdErr.Close()
+					stdOut.Close()
+
+					return nil, nil
+				}
+
+				fakePubSub := new(FakePubSub)
+
+				cacheDir, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				objectUnderTest := _containerCaller{
+					containerCache:   newContainerCache(cacheDir),
+					containerRuntime: fakeContainerRuntime,
+					pubSub:           fakePubSub,
+					stateStore:       newStateStore(context.Background(), db, fakePubSub),
+				}
+
+				_, firstIsCacheHit, err := objectUnderTest.Call(
+					context.Background(),
+					newContainerCall(),
+					map[string]*model.Value{},
+					&model.ContainerCallSpec{},
+					"rootCallID",
+				)
+				if err != nil {
+					panic(err)
+				}
+
+				/* act */
+				_, actualIsCacheHit, actualErr := objectUnderTest.Call(
+					context.Background(),
+					newContainerCall(),
+					map[string]*model.Value{},
+					&model.ContainerCallSpec{},
+					"rootCallID",
+				)
+
+				/* assert */
+				Expect(firstIsCacheHit).To(BeFalse())
+				Expect(actualErr).To(BeNil())
+				Expect(actualIsCacheHit).To(BeTrue())
+				Expect(fakeContainerRuntime.RunContainerCallCount()).To(Equal(1))
+
+				Expect(fakePubSub.PublishCallCount()).To(Equal(2))
+				actualReplayedEvent := fakePubSub.PublishArgsForCall(1)
+				Expect(string(actualReplayedEvent.ContainerStdOutWrittenTo.Data)).To(Equal("stdout\n"))
+			})
+		})
+		Context("containerCall.Cache true & image digest unresolved", func() {
+			It("should not replay cached results", func() {
+				/* arrange */
+				imageRef := "imageRef:latest"
+				newContainerCall := func() *model.ContainerCall {
+					return &model.ContainerCall{
+						Cache:       true,
+						Cmd:         []string{"cmd"},
+						ContainerID: "containerID",
+						Image:       &model.ContainerCallImage{Ref: &imageRef},
+					}
+				}
+
+				fakeContainerRuntime := new(FakeContainerRuntime)
+				// runtime can't resolve digests
+				fakeContainerRuntime.ResolveImageDigestReturns(nil, nil)
+				fakeContainerRuntime.RunContainerStub = func(
+					ctx context.Context,
+					req *model.ContainerCall,
+					rootCallID string,
+					eventPublisher pubsub.EventPublisher,
+					stdin io.Reader,
+					stdOut io.WriteCloser,
+					stdErr io.WriteCloser,
+				) (*int64, error) {
+					stdErr.Close()
+					stdOut.Close()
+
+					return nil, nil
+				}
+
+				fakePubSub := new(FakePubSub)
+
+				cacheDir, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				objectUnderTest := _containerCaller{
+					containerCache:   newContainerCache(cacheDir),
+					containerRuntime: fakeContainerRuntime,
+					pubSub:           fakePubSub,
+					stateStore:       newStateStore(context.Background(), db, fakePubSub),
+				}
+
+				objectUnderTest.Call(
+					context.Background(),
+					newContainerCall(),
+					map[string]*model.Value{},
+					&model.ContainerCallSpec{},
+					"rootCallID",
+				)
+
+				/* act */
+				_, actualIsCacheHit, actualErr := objectUnderTest.Call(
+					context.Background(),
+					newContainerCall(),
+					map[string]*model.Value{},
+					&model.ContainerCallSpec{},
+					"rootCallID",
+				)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualIsCacheHit).To(BeFalse())
+				Expect(fakeContainerRuntime.RunContainerCallCount()).To(Equal(2))
+			})
+		})
+		Context("containerCall.Cache true & containerCall.Interactive true", func() {
+			It("should not replay cached results", func() {
+				/* arrange */
+				imageRef := "imageRef"
+				newContainerCall := func() *model.ContainerCall {
+					return &model.ContainerCall{
+						Cache:       true,
+						Cmd:         []string{"cmd"},
+						ContainerID: "containerID",
+						Image:       &model.ContainerCallImage{Ref: &imageRef},
+						Interactive: true,
+					}
+				}
+
+				fakeContainerRuntime := new(FakeContainerRuntime)
+				fakeContainerRuntime.RunContainerStub = func(
+					ctx context.Context,
+					req *model.ContainerCall,
+					rootCallID string,
+					eventPublisher pubsub.EventPublisher,
+					stdin io.Reader,
+					stdOut io.WriteCloser,
+					stdErr io.WriteCloser,
+				) (*int64, error) {
+					stdErr.Close()
+					stdOut.Close()
+
+					return nil, nil
+				}
+
+				fakePubSub := new(FakePubSub)
+
+				cacheDir, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				objectUnderTest := _containerCaller{
+					containerCache:   newContainerCache(cacheDir),
+					containerRuntime: fakeContainerRuntime,
+					pubSub:           fakePubSub,
+					stateStore:       newStateStore(context.Background(), db, fakePubSub),
+				}
+
+				objectUnderTest.Call(
+					context.Background(),
+					newContainerCall(),
+					map[string]*model.Value{},
+					&model.ContainerCallSpec{},
+					"rootCallID",
+				)
+
+				/* act */
+				_, actualIsCacheHit, actualErr := objectUnderTest.Call(
+					context.Background(),
+					newContainerCall(),
+					map[string]*model.Value{},
+					&model.ContainerCallSpec{},
+					"rootCallID",
+				)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualIsCacheHit).To(BeFalse())
+				Expect(fakeContainerRuntime.RunContainerCallCount()).To(Equal(2))
+			})
 		})
 		Context("containerRuntime.RunContainer errors", func() {
 			It("should publish expected ContainerExited", func() {
@@ -104,6 +376,7 @@ var _ = Context("containerCaller", func() {
 					req *model.ContainerCall,
 					rootCallID string,
 					eventPublisher pubsub.EventPublisher,
+					stdin io.Reader,
 					stdOut io.WriteCloser,
 					stdErr io.WriteCloser,
 				) (*int64, error) {
@@ -120,7 +393,7 @@ var _ = Context("containerCaller", func() {
 				}
 
 				/* act */
-				actualOutputs, actualErr := objectUnderTest.Call(
+				actualOutputs, _, actualErr := objectUnderTest.Call(
 					context.Background(),
 					&model.ContainerCall{
 						BaseCall: model.BaseCall{},
@@ -136,6 +409,269 @@ var _ = Context("containerCaller", func() {
 				Expect(actualErr).To(MatchError(expectedErrorMessage))
 			})
 		})
+		Context("containerRuntime.RunContainer returns ErrOOMKilled", func() {
+			It("should return expected error", func() {
+				/* arrange */
+				fakeContainerRuntime := new(FakeContainerRuntime)
+
+				fakeContainerRuntime.RunContainerStub = func(
+					ctx context.Context,
+					req *model.ContainerCall,
+					rootCallID string,
+					eventPublisher pubsub.EventPublisher,
+					stdin io.Reader,
+					stdOut io.WriteCloser,
+					stdErr io.WriteCloser,
+				) (*int64, error) {
+
+					stdErr.Close()
+					stdOut.Close()
+
+					exitCode := int64(137)
+					return &exitCode, containerruntime.ErrOOMKilled{}
+				}
+
+				objectUnderTest := _containerCaller{
+					containerRuntime: fakeContainerRuntime,
+					pubSub:           new(FakePubSub),
+				}
+
+				/* act */
+				_, _, actualErr := objectUnderTest.Call(
+					context.Background(),
+					&model.ContainerCall{
+						BaseCall: model.BaseCall{},
+						Image:    &model.ContainerCallImage{},
+					},
+					map[string]*model.Value{},
+					&model.ContainerCallSpec{},
+					"rootCallID",
+				)
+
+				/* assert */
+				Expect(actualErr).To(MatchError("container killed after exceeding its memory limit (OOM); exit code: 137"))
+			})
+		})
+		Context("containerCall.AllowNonZeroExit true & containerRuntime.RunContainer errors", func() {
+			It("should return expected error", func() {
+				/* arrange */
+				expectedErrorMessage := "io: read/write on closed pipe"
+				fakeContainerRuntime := new(FakeContainerRuntime)
+
+				fakeContainerRuntime.RunContainerStub = func(
+					ctx context.Context,
+					req *model.ContainerCall,
+					rootCallID string,
+					eventPublisher pubsub.EventPublisher,
+					stdin io.Reader,
+					stdOut io.WriteCloser,
+					stdErr io.WriteCloser,
+				) (*int64, error) {
+
+					stdErr.Close()
+					stdOut.Close()
+
+					exitCode := int64(1)
+					return &exitCode, errors.New(expectedErrorMessage)
+				}
+
+				objectUnderTest := _containerCaller{
+					containerRuntime: fakeContainerRuntime,
+					pubSub:           new(FakePubSub),
+				}
+
+				/* act */
+				_, _, actualErr := objectUnderTest.Call(
+					context.Background(),
+					&model.ContainerCall{
+						AllowNonZeroExit: true,
+						BaseCall:         model.BaseCall{},
+						Image:            &model.ContainerCallImage{},
+					},
+					map[string]*model.Value{},
+					&model.ContainerCallSpec{},
+					"rootCallID",
+				)
+
+				/* assert */
+				Expect(actualErr).To(MatchError(expectedErrorMessage))
+			})
+		})
+		Context("containerCall.Readiness not nil", func() {
+			runUntilKilled := func(
+				ctx context.Context,
+				req *model.ContainerCall,
+				rootCallID string,
+				eventPublisher pubsub.EventPublisher,
+				stdin io.Reader,
+				stdOut io.WriteCloser,
+				stdErr io.WriteCloser,
+			) (*int64, error) {
+				defer stdErr.Close()
+				defer stdOut.Close()
+
+				<-ctx.Done()
+				return nil, nil
+			}
+
+			Context("containerRuntime.ProbeContainer succeeds", func() {
+				It("should publish expected CallReady", func() {
+					/* arrange */
+					providedContainerID := "dummyContainerID"
+					providedOpPath := "dummyOpPath"
+					providedRootCallID := "dummyRootCallID"
+
+					fakeContainerRuntime := new(FakeContainerRuntime)
+					fakeContainerRuntime.RunContainerStub = runUntilKilled
+
+					fakePubSub := new(FakePubSub)
+
+					objectUnderTest := _containerCaller{
+						containerRuntime: fakeContainerRuntime,
+						pubSub:           fakePubSub,
+					}
+
+					ctx, cancel := context.WithCancel(context.Background())
+					fakePubSub.PublishStub = func(event model.Event) {
+						if event.CallReady != nil {
+							// stop the container once ready
+							cancel()
+						}
+					}
+
+					/* act */
+					objectUnderTest.Call(
+						ctx,
+						&model.ContainerCall{
+							BaseCall: model.BaseCall{
+								OpPath: providedOpPath,
+							},
+							ContainerID: providedContainerID,
+							Image:       &model.ContainerCallImage{},
+							Readiness: &model.ContainerCallReadiness{
+								Exec:     []string{"true"},
+								Interval: "1ms",
+							},
+						},
+						map[string]*model.Value{},
+						&model.ContainerCallSpec{},
+						providedRootCallID,
+					)
+
+					/* assert */
+					actualEvent := fakePubSub.PublishArgsForCall(0)
+					Expect(*actualEvent.CallReady).To(Equal(model.CallReady{
+						CallID:      providedContainerID,
+						ContainerID: providedContainerID,
+						OpRef:       providedOpPath,
+						RootCallID:  providedRootCallID,
+					}))
+				})
+			})
+			Context("containerRuntime.ProbeContainer doesn't succeed before timeout", func() {
+				It("should return expected error", func() {
+					/* arrange */
+					fakeContainerRuntime := new(FakeContainerRuntime)
+					fakeContainerRuntime.RunContainerStub = runUntilKilled
+					fakeContainerRuntime.ProbeContainerReturns(errors.New("dummyError"))
+
+					objectUnderTest := _containerCaller{
+						containerRuntime: fakeContainerRuntime,
+						pubSub:           new(FakePubSub),
+					}
+
+					providedTimeout := "10ms"
+
+					/* act */
+					_, _, actualErr := objectUnderTest.Call(
+						context.Background(),
+						&model.ContainerCall{
+							BaseCall: model.BaseCall{},
+							Image:    &model.ContainerCallImage{},
+							Readiness: &model.ContainerCallReadiness{
+								Exec:     []string{"false"},
+								Interval: "1ms",
+								Timeout:  &providedTimeout,
+							},
+						},
+						map[string]*model.Value{},
+						&model.ContainerCallSpec{},
+						"rootCallID",
+					)
+
+					/* assert */
+					Expect(actualErr).To(MatchError("container not ready before timeout of 10ms: dummyError"))
+				})
+			})
+		})
+		Context("containerCallSpec binds std out, std err & exit code", func() {
+			It("should return expected outputs", func() {
+				/* arrange */
+				dataDir, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				fakeContainerRuntime := new(FakeContainerRuntime)
+
+				fakeContainerRuntime.RunContainerStub = func(
+					ctx context.Context,
+					req *model.ContainerCall,
+					rootCallID string,
+					eventPublisher pubsub.EventPublisher,
+					stdin io.Reader,
+					stdOut io.WriteCloser,
+					stdErr io.WriteCloser,
+				) (*int64, error) {
+
+					stdOut.Write([]byte("1.0.0\n"))
+					stdErr.Write([]byte("warning\n"))
+					stdErr.Close()
+					stdOut.Close()
+
+					exitCode := int64(3)
+					return &exitCode, nil
+				}
+
+				objectUnderTest := _containerCaller{
+					containerRuntime: fakeContainerRuntime,
+					dataDirPath:      dataDir,
+					pubSub:           new(FakePubSub),
+				}
+
+				stdOutRef := "$(version)"
+				stdErrRef := "$(warnings)"
+				exitCodeRef := "$(exitCode)"
+
+				/* act */
+				actualOutputs, _, actualErr := objectUnderTest.Call(
+					context.Background(),
+					&model.ContainerCall{
+						AllowNonZeroExit: true,
+						ContainerID:      "containerID",
+						Image:            &model.ContainerCallImage{},
+					},
+					map[string]*model.Value{},
+					&model.ContainerCallSpec{
+						ExitCode: &exitCodeRef,
+						StdErr:   &model.ContainerCallStdioSpec{File: &stdErrRef},
+						StdOut:   &model.ContainerCallStdioSpec{String: &stdOutRef},
+					},
+					"rootCallID",
+				)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(*actualOutputs["version"].String).To(Equal("1.0.0\n"))
+				Expect(*actualOutputs["exitCode"].Number).To(Equal(float64(3)))
+
+				actualStdErr, err := ioutil.ReadFile(*actualOutputs["warnings"].File)
+				if err != nil {
+					panic(err)
+				}
+				Expect(string(actualStdErr)).To(Equal("warning\n"))
+			})
+		})
 	})
 
 	It("should return expected results", func() {
@@ -159,6 +695,7 @@ var _ = Context("containerCaller", func() {
 			req *model.ContainerCall,
 			rootCallID string,
 			eventPublisher pubsub.EventPublisher,
+			stdin io.Reader,
 			stdOut io.WriteCloser,
 			stdErr io.WriteCloser,
 		) (*int64, error) {
@@ -175,7 +712,7 @@ var _ = Context("containerCaller", func() {
 		}
 
 		/* act */
-		actualOutputs, actualErr := objectUnderTest.Call(
+		actualOutputs, _, actualErr := objectUnderTest.Call(
 			context.Background(),
 			providedContainerCall,
 			providedInboundScope,
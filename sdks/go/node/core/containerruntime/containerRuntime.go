@@ -11,15 +11,52 @@ import (
 	"io"
 )
 
+// ErrOOMKilled is returned (along w/ the exit code) by RunContainer when a container is killed for exceeding its memory limit
+type ErrOOMKilled struct{}
+
+func (e ErrOOMKilled) Error() string {
+	return "container killed after exceeding its memory limit"
+}
+
 // ContainerRuntime defines the interface container runtimes must implement to be supported by
 //counterfeiter:generate -o fakes/containerRuntime.go . ContainerRuntime
 type ContainerRuntime interface {
+	// AttachContainer waits for an interactive container to be running then attaches stdin & stdout to its TTY.
+	// It returns once the container exits or ctx is done.
+	AttachContainer(
+		ctx context.Context,
+		containerID string,
+		stdin io.Reader,
+		stdout io.Writer,
+		// resizes will be propagated to the TTY of the container; nil if none
+		resizes <-chan model.TerminalSize,
+	) error
+
 	DeleteContainerIfExists(
 		ctx context.Context,
 		containerID string,
 	) error
 
+	// ProbeContainer runs the readiness probe of a running container once; nil is returned if it passed
+	ProbeContainer(
+		ctx context.Context,
+		containerID string,
+		readiness *model.ContainerCallReadiness,
+	) error
+
+	// ResolveImageDigest resolves the image ref of a container call to a digest of the image it refers to, pulling it if needed.
+	// nil is returned if the runtime can't resolve digests.
+	ResolveImageDigest(
+		ctx context.Context,
+		req *model.ContainerCall,
+		rootCallID string,
+		eventPublisher pubsub.EventPublisher,
+	) (*string, error)
+
 	// RunContainer creates, starts, and waits on a container. ExitCode &/Or an error will be returned
+	//
+	// expected errs:
+	//  - ErrOOMKilled if the container was killed for exceeding its memory limit
 	RunContainer(
 		ctx context.Context,
 		req *model.ContainerCall,
@@ -27,6 +64,8 @@ type ContainerRuntime interface {
 		rootCallID string,
 		// @TODO: get rid of this; just use stdout/stderr
 		eventPublisher pubsub.EventPublisher,
+		// stdin will be fed to std in of the container until EOF; nil if none
+		stdin io.Reader,
 		stdout io.WriteCloser,
 		stderr io.WriteCloser,
 	) (*int64, error)
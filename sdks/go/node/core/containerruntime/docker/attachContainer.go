@@ -0,0 +1,95 @@
+package docker
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	dockerClientPkg "github.com/docker/docker/client"
+	"github.com/opctl/opctl/sdks/go/model"
+	"golang.org/x/net/context"
+)
+
+// interval at which an interactive container is polled for having started
+const attachPollInterval = 100 * time.Millisecond
+
+func (ctp _containerRuntime) AttachContainer(
+	ctx context.Context,
+	containerID string,
+	stdin io.Reader,
+	stdout io.Writer,
+	resizes <-chan model.TerminalSize,
+) error {
+	containerName := getContainerName(containerID)
+
+	// the container might still be pulling/creating; wait until it's running
+	for {
+		containerJSON, err := ctp.dockerClient.ContainerInspect(ctx, containerName)
+		if err != nil && !dockerClientPkg.IsErrNotFound(err) {
+			return fmt.Errorf("unable to inspect container: %w", err)
+		}
+
+		if err == nil && containerJSON.ContainerJSONBase != nil && containerJSON.State != nil {
+			if containerJSON.State.Running {
+				break
+			}
+			if containerJSON.State.Status == "exited" || containerJSON.State.Status == "dead" {
+				return fmt.Errorf("unable to attach container: container %s", containerJSON.State.Status)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(attachPollInterval):
+		}
+	}
+
+	hijackedResponse, err := ctp.dockerClient.ContainerAttach(
+		ctx,
+		containerName,
+		types.ContainerAttachOptions{
+			// replay output written prior to attaching
+			Logs:   true,
+			Stdin:  true,
+			Stdout: true,
+			Stderr: true,
+			Stream: true,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to attach container: %w", err)
+	}
+	defer hijackedResponse.Close()
+
+	if resizes != nil {
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case size, ok := <-resizes:
+					if !ok {
+						return
+					}
+					// best effort; resizing fails if the container exited meanwhile
+					ctp.dockerClient.ContainerResize(
+						ctx,
+						containerName,
+						types.ResizeOptions{
+							Height: uint(size.Height),
+							Width:  uint(size.Width),
+						},
+					)
+				}
+			}
+		}()
+	}
+
+	go io.Copy(hijackedResponse.Conn, stdin)
+
+	// TTY output isn't multiplexed so it can be copied as is
+	_, err = io.Copy(stdout, hijackedResponse.Reader)
+	return err
+}
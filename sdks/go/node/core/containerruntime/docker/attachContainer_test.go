@@ -0,0 +1,120 @@
+package docker
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"github.com/docker/docker/api/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/opctl/opctl/sdks/go/node/core/containerruntime/docker/internal/fakes"
+)
+
+var _ = Context("AttachContainer", func() {
+	Context("dockerClient.ContainerInspect errors", func() {
+		It("should return expected error", func() {
+			/* arrange */
+			fakeDockerClient := new(FakeCommonAPIClient)
+			fakeDockerClient.ContainerInspectReturns(types.ContainerJSON{}, errors.New("dummyError"))
+
+			objectUnderTest := _containerRuntime{
+				dockerClient: fakeDockerClient,
+			}
+
+			/* act */
+			actualErr := objectUnderTest.AttachContainer(
+				context.Background(),
+				"dummyContainerID",
+				new(bytes.Buffer),
+				new(bytes.Buffer),
+				nil,
+			)
+
+			/* assert */
+			Expect(actualErr).To(MatchError("unable to inspect container: dummyError"))
+		})
+	})
+	Context("container exited", func() {
+		It("should return expected error", func() {
+			/* arrange */
+			fakeDockerClient := new(FakeCommonAPIClient)
+			fakeDockerClient.ContainerInspectReturns(
+				types.ContainerJSON{
+					ContainerJSONBase: &types.ContainerJSONBase{
+						State: &types.ContainerState{
+							Status: "exited",
+						},
+					},
+				},
+				nil,
+			)
+
+			objectUnderTest := _containerRuntime{
+				dockerClient: fakeDockerClient,
+			}
+
+			/* act */
+			actualErr := objectUnderTest.AttachContainer(
+				context.Background(),
+				"dummyContainerID",
+				new(bytes.Buffer),
+				new(bytes.Buffer),
+				nil,
+			)
+
+			/* assert */
+			Expect(actualErr).To(MatchError("unable to attach container: container exited"))
+		})
+	})
+	Context("container running", func() {
+		It("should call dockerClient.ContainerAttach w/ expected args", func() {
+			/* arrange */
+			providedCtx := context.Background()
+
+			fakeDockerClient := new(FakeCommonAPIClient)
+			fakeDockerClient.ContainerInspectReturns(
+				types.ContainerJSON{
+					ContainerJSONBase: &types.ContainerJSONBase{
+						State: &types.ContainerState{
+							Running: true,
+						},
+					},
+				},
+				nil,
+			)
+			// err to trigger immediate return
+			fakeDockerClient.ContainerAttachReturns(types.HijackedResponse{}, errors.New("dummyError"))
+
+			objectUnderTest := _containerRuntime{
+				dockerClient: fakeDockerClient,
+			}
+
+			/* act */
+			actualErr := objectUnderTest.AttachContainer(
+				providedCtx,
+				"dummyContainerID",
+				new(bytes.Buffer),
+				new(bytes.Buffer),
+				nil,
+			)
+
+			/* assert */
+			Expect(actualErr).To(MatchError("unable to attach container: dummyError"))
+
+			actualCtx,
+				actualContainerName,
+				actualOptions := fakeDockerClient.ContainerAttachArgsForCall(0)
+
+			Expect(actualCtx).To(Equal(providedCtx))
+			Expect(actualContainerName).To(Equal("opctl_dummyContainerID"))
+			Expect(actualOptions).To(Equal(types.ContainerAttachOptions{
+				Logs:   true,
+				Stdin:  true,
+				Stdout: true,
+				Stderr: true,
+				Stream: true,
+			}))
+		})
+	})
+})
@@ -12,16 +12,35 @@ func constructContainerConfig(
 	cmd []string,
 	envVars map[string]string,
 	imageRef string,
+	interactive bool,
+	openStdin bool,
 	portBindings nat.PortMap,
+	user string,
 	workDir string,
 ) *container.Config {
 	containerConfig := &container.Config{
 		Image:        imageRef,
+		User:         user,
 		WorkingDir:   workDir,
 		Tty:          true,
 		ExposedPorts: nat.PortSet{},
 	}
 
+	if openStdin {
+		containerConfig.AttachStdin = true
+		containerConfig.OpenStdin = true
+		// close stdin once the attached client detaches so the process sees EOF
+		containerConfig.StdinOnce = true
+		// a tty would echo stdin & never see EOF
+		containerConfig.Tty = false
+	}
+
+	if interactive {
+		// keep std in open so terminals can attach to the tty
+		containerConfig.AttachStdin = true
+		containerConfig.OpenStdin = true
+	}
+
 	for _, cmd := range cmd {
 		containerConfig.Entrypoint = append(containerConfig.Entrypoint, cmd)
 	}
@@ -27,6 +27,7 @@ var _ = Context("constructContainerConfig", func() {
 			"80/tcp":   []nat.PortBinding{},
 			"6060/udp": []nat.PortBinding{},
 		}
+		providedUser := "dummyUser"
 		providedWorkDir := "dummyWorkDir"
 
 		expectedResult := &container.Config{
@@ -34,6 +35,7 @@ var _ = Context("constructContainerConfig", func() {
 			Env:          []string{},
 			ExposedPorts: nat.PortSet{},
 			Image:        providedImageRef,
+			User:         providedUser,
 			WorkingDir:   providedWorkDir,
 			Tty:          true,
 		}
@@ -51,11 +53,56 @@ var _ = Context("constructContainerConfig", func() {
 			providedCmd,
 			providedEnvVars,
 			providedImageRef,
+			false,
+			false,
 			providedPortBindings,
+			providedUser,
 			providedWorkDir,
 		)
 
 		/* assert */
 		Expect(actualResult).To(Equal(expectedResult))
 	})
+	Context("openStdin true", func() {
+		It("should return expected result", func() {
+			/* act */
+			actualResult := constructContainerConfig(
+				[]string{},
+				map[string]string{},
+				"dummyImageRef",
+				false,
+				true,
+				nat.PortMap{},
+				"",
+				"",
+			)
+
+			/* assert */
+			Expect(actualResult.AttachStdin).To(BeTrue())
+			Expect(actualResult.OpenStdin).To(BeTrue())
+			Expect(actualResult.StdinOnce).To(BeTrue())
+			Expect(actualResult.Tty).To(BeFalse())
+		})
+	})
+	Context("interactive true", func() {
+		It("should return expected result", func() {
+			/* act */
+			actualResult := constructContainerConfig(
+				[]string{},
+				map[string]string{},
+				"dummyImageRef",
+				true,
+				false,
+				nat.PortMap{},
+				"",
+				"",
+			)
+
+			/* assert */
+			Expect(actualResult.AttachStdin).To(BeTrue())
+			Expect(actualResult.OpenStdin).To(BeTrue())
+			Expect(actualResult.StdinOnce).To(BeFalse())
+			Expect(actualResult.Tty).To(BeTrue())
+		})
+	})
 })
@@ -0,0 +1,26 @@
+package docker
+
+import (
+	"github.com/docker/docker/api/types/container"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+// constructResources constructs the docker resources of a container; nil containerCallResources are unlimited
+func constructResources(
+	containerCallResources *model.ContainerCallResources,
+) container.Resources {
+	resources := container.Resources{}
+	if containerCallResources == nil {
+		return resources
+	}
+
+	resources.Memory = containerCallResources.Memory
+	resources.MemorySwap = containerCallResources.MemorySwap
+	resources.NanoCPUs = int64(containerCallResources.Cpus * 1e9)
+	if containerCallResources.PidsLimit != 0 {
+		pidsLimit := containerCallResources.PidsLimit
+		resources.PidsLimit = &pidsLimit
+	}
+
+	return resources
+}
@@ -0,0 +1,43 @@
+package docker
+
+import (
+	"github.com/docker/docker/api/types/container"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+var _ = Context("constructResources", func() {
+	Context("containerCallResources nil", func() {
+		It("should return empty result", func() {
+			/* act */
+			actualResult := constructResources(nil)
+
+			/* assert */
+			Expect(actualResult).To(Equal(container.Resources{}))
+		})
+	})
+	It("should return expected result", func() {
+		/* arrange */
+		expectedPidsLimit := int64(100)
+
+		/* act */
+		actualResult := constructResources(
+			&model.ContainerCallResources{
+				Cpus:       1.5,
+				Memory:     1024,
+				MemorySwap: -1,
+				PidsLimit:  expectedPidsLimit,
+				ShmSize:    2048,
+			},
+		)
+
+		/* assert */
+		Expect(actualResult).To(Equal(container.Resources{
+			Memory:     1024,
+			MemorySwap: -1,
+			NanoCPUs:   1500000000,
+			PidsLimit:  &expectedPidsLimit,
+		}))
+	})
+})
@@ -11,5 +11,7 @@ type containerLogStreamer interface {
 		ctx context.Context,
 		containerName string,
 		dst io.Writer,
+		// logs of containers w/out a tty are multiplexed so must be demultiplexed
+		isTty bool,
 	) error
 }
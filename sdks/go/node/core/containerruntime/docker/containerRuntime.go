@@ -9,7 +9,11 @@ import (
 	"golang.org/x/net/context"
 )
 
-func New(ctx context.Context) (
+// New returns a docker container runtime; privilegedByDefault applies to containers which don't explicitly set privileged
+func New(
+	ctx context.Context,
+	privilegedByDefault bool,
+) (
 	containerRuntime containerruntime.ContainerRuntime,
 	err error,
 ) {
@@ -22,7 +26,7 @@ func New(ctx context.Context) (
 	// degrade client version to version of server
 	dockerClient.NegotiateAPIVersion(ctx)
 
-	rc, err := newRunContainer(ctx, dockerClient)
+	rc, err := newRunContainer(ctx, dockerClient, privilegedByDefault)
 	if err != nil {
 		return
 	}
@@ -3,9 +3,11 @@ package docker
 import (
 	"context"
 	"io"
+	"io/ioutil"
 
 	"github.com/docker/docker/api/types"
 	dockerClientPkg "github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
 )
 
 func newContainerStdErrStreamer(
@@ -24,6 +26,7 @@ func (cses _containerStdErrStreamer) Stream(
 	ctx context.Context,
 	containerName string,
 	dst io.Writer,
+	isTty bool,
 ) error {
 	src, err := cses.dockerClient.ContainerLogs(
 		ctx,
@@ -37,7 +40,11 @@ func (cses _containerStdErrStreamer) Stream(
 		return err
 	}
 
-	_, err = io.Copy(dst, src)
+	if isTty {
+		_, err = io.Copy(dst, src)
+	} else {
+		_, err = stdcopy.StdCopy(ioutil.Discard, dst, src)
+	}
 	src.Close()
 	return err
 }
@@ -44,6 +44,7 @@ var _ = Context("containerStdErrStreamer", func() {
 				providedCtx,
 				providedContainerName,
 				nopWriteCloser{ioutil.Discard},
+				true,
 			)
 
 			/* assert */
@@ -71,6 +72,7 @@ var _ = Context("containerStdErrStreamer", func() {
 					context.Background(),
 					"dummyContainerName",
 					nopWriteCloser{ioutil.Discard},
+					true,
 				)
 
 				/* assert */
@@ -104,6 +106,7 @@ var _ = Context("containerStdErrStreamer", func() {
 					context.Background(),
 					"dummyContainerName",
 					providedWriter,
+					true,
 				)
 
 				/* assert */
@@ -3,9 +3,11 @@ package docker
 import (
 	"context"
 	"io"
+	"io/ioutil"
 
 	"github.com/docker/docker/api/types"
 	dockerClientPkg "github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
 )
 
 func newContainerStdOutStreamer(
@@ -24,6 +26,7 @@ func (ctp _containerStdOutStreamer) Stream(
 	ctx context.Context,
 	containerName string,
 	dst io.Writer,
+	isTty bool,
 ) error {
 
 	src, err := ctp.dockerClient.ContainerLogs(
@@ -38,7 +41,11 @@ func (ctp _containerStdOutStreamer) Stream(
 		return err
 	}
 
-	_, err = io.Copy(dst, src)
+	if isTty {
+		_, err = io.Copy(dst, src)
+	} else {
+		_, err = stdcopy.StdCopy(dst, ioutil.Discard, src)
+	}
 	src.Close()
 	return err
 }
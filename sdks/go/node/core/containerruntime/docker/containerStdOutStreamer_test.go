@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/pkg/stdcopy"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/opctl/opctl/sdks/go/node/core/containerruntime/docker/internal/fakes"
@@ -37,6 +38,7 @@ var _ = Context("containerStdOutStreamer", func() {
 				providedCtx,
 				providedContainerName,
 				nopWriteCloser{ioutil.Discard},
+				true,
 			)
 
 			/* assert */
@@ -64,6 +66,7 @@ var _ = Context("containerStdOutStreamer", func() {
 					context.Background(),
 					"dummyContainerName",
 					nopWriteCloser{ioutil.Discard},
+					true,
 				)
 
 				/* assert */
@@ -97,11 +100,40 @@ var _ = Context("containerStdOutStreamer", func() {
 					context.Background(),
 					"dummyContainerName",
 					providedWriter,
+					true,
 				)
 
 				/* assert */
 				Expect(providedWriter.String()).To(Equal(expectedLogs))
 			})
+			Context("isTty false", func() {
+				It("should write demultiplexed std out to writeCloser", func() {
+					/* arrange */
+					providedWriter := bytes.NewBufferString("")
+
+					multiplexedLogs := bytes.NewBufferString("")
+					stdcopy.NewStdWriter(multiplexedLogs, stdcopy.Stdout).Write([]byte("stdout"))
+					stdcopy.NewStdWriter(multiplexedLogs, stdcopy.Stderr).Write([]byte("stderr"))
+
+					fakeDockerClient := new(FakeCommonAPIClient)
+					fakeDockerClient.ContainerLogsReturns(ioutil.NopCloser(multiplexedLogs), nil)
+
+					objectUnderTest := _containerStdOutStreamer{
+						dockerClient: fakeDockerClient,
+					}
+
+					/* act */
+					objectUnderTest.Stream(
+						context.Background(),
+						"dummyContainerName",
+						providedWriter,
+						false,
+					)
+
+					/* assert */
+					Expect(providedWriter.String()).To(Equal("stdout"))
+				})
+			})
 		})
 	})
 })
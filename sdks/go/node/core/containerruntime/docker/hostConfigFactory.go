@@ -8,6 +8,7 @@ import (
 	"github.com/docker/docker/api/types/mount"
 	dockerClientPkg "github.com/docker/docker/client"
 	"github.com/docker/go-connections/nat"
+	"github.com/opctl/opctl/sdks/go/model"
 )
 
 //counterfeiter:generate -o internal/fakes/hostConfigFactory.go . hostConfigFactory
@@ -16,7 +17,14 @@ type hostConfigFactory interface {
 		containerCallDirs map[string]string,
 		containerCallFiles map[string]string,
 		containerCallSockets map[string]string,
+		containerCallTmpfs map[string]int64,
+		readOnlyPaths []string,
 		portBindings nat.PortMap,
+		privileged bool,
+		capAdd []string,
+		capDrop []string,
+		readOnlyRootFs bool,
+		resources *model.ContainerCallResources,
 	) *container.HostConfig
 }
 
@@ -43,15 +51,32 @@ func (hcf _hostConfigFactory) Construct(
 	containerCallDirs map[string]string,
 	containerCallFiles map[string]string,
 	containerCallSockets map[string]string,
+	containerCallTmpfs map[string]int64,
+	readOnlyPaths []string,
 	portBindings nat.PortMap,
+	privileged bool,
+	capAdd []string,
+	capDrop []string,
+	readOnlyRootFs bool,
+	resources *model.ContainerCallResources,
 ) *container.HostConfig {
 	hostConfig := &container.HostConfig{
-		PortBindings: portBindings,
-		// support docker in docker
-		// @TODO: reconsider; can we avoid this?
-		// see for similar discussion: https://github.com/kubernetes/kubernetes/issues/391
-		Privileged: true,
+		CapAdd:         capAdd,
+		CapDrop:        capDrop,
+		PortBindings:   portBindings,
+		Privileged:     privileged,
+		ReadonlyRootfs: readOnlyRootFs,
+		Resources:      constructResources(resources),
 	}
+	if resources != nil {
+		hostConfig.ShmSize = resources.ShmSize
+	}
+
+	isReadOnlyByPath := map[string]bool{}
+	for _, readOnlyPath := range readOnlyPaths {
+		isReadOnlyByPath[readOnlyPath] = true
+	}
+
 	for containerFilePath, hostFilePath := range containerCallFiles {
 		hostConfig.Mounts = append(
 			hostConfig.Mounts,
@@ -60,6 +85,7 @@ func (hcf _hostConfigFactory) Construct(
 				Source:      hcf.fsPathConverter.LocalToEngine(hostFilePath),
 				Target:      containerFilePath,
 				Consistency: mount.ConsistencyCached,
+				ReadOnly:    isReadOnlyByPath[containerFilePath],
 			},
 		)
 	}
@@ -71,6 +97,19 @@ func (hcf _hostConfigFactory) Construct(
 				Source:      hcf.fsPathConverter.LocalToEngine(hostDirPath),
 				Target:      containerDirPath,
 				Consistency: mount.ConsistencyCached,
+				ReadOnly:    isReadOnlyByPath[containerDirPath],
+			},
+		)
+	}
+	for containerDirPath, sizeBytes := range containerCallTmpfs {
+		hostConfig.Mounts = append(
+			hostConfig.Mounts,
+			mount.Mount{
+				Type:   mount.TypeTmpfs,
+				Target: containerDirPath,
+				TmpfsOptions: &mount.TmpfsOptions{
+					SizeBytes: sizeBytes,
+				},
 			},
 		)
 	}
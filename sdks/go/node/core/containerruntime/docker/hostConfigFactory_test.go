@@ -7,6 +7,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/opctl/opctl/sdks/go/internal/iruntime"
+	"github.com/opctl/opctl/sdks/go/model"
 )
 
 var _ = Context("hostConfigFactory", func() {
@@ -59,6 +60,14 @@ var _ = Context("hostConfigFactory", func() {
 						Source:      "dir2HostPath",
 						Target:      "dir2ContainerPath",
 						Consistency: mount.ConsistencyCached,
+						ReadOnly:    true,
+					},
+					mount.Mount{
+						Type:   mount.TypeTmpfs,
+						Target: "/tmp",
+						TmpfsOptions: &mount.TmpfsOptions{
+							SizeBytes: 4096,
+						},
 					},
 					mount.Mount{
 						Type:   mount.TypeBind,
@@ -71,8 +80,15 @@ var _ = Context("hostConfigFactory", func() {
 						Target: "/unixSocket2ContainerAddress",
 					},
 				},
-				PortBindings: providedPortBindings,
-				Privileged:   true,
+				CapAdd:         []string{"NET_ADMIN"},
+				CapDrop:        []string{"ALL"},
+				PortBindings:   providedPortBindings,
+				Privileged:     true,
+				ReadonlyRootfs: true,
+				Resources: container.Resources{
+					Memory: 1024,
+				},
+				ShmSize: 2048,
 			}
 
 			objectUnderTest := _hostConfigFactory{
@@ -84,7 +100,17 @@ var _ = Context("hostConfigFactory", func() {
 				providedContainerDirs,
 				providedContainerFiles,
 				providedContainerSockets,
+				map[string]int64{"/tmp": 4096},
+				[]string{"dir2ContainerPath"},
 				providedPortBindings,
+				true,
+				[]string{"NET_ADMIN"},
+				[]string{"ALL"},
+				true,
+				&model.ContainerCallResources{
+					Memory:  1024,
+					ShmSize: 2048,
+				},
 			)
 
 			/* assert */
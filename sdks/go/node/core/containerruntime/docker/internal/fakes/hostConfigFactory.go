@@ -6,16 +6,24 @@ import (
 
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/go-connections/nat"
+	"github.com/opctl/opctl/sdks/go/model"
 )
 
 type FakeHostConfigFactory struct {
-	ConstructStub        func(map[string]string, map[string]string, map[string]string, nat.PortMap) *container.HostConfig
+	ConstructStub        func(map[string]string, map[string]string, map[string]string, map[string]int64, []string, nat.PortMap, bool, []string, []string, bool, *model.ContainerCallResources) *container.HostConfig
 	constructMutex       sync.RWMutex
 	constructArgsForCall []struct {
-		arg1 map[string]string
-		arg2 map[string]string
-		arg3 map[string]string
-		arg4 nat.PortMap
+		arg1  map[string]string
+		arg2  map[string]string
+		arg3  map[string]string
+		arg4  map[string]int64
+		arg5  []string
+		arg6  nat.PortMap
+		arg7  bool
+		arg8  []string
+		arg9  []string
+		arg10 bool
+		arg11 *model.ContainerCallResources
 	}
 	constructReturns struct {
 		result1 *container.HostConfig
@@ -27,19 +35,41 @@ type FakeHostConfigFactory struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeHostConfigFactory) Construct(arg1 map[string]string, arg2 map[string]string, arg3 map[string]string, arg4 nat.PortMap) *container.HostConfig {
+func (fake *FakeHostConfigFactory) Construct(arg1 map[string]string, arg2 map[string]string, arg3 map[string]string, arg4 map[string]int64, arg5 []string, arg6 nat.PortMap, arg7 bool, arg8 []string, arg9 []string, arg10 bool, arg11 *model.ContainerCallResources) *container.HostConfig {
+	var arg5Copy []string
+	if arg5 != nil {
+		arg5Copy = make([]string, len(arg5))
+		copy(arg5Copy, arg5)
+	}
+	var arg8Copy []string
+	if arg8 != nil {
+		arg8Copy = make([]string, len(arg8))
+		copy(arg8Copy, arg8)
+	}
+	var arg9Copy []string
+	if arg9 != nil {
+		arg9Copy = make([]string, len(arg9))
+		copy(arg9Copy, arg9)
+	}
 	fake.constructMutex.Lock()
 	ret, specificReturn := fake.constructReturnsOnCall[len(fake.constructArgsForCall)]
 	fake.constructArgsForCall = append(fake.constructArgsForCall, struct {
-		arg1 map[string]string
-		arg2 map[string]string
-		arg3 map[string]string
-		arg4 nat.PortMap
-	}{arg1, arg2, arg3, arg4})
-	fake.recordInvocation("Construct", []interface{}{arg1, arg2, arg3, arg4})
+		arg1  map[string]string
+		arg2  map[string]string
+		arg3  map[string]string
+		arg4  map[string]int64
+		arg5  []string
+		arg6  nat.PortMap
+		arg7  bool
+		arg8  []string
+		arg9  []string
+		arg10 bool
+		arg11 *model.ContainerCallResources
+	}{arg1, arg2, arg3, arg4, arg5Copy, arg6, arg7, arg8Copy, arg9Copy, arg10, arg11})
+	fake.recordInvocation("Construct", []interface{}{arg1, arg2, arg3, arg4, arg5Copy, arg6, arg7, arg8Copy, arg9Copy, arg10, arg11})
 	fake.constructMutex.Unlock()
 	if fake.ConstructStub != nil {
-		return fake.ConstructStub(arg1, arg2, arg3, arg4)
+		return fake.ConstructStub(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11)
 	}
 	if specificReturn {
 		return ret.result1
@@ -54,17 +84,17 @@ func (fake *FakeHostConfigFactory) ConstructCallCount() int {
 	return len(fake.constructArgsForCall)
 }
 
-func (fake *FakeHostConfigFactory) ConstructCalls(stub func(map[string]string, map[string]string, map[string]string, nat.PortMap) *container.HostConfig) {
+func (fake *FakeHostConfigFactory) ConstructCalls(stub func(map[string]string, map[string]string, map[string]string, map[string]int64, []string, nat.PortMap, bool, []string, []string, bool, *model.ContainerCallResources) *container.HostConfig) {
 	fake.constructMutex.Lock()
 	defer fake.constructMutex.Unlock()
 	fake.ConstructStub = stub
 }
 
-func (fake *FakeHostConfigFactory) ConstructArgsForCall(i int) (map[string]string, map[string]string, map[string]string, nat.PortMap) {
+func (fake *FakeHostConfigFactory) ConstructArgsForCall(i int) (map[string]string, map[string]string, map[string]string, map[string]int64, []string, nat.PortMap, bool, []string, []string, bool, *model.ContainerCallResources) {
 	fake.constructMutex.RLock()
 	defer fake.constructMutex.RUnlock()
 	argsForCall := fake.constructArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7, argsForCall.arg8, argsForCall.arg9, argsForCall.arg10, argsForCall.arg11
 }
 
 func (fake *FakeHostConfigFactory) ConstructReturns(result1 *container.HostConfig) {
@@ -0,0 +1,77 @@
+package docker
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/docker/docker/api/types"
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/node/core/containerruntime"
+	"golang.org/x/net/context"
+)
+
+func (ctp _containerRuntime) ProbeContainer(
+	ctx context.Context,
+	containerID string,
+	readiness *model.ContainerCallReadiness,
+) error {
+	containerName := getContainerName(containerID)
+
+	containerJSON, err := ctp.dockerClient.ContainerInspect(ctx, containerName)
+	if err != nil {
+		return fmt.Errorf("unable to inspect container: %w", err)
+	}
+	if containerJSON.ContainerJSONBase == nil || containerJSON.State == nil || !containerJSON.State.Running {
+		return errors.New("container not running")
+	}
+
+	if len(readiness.Exec) == 0 {
+		// network probes reach the container through the opctl network
+		ipAddress := ""
+		if containerJSON.NetworkSettings != nil {
+			if endpointSettings, ok := containerJSON.NetworkSettings.Networks[dockerNetworkName]; ok && endpointSettings != nil {
+				ipAddress = endpointSettings.IPAddress
+			}
+		}
+
+		return containerruntime.ProbeNetwork(ctx, ipAddress, readiness)
+	}
+
+	execIDResponse, err := ctp.dockerClient.ContainerExecCreate(
+		ctx,
+		containerName,
+		types.ExecConfig{
+			AttachStderr: true,
+			AttachStdout: true,
+			Cmd:          readiness.Exec,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to create exec: %w", err)
+	}
+
+	hijackedResponse, err := ctp.dockerClient.ContainerExecAttach(
+		ctx,
+		execIDResponse.ID,
+		types.ExecStartCheck{},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to start exec: %w", err)
+	}
+
+	// output of the probe isn't surfaced; drain it until the exec exits
+	io.Copy(ioutil.Discard, hijackedResponse.Reader)
+	hijackedResponse.Close()
+
+	execInspect, err := ctp.dockerClient.ContainerExecInspect(ctx, execIDResponse.ID)
+	if err != nil {
+		return fmt.Errorf("unable to inspect exec: %w", err)
+	}
+	if execInspect.ExitCode != 0 {
+		return fmt.Errorf("exec exited w/ code %d", execInspect.ExitCode)
+	}
+
+	return nil
+}
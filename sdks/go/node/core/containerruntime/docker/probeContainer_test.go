@@ -0,0 +1,201 @@
+package docker
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/network"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+	. "github.com/opctl/opctl/sdks/go/node/core/containerruntime/docker/internal/fakes"
+)
+
+var _ = Context("ProbeContainer", func() {
+	runningContainerJSON := types.ContainerJSON{
+		ContainerJSONBase: &types.ContainerJSONBase{
+			State: &types.ContainerState{
+				Running: true,
+			},
+		},
+	}
+	newHijackedResponse := func() types.HijackedResponse {
+		conn, _ := net.Pipe()
+		return types.HijackedResponse{
+			Conn:   conn,
+			Reader: bufio.NewReader(strings.NewReader("dummyOutput")),
+		}
+	}
+
+	Context("dockerClient.ContainerInspect errors", func() {
+		It("should return expected error", func() {
+			/* arrange */
+			fakeDockerClient := new(FakeCommonAPIClient)
+			fakeDockerClient.ContainerInspectReturns(types.ContainerJSON{}, errors.New("dummyError"))
+
+			objectUnderTest := _containerRuntime{
+				dockerClient: fakeDockerClient,
+			}
+
+			/* act */
+			actualErr := objectUnderTest.ProbeContainer(
+				context.Background(),
+				"dummyContainerID",
+				&model.ContainerCallReadiness{Exec: []string{"true"}},
+			)
+
+			/* assert */
+			Expect(actualErr).To(MatchError("unable to inspect container: dummyError"))
+		})
+	})
+	Context("container not running", func() {
+		It("should return expected error", func() {
+			/* arrange */
+			fakeDockerClient := new(FakeCommonAPIClient)
+			fakeDockerClient.ContainerInspectReturns(
+				types.ContainerJSON{
+					ContainerJSONBase: &types.ContainerJSONBase{
+						State: &types.ContainerState{},
+					},
+				},
+				nil,
+			)
+
+			objectUnderTest := _containerRuntime{
+				dockerClient: fakeDockerClient,
+			}
+
+			/* act */
+			actualErr := objectUnderTest.ProbeContainer(
+				context.Background(),
+				"dummyContainerID",
+				&model.ContainerCallReadiness{Exec: []string{"true"}},
+			)
+
+			/* assert */
+			Expect(actualErr).To(MatchError("container not running"))
+		})
+	})
+	Context("readiness.Exec not empty", func() {
+		It("should call dockerClient.ContainerExecCreate w/ expected args", func() {
+			/* arrange */
+			providedContainerID := "dummyContainerID"
+			providedExec := []string{"pg_isready"}
+
+			fakeDockerClient := new(FakeCommonAPIClient)
+			fakeDockerClient.ContainerInspectReturns(runningContainerJSON, nil)
+			// err to trigger immediate return
+			fakeDockerClient.ContainerExecCreateReturns(types.IDResponse{}, errors.New("dummyError"))
+
+			objectUnderTest := _containerRuntime{
+				dockerClient: fakeDockerClient,
+			}
+
+			/* act */
+			actualErr := objectUnderTest.ProbeContainer(
+				context.Background(),
+				providedContainerID,
+				&model.ContainerCallReadiness{Exec: providedExec},
+			)
+
+			/* assert */
+			_, actualContainerName, actualExecConfig := fakeDockerClient.ContainerExecCreateArgsForCall(0)
+			Expect(actualContainerName).To(Equal(getContainerName(providedContainerID)))
+			Expect(actualExecConfig.Cmd).To(Equal(providedExec))
+			Expect(actualErr).To(MatchError("unable to create exec: dummyError"))
+		})
+		Context("exec exits non zero", func() {
+			It("should return expected error", func() {
+				/* arrange */
+				fakeDockerClient := new(FakeCommonAPIClient)
+				fakeDockerClient.ContainerInspectReturns(runningContainerJSON, nil)
+				fakeDockerClient.ContainerExecCreateReturns(types.IDResponse{ID: "dummyExecID"}, nil)
+				fakeDockerClient.ContainerExecAttachReturns(newHijackedResponse(), nil)
+				fakeDockerClient.ContainerExecInspectReturns(types.ContainerExecInspect{ExitCode: 1}, nil)
+
+				objectUnderTest := _containerRuntime{
+					dockerClient: fakeDockerClient,
+				}
+
+				/* act */
+				actualErr := objectUnderTest.ProbeContainer(
+					context.Background(),
+					"dummyContainerID",
+					&model.ContainerCallReadiness{Exec: []string{"false"}},
+				)
+
+				/* assert */
+				_, actualExecID := fakeDockerClient.ContainerExecInspectArgsForCall(0)
+				Expect(actualExecID).To(Equal("dummyExecID"))
+				Expect(actualErr).To(MatchError("exec exited w/ code 1"))
+			})
+		})
+		Context("exec exits zero", func() {
+			It("should return nil", func() {
+				/* arrange */
+				fakeDockerClient := new(FakeCommonAPIClient)
+				fakeDockerClient.ContainerInspectReturns(runningContainerJSON, nil)
+				fakeDockerClient.ContainerExecCreateReturns(types.IDResponse{ID: "dummyExecID"}, nil)
+				fakeDockerClient.ContainerExecAttachReturns(newHijackedResponse(), nil)
+				fakeDockerClient.ContainerExecInspectReturns(types.ContainerExecInspect{}, nil)
+
+				objectUnderTest := _containerRuntime{
+					dockerClient: fakeDockerClient,
+				}
+
+				/* act */
+				actualErr := objectUnderTest.ProbeContainer(
+					context.Background(),
+					"dummyContainerID",
+					&model.ContainerCallReadiness{Exec: []string{"true"}},
+				)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+			})
+		})
+	})
+	Context("readiness.TCPPort not nil", func() {
+		It("should dial the container on the opctl network", func() {
+			/* arrange */
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			if err != nil {
+				panic(err)
+			}
+			defer listener.Close()
+
+			providedTCPPort := listener.Addr().(*net.TCPAddr).Port
+
+			fakeDockerClient := new(FakeCommonAPIClient)
+			fakeDockerClient.ContainerInspectReturns(
+				types.ContainerJSON{
+					ContainerJSONBase: runningContainerJSON.ContainerJSONBase,
+					NetworkSettings: &types.NetworkSettings{
+						Networks: map[string]*network.EndpointSettings{
+							dockerNetworkName: {IPAddress: "127.0.0.1"},
+						},
+					},
+				},
+				nil,
+			)
+
+			objectUnderTest := _containerRuntime{
+				dockerClient: fakeDockerClient,
+			}
+
+			/* act */
+			actualErr := objectUnderTest.ProbeContainer(
+				context.Background(),
+				"dummyContainerID",
+				&model.ContainerCallReadiness{TCPPort: &providedTCPPort},
+			)
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,34 @@
+package docker
+
+import (
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/pubsub"
+	"golang.org/x/net/context"
+)
+
+func (ctp _containerRuntime) ResolveImageDigest(
+	ctx context.Context,
+	req *model.ContainerCall,
+	rootCallID string,
+	eventPublisher pubsub.EventPublisher,
+) (*string, error) {
+	// pull first so tags pushed again since they were last pulled resolve to their new image
+	pullErr := newImagePuller(ctp.dockerClient).Pull(
+		ctx,
+		req,
+		rootCallID,
+		eventPublisher,
+	)
+
+	imageInspect, _, err := ctp.dockerClient.ImageInspectWithRaw(ctx, *req.Image.Ref)
+	if err != nil {
+		if pullErr != nil {
+			// image isn't present; the pull is why
+			return nil, pullErr
+		}
+		return nil, err
+	}
+
+	// image IDs are digests of image content
+	return &imageInspect.ID, nil
+}
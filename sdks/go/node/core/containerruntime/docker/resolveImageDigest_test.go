@@ -0,0 +1,77 @@
+package docker
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+
+	"github.com/docker/docker/api/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+	. "github.com/opctl/opctl/sdks/go/node/core/containerruntime/docker/internal/fakes"
+	. "github.com/opctl/opctl/sdks/go/pubsub/fakes"
+)
+
+var _ = Context("ResolveImageDigest", func() {
+	imageRef := "imageRef:latest"
+	providedContainerCall := &model.ContainerCall{
+		Image: &model.ContainerCallImage{Ref: &imageRef},
+	}
+
+	Context("image inspected", func() {
+		It("should return image ID", func() {
+			/* arrange */
+			expectedDigest := "sha256:dummyID"
+
+			fakeDockerClient := new(FakeCommonAPIClient)
+			fakeDockerClient.ImagePullReturns(ioutil.NopCloser(bytes.NewBufferString("")), nil)
+			fakeDockerClient.ImageInspectWithRawReturns(types.ImageInspect{ID: expectedDigest}, nil, nil)
+
+			objectUnderTest := _containerRuntime{
+				dockerClient: fakeDockerClient,
+			}
+
+			/* act */
+			actualDigest, actualErr := objectUnderTest.ResolveImageDigest(
+				context.Background(),
+				providedContainerCall,
+				"rootCallID",
+				new(FakeEventPublisher),
+			)
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(*actualDigest).To(Equal(expectedDigest))
+
+			_, actualImageRef := fakeDockerClient.ImageInspectWithRawArgsForCall(0)
+			Expect(actualImageRef).To(Equal(imageRef))
+		})
+	})
+	Context("pull & inspect error", func() {
+		It("should return pull error", func() {
+			/* arrange */
+			expectedErr := errors.New("pullErr")
+
+			fakeDockerClient := new(FakeCommonAPIClient)
+			fakeDockerClient.ImagePullReturns(nil, expectedErr)
+			fakeDockerClient.ImageInspectWithRawReturns(types.ImageInspect{}, nil, errors.New("not found"))
+
+			objectUnderTest := _containerRuntime{
+				dockerClient: fakeDockerClient,
+			}
+
+			/* act */
+			_, actualErr := objectUnderTest.ResolveImageDigest(
+				context.Background(),
+				providedContainerCall,
+				"rootCallID",
+				new(FakeEventPublisher),
+			)
+
+			/* assert */
+			Expect(actualErr).To(Equal(expectedErr))
+		})
+	})
+})
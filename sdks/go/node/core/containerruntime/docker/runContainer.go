@@ -14,6 +14,7 @@ import (
 	"github.com/docker/docker/api/types/network"
 	dockerClientPkg "github.com/docker/docker/client"
 	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/node/core/containerruntime"
 	"github.com/opctl/opctl/sdks/go/pubsub"
 )
 
@@ -23,6 +24,7 @@ type runContainer interface {
 		req *model.ContainerCall,
 		rootCallID string,
 		eventPublisher pubsub.EventPublisher,
+		stdin io.Reader,
 		stdout io.WriteCloser,
 		stderr io.WriteCloser,
 	) (*int64, error)
@@ -31,6 +33,7 @@ type runContainer interface {
 func newRunContainer(
 	ctx context.Context,
 	dockerClient dockerClientPkg.CommonAPIClient,
+	privilegedByDefault bool,
 ) (runContainer, error) {
 	hcf, err := newHostConfigFactory(ctx, dockerClient)
 	if err != nil {
@@ -45,6 +48,7 @@ func newRunContainer(
 		hostConfigFactory:       hcf,
 		imagePuller:             newImagePuller(dockerClient),
 		imagePusher:             newImagePusher(),
+		privilegedByDefault:     privilegedByDefault,
 	}
 	return rc, nil
 }
@@ -57,6 +61,8 @@ type _runContainer struct {
 	hostConfigFactory       hostConfigFactory
 	imagePuller             imagePuller
 	imagePusher             imagePusher
+	// privilegedByDefault applies to containers which don't explicitly set privileged
+	privilegedByDefault bool
 }
 
 func (cr _runContainer) RunContainer(
@@ -64,6 +70,7 @@ func (cr _runContainer) RunContainer(
 	req *model.ContainerCall,
 	rootCallID string,
 	eventPublisher pubsub.EventPublisher,
+	stdin io.Reader,
 	stdout io.WriteCloser,
 	stderr io.WriteCloser,
 ) (*int64, error) {
@@ -129,11 +136,23 @@ func (cr _runContainer) RunContainer(
 		return nil, err
 	}
 
+	privileged := cr.privilegedByDefault
+	if req.Privileged != nil {
+		privileged = *req.Privileged
+	}
+
 	hostConfig := cr.hostConfigFactory.Construct(
 		req.Dirs,
 		req.Files,
 		req.Sockets,
+		req.Tmpfs,
+		req.ReadOnlyPaths,
 		portBindings,
+		privileged,
+		req.CapAdd,
+		req.CapDrop,
+		req.ReadOnlyRootFs,
+		req.Resources,
 	)
 
 	// construct networking config
@@ -155,7 +174,10 @@ func (cr _runContainer) RunContainer(
 			req.Cmd,
 			req.EnvVars,
 			*req.Image.Ref,
+			req.Interactive,
+			stdin != nil,
 			portBindings,
+			req.User,
 			req.WorkDir,
 		),
 		hostConfig,
@@ -176,6 +198,28 @@ func (cr _runContainer) RunContainer(
 		}
 	}
 
+	if stdin != nil {
+		// attach before starting so no input is missed
+		hijackedResponse, err := cr.dockerClient.ContainerAttach(
+			ctx,
+			containerCreatedResponse.ID,
+			types.ContainerAttachOptions{
+				Stdin:  true,
+				Stream: true,
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to attach stdin: %w", err)
+		}
+		defer hijackedResponse.Close()
+
+		go func() {
+			io.Copy(hijackedResponse.Conn, stdin)
+			// signal EOF
+			hijackedResponse.CloseWrite()
+		}()
+	}
+
 	// start container
 	if err := cr.dockerClient.ContainerStart(
 		ctx,
@@ -194,6 +238,7 @@ func (cr _runContainer) RunContainer(
 			ctx,
 			containerName,
 			stderr,
+			stdin == nil,
 		); err != nil {
 			errChan <- err
 		}
@@ -205,6 +250,7 @@ func (cr _runContainer) RunContainer(
 			ctx,
 			containerName,
 			stdout,
+			stdin == nil,
 		); err != nil {
 			errChan <- err
 		}
@@ -231,6 +277,15 @@ func (cr _runContainer) RunContainer(
 		// non-destructively set err
 		err = <-errChan
 	}
+
+	if err == nil && exitCode != 0 {
+		// make best effort to determine if the container was killed for exceeding its memory limit
+		containerJSON, inspectErr := cr.dockerClient.ContainerInspect(ctx, containerName)
+		if inspectErr == nil && containerJSON.ContainerJSONBase != nil && containerJSON.State != nil && containerJSON.State.OOMKilled {
+			err = containerruntime.ErrOOMKilled{}
+		}
+	}
+
 	return &exitCode, err
 
 }
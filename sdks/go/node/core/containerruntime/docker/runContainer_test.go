@@ -3,7 +3,10 @@ package docker
 import (
 	"context"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"net"
+	"strings"
 
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
@@ -51,6 +54,7 @@ var _ = Context("RunContainer", func() {
 			providedReq,
 			"rootCallID",
 			new(FakeEventPublisher),
+			nil,
 			nopWriteCloser{ioutil.Discard},
 			nopWriteCloser{ioutil.Discard},
 		)
@@ -88,6 +92,7 @@ var _ = Context("RunContainer", func() {
 				},
 				"rootCallID",
 				new(FakeEventPublisher),
+				nil,
 				nopWriteCloser{ioutil.Discard},
 				nopWriteCloser{ioutil.Discard},
 			)
@@ -106,15 +111,21 @@ var _ = Context("RunContainer", func() {
 					"dir1ContainerPath": "dir1HostPath",
 					"dir2ContainerPath": "dir2HostPath",
 				},
+				CapAdd:  []string{"NET_ADMIN"},
+				CapDrop: []string{"ALL"},
 				Files: map[string]string{
 					"file1ContainerPath": "file1HostPath",
 					"file2ContainerPath": "file2HostPath",
 				},
-				Image: &model.ContainerCallImage{Ref: new(string)},
+				Image:          &model.ContainerCallImage{Ref: new(string)},
+				ReadOnlyRootFs: true,
+				ReadOnlyPaths:  []string{"/dir"},
+				Resources:      &model.ContainerCallResources{Memory: 1024},
 				Sockets: map[string]string{
 					"/unixSocket1ContainerAddress": "/unixSocket1HostAddress",
 					"/unixSocket2ContainerAddress": "/unixSocket2HostAddress",
 				},
+				Tmpfs: map[string]int64{"/tmp": 0},
 				Ports: map[string]string{
 					"80": "80",
 				},
@@ -145,6 +156,7 @@ var _ = Context("RunContainer", func() {
 				providedReq,
 				"rootCallID",
 				new(FakeEventPublisher),
+				nil,
 				nopWriteCloser{ioutil.Discard},
 				nopWriteCloser{ioutil.Discard},
 			)
@@ -153,11 +165,64 @@ var _ = Context("RunContainer", func() {
 			actualDirs,
 				actualFiles,
 				actualSockets,
-				actualPortBindings := fakeHostConfigFactory.ConstructArgsForCall(0)
+				actualTmpfs,
+				actualReadOnlyPaths,
+				actualPortBindings,
+				actualPrivileged,
+				actualCapAdd,
+				actualCapDrop,
+				actualReadOnlyRootFs,
+				actualResources := fakeHostConfigFactory.ConstructArgsForCall(0)
 			Expect(actualDirs).To(Equal(providedReq.Dirs))
 			Expect(actualFiles).To(Equal(providedReq.Files))
 			Expect(actualSockets).To(Equal(providedReq.Sockets))
+			Expect(actualTmpfs).To(Equal(providedReq.Tmpfs))
+			Expect(actualReadOnlyPaths).To(Equal(providedReq.ReadOnlyPaths))
 			Expect(actualPortBindings).To(Equal(portBindings))
+			Expect(actualPrivileged).To(BeFalse())
+			Expect(actualCapAdd).To(Equal(providedReq.CapAdd))
+			Expect(actualCapDrop).To(Equal(providedReq.CapDrop))
+			Expect(actualReadOnlyRootFs).To(Equal(providedReq.ReadOnlyRootFs))
+			Expect(actualResources).To(Equal(providedReq.Resources))
+		})
+
+		Context("req.Privileged nil", func() {
+			It("should call hostConfigFactory.Construct w/ privilegedByDefault", func() {
+				/* arrange */
+				providedReq := &model.ContainerCall{
+					Image: &model.ContainerCallImage{Ref: new(string)},
+				}
+
+				fakeHostConfigFactory := new(FakeHostConfigFactory)
+
+				fakeDockerClient := new(FakeCommonAPIClient)
+				fakeDockerClient.ContainerWaitReturns(closedContainerWaitOkBodyChan, nil)
+
+				objectUnderTest := _runContainer{
+					containerStdErrStreamer: new(FakeContainerLogStreamer),
+					containerStdOutStreamer: new(FakeContainerLogStreamer),
+					dockerClient:            fakeDockerClient,
+					ensureNetworkExistser:   new(FakeEnsureNetworkExistser),
+					hostConfigFactory:       fakeHostConfigFactory,
+					imagePuller:             new(FakeImagePuller),
+					privilegedByDefault:     true,
+				}
+
+				/* act */
+				objectUnderTest.RunContainer(
+					context.Background(),
+					providedReq,
+					"rootCallID",
+					new(FakeEventPublisher),
+					nil,
+					nopWriteCloser{ioutil.Discard},
+					nopWriteCloser{ioutil.Discard},
+				)
+
+				/* assert */
+				_, _, _, _, _, _, actualPrivileged, _, _, _, _ := fakeHostConfigFactory.ConstructArgsForCall(0)
+				Expect(actualPrivileged).To(BeTrue())
+			})
 		})
 
 		It("should call imagePuller.Pull w/ expected args", func() {
@@ -193,6 +258,7 @@ var _ = Context("RunContainer", func() {
 				providedReq,
 				providedRootCallID,
 				providedEventPublisher,
+				nil,
 				nopWriteCloser{ioutil.Discard},
 				nopWriteCloser{ioutil.Discard},
 			)
@@ -228,7 +294,10 @@ var _ = Context("RunContainer", func() {
 				providedReq.Cmd,
 				providedReq.EnvVars,
 				*providedReq.Image.Ref,
+				providedReq.Interactive,
+				false,
 				expectedPortBindings,
+				providedReq.User,
 				providedReq.WorkDir,
 			)
 
@@ -264,6 +333,7 @@ var _ = Context("RunContainer", func() {
 				providedReq,
 				"rootCallID",
 				new(FakeEventPublisher),
+				nil,
 				nopWriteCloser{ioutil.Discard},
 				nopWriteCloser{ioutil.Discard},
 			)
@@ -281,5 +351,58 @@ var _ = Context("RunContainer", func() {
 			Expect(actualNetworkingConfig).To(Equal(expectedNetworkingConfig))
 			Expect(actualContainerName).To(Equal(fmt.Sprintf("opctl_%s", providedReq.ContainerID)))
 		})
+
+		Context("stdin not nil", func() {
+			It("should attach & write stdin to container", func() {
+				/* arrange */
+				providedStdin := "input"
+				serverConn, clientConn := net.Pipe()
+
+				fakeDockerClient := new(FakeCommonAPIClient)
+				fakeDockerClient.ContainerAttachReturns(types.HijackedResponse{Conn: clientConn}, nil)
+
+				var actualStdin string
+				fakeDockerClient.ContainerWaitStub = func(
+					ctx context.Context,
+					containerID string,
+					condition container.WaitCondition,
+				) (<-chan container.ContainerWaitOKBody, <-chan error) {
+					// read before returning; the container is done once waited on
+					buf := make([]byte, len(providedStdin))
+					io.ReadFull(serverConn, buf)
+					actualStdin = string(buf)
+
+					return closedContainerWaitOkBodyChan, nil
+				}
+
+				objectUnderTest := _runContainer{
+					containerStdErrStreamer: new(FakeContainerLogStreamer),
+					containerStdOutStreamer: new(FakeContainerLogStreamer),
+					dockerClient:            fakeDockerClient,
+					ensureNetworkExistser:   new(FakeEnsureNetworkExistser),
+					hostConfigFactory:       new(FakeHostConfigFactory),
+					imagePuller:             new(FakeImagePuller),
+				}
+
+				/* act */
+				objectUnderTest.RunContainer(
+					context.Background(),
+					&model.ContainerCall{
+						ContainerID: "dummyContainerID",
+						Image:       &model.ContainerCallImage{Ref: new(string)},
+					},
+					"rootCallID",
+					new(FakeEventPublisher),
+					strings.NewReader(providedStdin),
+					nopWriteCloser{ioutil.Discard},
+					nopWriteCloser{ioutil.Discard},
+				)
+
+				/* assert */
+				_, _, actualOptions := fakeDockerClient.ContainerAttachArgsForCall(0)
+				Expect(actualOptions).To(Equal(types.ContainerAttachOptions{Stdin: true, Stream: true}))
+				Expect(actualStdin).To(Equal(providedStdin))
+			})
+		})
 	})
 })
@@ -12,6 +12,21 @@ import (
 )
 
 type FakeContainerRuntime struct {
+	AttachContainerStub        func(context.Context, string, io.Reader, io.Writer, <-chan model.TerminalSize) error
+	attachContainerMutex       sync.RWMutex
+	attachContainerArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 io.Reader
+		arg4 io.Writer
+		arg5 <-chan model.TerminalSize
+	}
+	attachContainerReturns struct {
+		result1 error
+	}
+	attachContainerReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DeleteContainerIfExistsStub        func(context.Context, string) error
 	deleteContainerIfExistsMutex       sync.RWMutex
 	deleteContainerIfExistsArgsForCall []struct {
@@ -24,15 +39,45 @@ type FakeContainerRuntime struct {
 	deleteContainerIfExistsReturnsOnCall map[int]struct {
 		result1 error
 	}
-	RunContainerStub        func(context.Context, *model.ContainerCall, string, pubsub.EventPublisher, io.WriteCloser, io.WriteCloser) (*int64, error)
+	ProbeContainerStub        func(context.Context, string, *model.ContainerCallReadiness) error
+	probeContainerMutex       sync.RWMutex
+	probeContainerArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *model.ContainerCallReadiness
+	}
+	probeContainerReturns struct {
+		result1 error
+	}
+	probeContainerReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ResolveImageDigestStub        func(context.Context, *model.ContainerCall, string, pubsub.EventPublisher) (*string, error)
+	resolveImageDigestMutex       sync.RWMutex
+	resolveImageDigestArgsForCall []struct {
+		arg1 context.Context
+		arg2 *model.ContainerCall
+		arg3 string
+		arg4 pubsub.EventPublisher
+	}
+	resolveImageDigestReturns struct {
+		result1 *string
+		result2 error
+	}
+	resolveImageDigestReturnsOnCall map[int]struct {
+		result1 *string
+		result2 error
+	}
+	RunContainerStub        func(context.Context, *model.ContainerCall, string, pubsub.EventPublisher, io.Reader, io.WriteCloser, io.WriteCloser) (*int64, error)
 	runContainerMutex       sync.RWMutex
 	runContainerArgsForCall []struct {
 		arg1 context.Context
 		arg2 *model.ContainerCall
 		arg3 string
 		arg4 pubsub.EventPublisher
-		arg5 io.WriteCloser
+		arg5 io.Reader
 		arg6 io.WriteCloser
+		arg7 io.WriteCloser
 	}
 	runContainerReturns struct {
 		result1 *int64
@@ -46,6 +91,70 @@ type FakeContainerRuntime struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeContainerRuntime) AttachContainer(arg1 context.Context, arg2 string, arg3 io.Reader, arg4 io.Writer, arg5 <-chan model.TerminalSize) error {
+	fake.attachContainerMutex.Lock()
+	ret, specificReturn := fake.attachContainerReturnsOnCall[len(fake.attachContainerArgsForCall)]
+	fake.attachContainerArgsForCall = append(fake.attachContainerArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 io.Reader
+		arg4 io.Writer
+		arg5 <-chan model.TerminalSize
+	}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("AttachContainer", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.attachContainerMutex.Unlock()
+	if fake.AttachContainerStub != nil {
+		return fake.AttachContainerStub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.attachContainerReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeContainerRuntime) AttachContainerCallCount() int {
+	fake.attachContainerMutex.RLock()
+	defer fake.attachContainerMutex.RUnlock()
+	return len(fake.attachContainerArgsForCall)
+}
+
+func (fake *FakeContainerRuntime) AttachContainerCalls(stub func(context.Context, string, io.Reader, io.Writer, <-chan model.TerminalSize) error) {
+	fake.attachContainerMutex.Lock()
+	defer fake.attachContainerMutex.Unlock()
+	fake.AttachContainerStub = stub
+}
+
+func (fake *FakeContainerRuntime) AttachContainerArgsForCall(i int) (context.Context, string, io.Reader, io.Writer, <-chan model.TerminalSize) {
+	fake.attachContainerMutex.RLock()
+	defer fake.attachContainerMutex.RUnlock()
+	argsForCall := fake.attachContainerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeContainerRuntime) AttachContainerReturns(result1 error) {
+	fake.attachContainerMutex.Lock()
+	defer fake.attachContainerMutex.Unlock()
+	fake.AttachContainerStub = nil
+	fake.attachContainerReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContainerRuntime) AttachContainerReturnsOnCall(i int, result1 error) {
+	fake.attachContainerMutex.Lock()
+	defer fake.attachContainerMutex.Unlock()
+	fake.AttachContainerStub = nil
+	if fake.attachContainerReturnsOnCall == nil {
+		fake.attachContainerReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.attachContainerReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeContainerRuntime) DeleteContainerIfExists(arg1 context.Context, arg2 string) error {
 	fake.deleteContainerIfExistsMutex.Lock()
 	ret, specificReturn := fake.deleteContainerIfExistsReturnsOnCall[len(fake.deleteContainerIfExistsArgsForCall)]
@@ -107,7 +216,135 @@ func (fake *FakeContainerRuntime) DeleteContainerIfExistsReturnsOnCall(i int, re
 	}{result1}
 }
 
-func (fake *FakeContainerRuntime) RunContainer(arg1 context.Context, arg2 *model.ContainerCall, arg3 string, arg4 pubsub.EventPublisher, arg5 io.WriteCloser, arg6 io.WriteCloser) (*int64, error) {
+func (fake *FakeContainerRuntime) ProbeContainer(arg1 context.Context, arg2 string, arg3 *model.ContainerCallReadiness) error {
+	fake.probeContainerMutex.Lock()
+	ret, specificReturn := fake.probeContainerReturnsOnCall[len(fake.probeContainerArgsForCall)]
+	fake.probeContainerArgsForCall = append(fake.probeContainerArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *model.ContainerCallReadiness
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("ProbeContainer", []interface{}{arg1, arg2, arg3})
+	fake.probeContainerMutex.Unlock()
+	if fake.ProbeContainerStub != nil {
+		return fake.ProbeContainerStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.probeContainerReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeContainerRuntime) ProbeContainerCallCount() int {
+	fake.probeContainerMutex.RLock()
+	defer fake.probeContainerMutex.RUnlock()
+	return len(fake.probeContainerArgsForCall)
+}
+
+func (fake *FakeContainerRuntime) ProbeContainerCalls(stub func(context.Context, string, *model.ContainerCallReadiness) error) {
+	fake.probeContainerMutex.Lock()
+	defer fake.probeContainerMutex.Unlock()
+	fake.ProbeContainerStub = stub
+}
+
+func (fake *FakeContainerRuntime) ProbeContainerArgsForCall(i int) (context.Context, string, *model.ContainerCallReadiness) {
+	fake.probeContainerMutex.RLock()
+	defer fake.probeContainerMutex.RUnlock()
+	argsForCall := fake.probeContainerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContainerRuntime) ProbeContainerReturns(result1 error) {
+	fake.probeContainerMutex.Lock()
+	defer fake.probeContainerMutex.Unlock()
+	fake.ProbeContainerStub = nil
+	fake.probeContainerReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContainerRuntime) ProbeContainerReturnsOnCall(i int, result1 error) {
+	fake.probeContainerMutex.Lock()
+	defer fake.probeContainerMutex.Unlock()
+	fake.ProbeContainerStub = nil
+	if fake.probeContainerReturnsOnCall == nil {
+		fake.probeContainerReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.probeContainerReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContainerRuntime) ResolveImageDigest(arg1 context.Context, arg2 *model.ContainerCall, arg3 string, arg4 pubsub.EventPublisher) (*string, error) {
+	fake.resolveImageDigestMutex.Lock()
+	ret, specificReturn := fake.resolveImageDigestReturnsOnCall[len(fake.resolveImageDigestArgsForCall)]
+	fake.resolveImageDigestArgsForCall = append(fake.resolveImageDigestArgsForCall, struct {
+		arg1 context.Context
+		arg2 *model.ContainerCall
+		arg3 string
+		arg4 pubsub.EventPublisher
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("ResolveImageDigest", []interface{}{arg1, arg2, arg3, arg4})
+	fake.resolveImageDigestMutex.Unlock()
+	if fake.ResolveImageDigestStub != nil {
+		return fake.ResolveImageDigestStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.resolveImageDigestReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContainerRuntime) ResolveImageDigestCallCount() int {
+	fake.resolveImageDigestMutex.RLock()
+	defer fake.resolveImageDigestMutex.RUnlock()
+	return len(fake.resolveImageDigestArgsForCall)
+}
+
+func (fake *FakeContainerRuntime) ResolveImageDigestCalls(stub func(context.Context, *model.ContainerCall, string, pubsub.EventPublisher) (*string, error)) {
+	fake.resolveImageDigestMutex.Lock()
+	defer fake.resolveImageDigestMutex.Unlock()
+	fake.ResolveImageDigestStub = stub
+}
+
+func (fake *FakeContainerRuntime) ResolveImageDigestArgsForCall(i int) (context.Context, *model.ContainerCall, string, pubsub.EventPublisher) {
+	fake.resolveImageDigestMutex.RLock()
+	defer fake.resolveImageDigestMutex.RUnlock()
+	argsForCall := fake.resolveImageDigestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeContainerRuntime) ResolveImageDigestReturns(result1 *string, result2 error) {
+	fake.resolveImageDigestMutex.Lock()
+	defer fake.resolveImageDigestMutex.Unlock()
+	fake.ResolveImageDigestStub = nil
+	fake.resolveImageDigestReturns = struct {
+		result1 *string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContainerRuntime) ResolveImageDigestReturnsOnCall(i int, result1 *string, result2 error) {
+	fake.resolveImageDigestMutex.Lock()
+	defer fake.resolveImageDigestMutex.Unlock()
+	fake.ResolveImageDigestStub = nil
+	if fake.resolveImageDigestReturnsOnCall == nil {
+		fake.resolveImageDigestReturnsOnCall = make(map[int]struct {
+			result1 *string
+			result2 error
+		})
+	}
+	fake.resolveImageDigestReturnsOnCall[i] = struct {
+		result1 *string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContainerRuntime) RunContainer(arg1 context.Context, arg2 *model.ContainerCall, arg3 string, arg4 pubsub.EventPublisher, arg5 io.Reader, arg6 io.WriteCloser, arg7 io.WriteCloser) (*int64, error) {
 	fake.runContainerMutex.Lock()
 	ret, specificReturn := fake.runContainerReturnsOnCall[len(fake.runContainerArgsForCall)]
 	fake.runContainerArgsForCall = append(fake.runContainerArgsForCall, struct {
@@ -115,13 +352,14 @@ func (fake *FakeContainerRuntime) RunContainer(arg1 context.Context, arg2 *model
 		arg2 *model.ContainerCall
 		arg3 string
 		arg4 pubsub.EventPublisher
-		arg5 io.WriteCloser
+		arg5 io.Reader
 		arg6 io.WriteCloser
-	}{arg1, arg2, arg3, arg4, arg5, arg6})
-	fake.recordInvocation("RunContainer", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+		arg7 io.WriteCloser
+	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+	fake.recordInvocation("RunContainer", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
 	fake.runContainerMutex.Unlock()
 	if fake.RunContainerStub != nil {
-		return fake.RunContainerStub(arg1, arg2, arg3, arg4, arg5, arg6)
+		return fake.RunContainerStub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -136,17 +374,17 @@ func (fake *FakeContainerRuntime) RunContainerCallCount() int {
 	return len(fake.runContainerArgsForCall)
 }
 
-func (fake *FakeContainerRuntime) RunContainerCalls(stub func(context.Context, *model.ContainerCall, string, pubsub.EventPublisher, io.WriteCloser, io.WriteCloser) (*int64, error)) {
+func (fake *FakeContainerRuntime) RunContainerCalls(stub func(context.Context, *model.ContainerCall, string, pubsub.EventPublisher, io.Reader, io.WriteCloser, io.WriteCloser) (*int64, error)) {
 	fake.runContainerMutex.Lock()
 	defer fake.runContainerMutex.Unlock()
 	fake.RunContainerStub = stub
 }
 
-func (fake *FakeContainerRuntime) RunContainerArgsForCall(i int) (context.Context, *model.ContainerCall, string, pubsub.EventPublisher, io.WriteCloser, io.WriteCloser) {
+func (fake *FakeContainerRuntime) RunContainerArgsForCall(i int) (context.Context, *model.ContainerCall, string, pubsub.EventPublisher, io.Reader, io.WriteCloser, io.WriteCloser) {
 	fake.runContainerMutex.RLock()
 	defer fake.runContainerMutex.RUnlock()
 	argsForCall := fake.runContainerArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
 }
 
 func (fake *FakeContainerRuntime) RunContainerReturns(result1 *int64, result2 error) {
@@ -178,8 +416,14 @@ func (fake *FakeContainerRuntime) RunContainerReturnsOnCall(i int, result1 *int6
 func (fake *FakeContainerRuntime) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.attachContainerMutex.RLock()
+	defer fake.attachContainerMutex.RUnlock()
 	fake.deleteContainerIfExistsMutex.RLock()
 	defer fake.deleteContainerIfExistsMutex.RUnlock()
+	fake.probeContainerMutex.RLock()
+	defer fake.probeContainerMutex.RUnlock()
+	fake.resolveImageDigestMutex.RLock()
+	defer fake.resolveImageDigestMutex.RUnlock()
 	fake.runContainerMutex.RLock()
 	defer fake.runContainerMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -1,15 +1,20 @@
 package k8s
 
 import (
+	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/opctl/opctl/sdks/go/model"
 	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func constructPod(
 	req *model.ContainerCall,
+	privilegedByDefault bool,
 ) (*coreV1.Pod, error) {
 
 	podName := constructPodName(req.ContainerID)
@@ -28,6 +33,26 @@ func constructPod(
 		},
 	}
 
+	container.Resources = constructResourceRequirements(req.Resources)
+
+	if req.Stdin != nil {
+		container.Stdin = true
+		// close stdin once the attached client detaches so the process sees EOF
+		container.StdinOnce = true
+	}
+
+	if req.Interactive {
+		// keep std in open so terminals can attach to the tty
+		container.Stdin = true
+		container.TTY = true
+	}
+
+	securityContext, err := constructSecurityContext(req, privilegedByDefault)
+	if err != nil {
+		return nil, err
+	}
+	container.SecurityContext = securityContext
+
 	for _, cmd := range req.Cmd {
 		container.Command = append(
 			container.Command,
@@ -45,6 +70,11 @@ func constructPod(
 		)
 	}
 
+	isReadOnlyByPath := map[string]bool{}
+	for _, readOnlyPath := range req.ReadOnlyPaths {
+		isReadOnlyByPath[readOnlyPath] = true
+	}
+
 	pathPrefix := "/root/opctl/"
 	for containerPath, hostPath := range req.Dirs {
 		container.VolumeMounts = append(
@@ -52,6 +82,7 @@ func constructPod(
 			coreV1.VolumeMount{
 				Name:      "opctl",
 				MountPath: containerPath,
+				ReadOnly:  isReadOnlyByPath[containerPath],
 				SubPath:   strings.TrimPrefix(hostPath, pathPrefix),
 			},
 		)
@@ -63,11 +94,83 @@ func constructPod(
 			coreV1.VolumeMount{
 				Name:      "opctl",
 				MountPath: containerPath,
+				ReadOnly:  isReadOnlyByPath[containerPath],
 				SubPath:   strings.TrimPrefix(hostPath, pathPrefix),
 			},
 		)
 	}
 
+	volumes := []coreV1.Volume{
+		{
+			Name: "opctl",
+			VolumeSource: coreV1.VolumeSource{
+				PersistentVolumeClaim: &coreV1.PersistentVolumeClaimVolumeSource{
+					ClaimName: "opctl",
+				},
+			},
+		},
+	}
+
+	// sort tmpfs paths to make volume names deterministic
+	tmpfsPaths := []string{}
+	for containerPath := range req.Tmpfs {
+		tmpfsPaths = append(tmpfsPaths, containerPath)
+	}
+	sort.Strings(tmpfsPaths)
+
+	for i, containerPath := range tmpfsPaths {
+		// k8s has no tmpfs; mount a memory backed volume instead
+		volumeName := fmt.Sprintf("tmpfs-%d", i)
+
+		emptyDir := &coreV1.EmptyDirVolumeSource{
+			Medium: coreV1.StorageMediumMemory,
+		}
+		if sizeBytes := req.Tmpfs[containerPath]; sizeBytes != 0 {
+			emptyDir.SizeLimit = resource.NewQuantity(sizeBytes, resource.BinarySI)
+		}
+
+		volumes = append(
+			volumes,
+			coreV1.Volume{
+				Name: volumeName,
+				VolumeSource: coreV1.VolumeSource{
+					EmptyDir: emptyDir,
+				},
+			},
+		)
+		container.VolumeMounts = append(
+			container.VolumeMounts,
+			coreV1.VolumeMount{
+				Name:      volumeName,
+				MountPath: containerPath,
+			},
+		)
+	}
+
+	if req.Resources != nil && req.Resources.ShmSize != 0 {
+		// k8s has no shm size; mount a memory backed volume instead
+		shmSize := resource.NewQuantity(req.Resources.ShmSize, resource.BinarySI)
+		volumes = append(
+			volumes,
+			coreV1.Volume{
+				Name: "shm",
+				VolumeSource: coreV1.VolumeSource{
+					EmptyDir: &coreV1.EmptyDirVolumeSource{
+						Medium:    coreV1.StorageMediumMemory,
+						SizeLimit: shmSize,
+					},
+				},
+			},
+		)
+		container.VolumeMounts = append(
+			container.VolumeMounts,
+			coreV1.VolumeMount{
+				Name:      "shm",
+				MountPath: "/dev/shm",
+			},
+		)
+	}
+
 	return &coreV1.Pod{
 		ObjectMeta: metaV1.ObjectMeta{
 			Name: podName,
@@ -77,16 +180,78 @@ func constructPod(
 				container,
 			},
 			RestartPolicy: coreV1.RestartPolicyNever,
-			Volumes: []coreV1.Volume{
-				{
-					Name: "opctl",
-					VolumeSource: coreV1.VolumeSource{
-						PersistentVolumeClaim: &coreV1.PersistentVolumeClaimVolumeSource{
-							ClaimName: "opctl",
-						},
-					},
-				},
-			},
+			Volumes:       volumes,
 		},
 	}, nil
 }
+
+// constructResourceRequirements constructs limits of a container.
+// note: k8s doesn't support limiting memory swap or pids per container so those are ignored.
+func constructResourceRequirements(
+	resources *model.ContainerCallResources,
+) coreV1.ResourceRequirements {
+	resourceRequirements := coreV1.ResourceRequirements{}
+	if resources == nil {
+		return resourceRequirements
+	}
+
+	limits := coreV1.ResourceList{}
+	if resources.Cpus != 0 {
+		limits[coreV1.ResourceCPU] = *resource.NewMilliQuantity(int64(resources.Cpus*1000), resource.DecimalSI)
+	}
+	if resources.Memory != 0 {
+		limits[coreV1.ResourceMemory] = *resource.NewQuantity(resources.Memory, resource.BinarySI)
+	}
+	if len(limits) > 0 {
+		resourceRequirements.Limits = limits
+	}
+
+	return resourceRequirements
+}
+
+func constructSecurityContext(
+	req *model.ContainerCall,
+	privilegedByDefault bool,
+) (*coreV1.SecurityContext, error) {
+	privileged := privilegedByDefault
+	if req.Privileged != nil {
+		privileged = *req.Privileged
+	}
+	readOnlyRootFs := req.ReadOnlyRootFs
+
+	securityContext := &coreV1.SecurityContext{
+		Privileged:             &privileged,
+		ReadOnlyRootFilesystem: &readOnlyRootFs,
+	}
+
+	if len(req.CapAdd) > 0 || len(req.CapDrop) > 0 {
+		securityContext.Capabilities = &coreV1.Capabilities{}
+		for _, capability := range req.CapAdd {
+			securityContext.Capabilities.Add = append(securityContext.Capabilities.Add, coreV1.Capability(capability))
+		}
+		for _, capability := range req.CapDrop {
+			securityContext.Capabilities.Drop = append(securityContext.Capabilities.Drop, coreV1.Capability(capability))
+		}
+	}
+
+	if req.User != "" {
+		// k8s only supports numeric users & groups
+		userParts := strings.SplitN(req.User, ":", 2)
+
+		runAsUser, err := strconv.ParseInt(userParts[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("unable to construct user: user must be numeric w/ k8s container runtime; got '%s'", userParts[0])
+		}
+		securityContext.RunAsUser = &runAsUser
+
+		if len(userParts) > 1 {
+			runAsGroup, err := strconv.ParseInt(userParts[1], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("unable to construct user: group must be numeric w/ k8s container runtime; got '%s'", userParts[1])
+			}
+			securityContext.RunAsGroup = &runAsGroup
+		}
+	}
+
+	return securityContext, nil
+}
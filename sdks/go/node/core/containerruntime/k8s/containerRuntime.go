@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"io/ioutil"
 
 	"github.com/opctl/opctl/sdks/go/model"
 	"github.com/opctl/opctl/sdks/go/node/core/containerruntime"
@@ -11,10 +12,15 @@ import (
 	coreV1 "k8s.io/api/core/v1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
 )
 
-func New() (
+// New returns a k8s container runtime; privilegedByDefault applies to containers which don't explicitly set privileged
+func New(
+	privilegedByDefault bool,
+) (
 	containerRuntime containerruntime.ContainerRuntime,
 	err error,
 ) {
@@ -30,12 +36,102 @@ func New() (
 	}
 
 	return _containerRuntime{
-		k8sClient: k8sClient,
+		k8sClient:           k8sClient,
+		k8sConfig:           k8sConfig,
+		privilegedByDefault: privilegedByDefault,
 	}, nil
 }
 
 type _containerRuntime struct {
-	k8sClient *kubernetes.Clientset
+	k8sClient           *kubernetes.Clientset
+	k8sConfig           *rest.Config
+	privilegedByDefault bool
+}
+
+func (cr _containerRuntime) AttachContainer(
+	ctx context.Context,
+	containerID string,
+	stdin io.Reader,
+	stdout io.Writer,
+	resizes <-chan model.TerminalSize,
+) error {
+	podName := constructPodName(containerID)
+
+	// the pod might still be pending; wait until it's running
+	watcher, err := cr.k8sClient.CoreV1().Pods("opctl").Watch(
+		ctx,
+		metaV1.ListOptions{
+			FieldSelector: fmt.Sprintf("metadata.name=%s", podName),
+		},
+	)
+	if err != nil {
+		return err
+	}
+	defer watcher.Stop()
+
+	isRunning := false
+	for event := range watcher.ResultChan() {
+		pod, ok := event.Object.(*coreV1.Pod)
+		if !ok {
+			continue
+		}
+
+		if pod.Status.Phase == coreV1.PodRunning {
+			isRunning = true
+			break
+		}
+		if pod.Status.Phase == coreV1.PodSucceeded || pod.Status.Phase == coreV1.PodFailed {
+			return fmt.Errorf("unable to attach container: pod %s", pod.Status.Phase)
+		}
+	}
+	if !isRunning {
+		return ctx.Err()
+	}
+
+	req := cr.k8sClient.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Name(podName).
+		Namespace("opctl").
+		SubResource("attach").
+		VersionedParams(
+			&coreV1.PodAttachOptions{
+				Stdin:  true,
+				Stdout: true,
+				TTY:    true,
+			},
+			scheme.ParameterCodec,
+		)
+
+	executor, err := remotecommand.NewSPDYExecutor(cr.k8sConfig, "POST", req.URL())
+	if err != nil {
+		return fmt.Errorf("unable to attach container: %w", err)
+	}
+
+	streamOptions := remotecommand.StreamOptions{
+		Stdin:  stdin,
+		Stdout: stdout,
+		Tty:    true,
+	}
+	if resizes != nil {
+		streamOptions.TerminalSizeQueue = terminalSizeQueue(resizes)
+	}
+
+	return executor.Stream(streamOptions)
+}
+
+// terminalSizeQueue adapts a channel of resizes to a remotecommand.TerminalSizeQueue
+type terminalSizeQueue <-chan model.TerminalSize
+
+func (q terminalSizeQueue) Next() *remotecommand.TerminalSize {
+	size, ok := <-q
+	if !ok {
+		return nil
+	}
+
+	return &remotecommand.TerminalSize{
+		Height: size.Height,
+		Width:  size.Width,
+	}
 }
 
 func (cr _containerRuntime) DeleteContainerIfExists(
@@ -53,18 +149,78 @@ func (cr _containerRuntime) DeleteContainerIfExists(
 	return nil
 }
 
+func (cr _containerRuntime) ProbeContainer(
+	ctx context.Context,
+	containerID string,
+	readiness *model.ContainerCallReadiness,
+) error {
+	pod, err := cr.k8sClient.CoreV1().Pods("opctl").Get(
+		ctx,
+		constructPodName(containerID),
+		metaV1.GetOptions{},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to get pod: %w", err)
+	}
+	if pod.Status.Phase != coreV1.PodRunning {
+		return fmt.Errorf("pod %s", pod.Status.Phase)
+	}
+
+	if len(readiness.Exec) == 0 {
+		return containerruntime.ProbeNetwork(ctx, pod.Status.PodIP, readiness)
+	}
+
+	req := cr.k8sClient.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Name(pod.ObjectMeta.Name).
+		Namespace("opctl").
+		SubResource("exec").
+		VersionedParams(
+			&coreV1.PodExecOptions{
+				Command: readiness.Exec,
+				Stdout:  true,
+				Stderr:  true,
+			},
+			scheme.ParameterCodec,
+		)
+
+	executor, err := remotecommand.NewSPDYExecutor(cr.k8sConfig, "POST", req.URL())
+	if err != nil {
+		return fmt.Errorf("unable to exec: %w", err)
+	}
+
+	// errs if the command exits non zero
+	return executor.Stream(
+		remotecommand.StreamOptions{
+			Stdout: ioutil.Discard,
+			Stderr: ioutil.Discard,
+		},
+	)
+}
+
+func (cr _containerRuntime) ResolveImageDigest(
+	ctx context.Context,
+	req *model.ContainerCall,
+	rootCallID string,
+	eventPublisher pubsub.EventPublisher,
+) (*string, error) {
+	// images are pulled by the kubelet of whichever k8s node runs the pod so can't be resolved here
+	return nil, nil
+}
+
 func (cr _containerRuntime) RunContainer(
 	ctx context.Context,
 	req *model.ContainerCall,
 	rootCallID string,
 	eventPublisher pubsub.EventPublisher,
+	stdin io.Reader,
 	stdout io.WriteCloser,
 	stderr io.WriteCloser,
 ) (*int64, error) {
 	defer stdout.Close()
 	defer stderr.Close()
 
-	pod, err := constructPod(req)
+	pod, err := constructPod(req, cr.privilegedByDefault)
 	if err != nil {
 		return nil, err
 	}
@@ -89,6 +245,7 @@ func (cr _containerRuntime) RunContainer(
 	}
 	defer watcher.Stop()
 
+	isStdinAttached := false
 	for event := range watcher.ResultChan() {
 		var ok bool
 		pod, ok = event.Object.(*coreV1.Pod)
@@ -98,6 +255,11 @@ func (cr _containerRuntime) RunContainer(
 
 		switch pod.Status.Phase {
 		case coreV1.PodRunning:
+			if stdin != nil && !isStdinAttached {
+				isStdinAttached = true
+				go cr.attachStdin(ctx, pod.ObjectMeta.Name, stdin)
+			}
+
 			// https://stackoverflow.com/questions/53852530/how-to-get-logs-from-kubernetes-using-golang
 			logsResult := cr.k8sClient.CoreV1().Pods("opctl").GetLogs(
 				pod.ObjectMeta.Name,
@@ -139,6 +301,15 @@ func (cr _containerRuntime) RunContainer(
 			}
 
 			exitCode := int64(pod.Status.ContainerStatuses[0].State.Terminated.ExitCode)
+			switch pod.Status.ContainerStatuses[0].State.Terminated.Reason {
+			case "OOMKilled":
+				return &exitCode, containerruntime.ErrOOMKilled{}
+			case "Error":
+				if exitCode != 0 {
+					// the container exited w/ a nonzero exit code; that's reported via exitCode
+					return &exitCode, nil
+				}
+			}
 			return &exitCode, fmt.Errorf(
 				"%s, %s",
 				pod.Status.ContainerStatuses[0].State.Terminated.Reason,
@@ -149,3 +320,33 @@ func (cr _containerRuntime) RunContainer(
 
 	return nil, err
 }
+
+// attachStdin attaches to a running pod & streams stdin to it until EOF
+func (cr _containerRuntime) attachStdin(
+	ctx context.Context,
+	podName string,
+	stdin io.Reader,
+) error {
+	req := cr.k8sClient.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Name(podName).
+		Namespace("opctl").
+		SubResource("attach").
+		VersionedParams(
+			&coreV1.PodAttachOptions{
+				Stdin: true,
+			},
+			scheme.ParameterCodec,
+		)
+
+	executor, err := remotecommand.NewSPDYExecutor(cr.k8sConfig, "POST", req.URL())
+	if err != nil {
+		return fmt.Errorf("unable to attach stdin: %w", err)
+	}
+
+	return executor.Stream(
+		remotecommand.StreamOptions{
+			Stdin: stdin,
+		},
+	)
+}
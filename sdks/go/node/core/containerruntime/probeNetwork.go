@@ -0,0 +1,63 @@
+package containerruntime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+// ProbeNetwork runs the tcpPort or httpGet readiness probe against a container reachable at host.
+// Runtimes can use it to probe containers once they've resolved their address.
+func ProbeNetwork(
+	ctx context.Context,
+	host string,
+	readiness *model.ContainerCallReadiness,
+) error {
+	if host == "" {
+		return errors.New("unable to probe container: no address")
+	}
+
+	switch {
+	case readiness.TCPPort != nil:
+		conn, err := new(net.Dialer).DialContext(
+			ctx,
+			"tcp",
+			net.JoinHostPort(host, strconv.Itoa(*readiness.TCPPort)),
+		)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	case readiness.HTTPGet != nil:
+		httpReq, err := http.NewRequestWithContext(
+			ctx,
+			http.MethodGet,
+			fmt.Sprintf(
+				"http://%s%s",
+				net.JoinHostPort(host, strconv.Itoa(readiness.HTTPGet.Port)),
+				readiness.HTTPGet.Path,
+			),
+			nil,
+		)
+		if err != nil {
+			return err
+		}
+
+		httpResp, err := http.DefaultClient.Do(httpReq)
+		if err != nil {
+			return err
+		}
+		defer httpResp.Body.Close()
+
+		if httpResp.StatusCode < 200 || httpResp.StatusCode >= 400 {
+			return fmt.Errorf("unexpected status %d", httpResp.StatusCode)
+		}
+	}
+
+	return nil
+}
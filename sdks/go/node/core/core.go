@@ -18,11 +18,16 @@ import (
 	"github.com/opctl/opctl/sdks/go/pubsub"
 )
 
+// eventCompactionInterval is how often events are pruned per the event retention policy
+const eventCompactionInterval = time.Hour
+
 // New returns a new LocalCore initialized with the given options
 func New(
 	ctx context.Context,
 	containerRuntime containerruntime.ContainerRuntime,
 	dataDirPath string,
+	eventRetentionPolicy pubsub.RetentionPolicy,
+	outputConflictPolicy OutputConflictPolicy,
 ) Core {
 	eventDbPath := path.Join(dataDirPath, "dcg", "events")
 	err := os.MkdirAll(eventDbPath, 0700)
@@ -42,7 +47,10 @@ func New(
 		panic(err)
 	}
 
-	pubSub := pubsub.New(db)
+	pubSub := newSecretRedactingPubSub(
+		ctx,
+		pubsub.New(db),
+	)
 
 	stateStore := newStateStore(
 		ctx,
@@ -50,14 +58,19 @@ func New(
 		pubSub,
 	)
 
+	containerCache := newContainerCache(dataDirPath)
+
 	caller := newCaller(
 		newContainerCaller(
+			containerCache,
 			containerRuntime,
+			dataDirPath,
 			pubSub,
 			stateStore,
 		),
 		dataDirPath,
 		pubSub,
+		outputConflictPolicy,
 	)
 
 	go func() {
@@ -84,15 +97,37 @@ func New(
 					ctx,
 					req.OpID,
 					req.RootCallID,
+					event.Timestamp,
 				)
 			}
 		}
 	}()
 
+	if !eventRetentionPolicy.IsEmpty() {
+		go func() {
+			// compact events in background
+			ticker := time.NewTicker(eventCompactionInterval)
+			defer ticker.Stop()
+
+			for {
+				// @TODO: handle err
+				pruneEvents(pubSub, dataDirPath, eventRetentionPolicy)
+
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+				}
+			}
+		}()
+	}
+
 	return core{
 		caller:           caller,
+		containerCache:   containerCache,
 		containerRuntime: containerRuntime,
 		dataCachePath:    filepath.Join(dataDirPath, "ops"),
+		dataDirPath:      dataDirPath,
 		opCaller: newOpCaller(
 			caller,
 			dataDirPath,
@@ -105,8 +140,10 @@ func New(
 // core is an Node that supports running ops directly on the host
 type core struct {
 	caller           caller
+	containerCache   containerCache
 	containerRuntime containerruntime.ContainerRuntime
 	dataCachePath    string
+	dataDirPath      string
 	opCaller         opCaller
 	pubSub           pubsub.PubSub
 	stateStore       stateStore
@@ -7,6 +7,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/opctl/opctl/sdks/go/node/core/containerruntime/fakes"
+	"github.com/opctl/opctl/sdks/go/pubsub"
 )
 
 var _ = Context("core", func() {
@@ -24,6 +25,8 @@ var _ = Context("core", func() {
 					context.Background(),
 					new(FakeContainerRuntime),
 					dataDir,
+					pubsub.RetentionPolicy{},
+					OutputConflictPolicyFail,
 				),
 			).To(Not(BeNil()))
 		})
@@ -0,0 +1,28 @@
+package core
+
+import (
+	"context"
+	"time"
+)
+
+// detachedContext carries the values of its parent but is never cancelled w/ it; used to run finally calls
+// of calls which were killed or timed out
+type detachedContext struct {
+	parent context.Context
+}
+
+func (dc detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (dc detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (dc detachedContext) Err() error {
+	return nil
+}
+
+func (dc detachedContext) Value(key interface{}) interface{} {
+	return dc.parent.Value(key)
+}
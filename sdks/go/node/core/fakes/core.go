@@ -22,6 +22,18 @@ type FakeCore struct {
 	addAuthReturnsOnCall map[int]struct {
 		result1 error
 	}
+	AttachContainerStub        func(context.Context, model.AttachContainerReq) error
+	attachContainerMutex       sync.RWMutex
+	attachContainerArgsForCall []struct {
+		arg1 context.Context
+		arg2 model.AttachContainerReq
+	}
+	attachContainerReturns struct {
+		result1 error
+	}
+	attachContainerReturnsOnCall map[int]struct {
+		result1 error
+	}
 	GetDataStub        func(context.Context, model.GetDataReq) (model.ReadSeekCloser, error)
 	getDataMutex       sync.RWMutex
 	getDataArgsForCall []struct {
@@ -50,6 +62,20 @@ type FakeCore struct {
 		result1 <-chan model.Event
 		result2 error
 	}
+	GetOpStub        func(context.Context, string) (*model.OpSummary, error)
+	getOpMutex       sync.RWMutex
+	getOpArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getOpReturns struct {
+		result1 *model.OpSummary
+		result2 error
+	}
+	getOpReturnsOnCall map[int]struct {
+		result1 *model.OpSummary
+		result2 error
+	}
 	KillOpStub        func(context.Context, model.KillOpReq) error
 	killOpMutex       sync.RWMutex
 	killOpArgsForCall []struct {
@@ -62,6 +88,19 @@ type FakeCore struct {
 	killOpReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ListCacheEntriesStub        func(context.Context) ([]*model.CacheEntry, error)
+	listCacheEntriesMutex       sync.RWMutex
+	listCacheEntriesArgsForCall []struct {
+		arg1 context.Context
+	}
+	listCacheEntriesReturns struct {
+		result1 []*model.CacheEntry
+		result2 error
+	}
+	listCacheEntriesReturnsOnCall map[int]struct {
+		result1 []*model.CacheEntry
+		result2 error
+	}
 	ListDescendantsStub        func(context.Context, model.ListDescendantsReq) ([]*model.DirEntry, error)
 	listDescendantsMutex       sync.RWMutex
 	listDescendantsArgsForCall []struct {
@@ -76,6 +115,19 @@ type FakeCore struct {
 		result1 []*model.DirEntry
 		result2 error
 	}
+	ListOpsStub        func(context.Context) ([]*model.OpSummary, error)
+	listOpsMutex       sync.RWMutex
+	listOpsArgsForCall []struct {
+		arg1 context.Context
+	}
+	listOpsReturns struct {
+		result1 []*model.OpSummary
+		result2 error
+	}
+	listOpsReturnsOnCall map[int]struct {
+		result1 []*model.OpSummary
+		result2 error
+	}
 	LivenessStub        func(context.Context) error
 	livenessMutex       sync.RWMutex
 	livenessArgsForCall []struct {
@@ -87,6 +139,34 @@ type FakeCore struct {
 	livenessReturnsOnCall map[int]struct {
 		result1 error
 	}
+	PruneCacheStub        func(context.Context, model.PruneCacheReq) ([]*model.CacheEntry, error)
+	pruneCacheMutex       sync.RWMutex
+	pruneCacheArgsForCall []struct {
+		arg1 context.Context
+		arg2 model.PruneCacheReq
+	}
+	pruneCacheReturns struct {
+		result1 []*model.CacheEntry
+		result2 error
+	}
+	pruneCacheReturnsOnCall map[int]struct {
+		result1 []*model.CacheEntry
+		result2 error
+	}
+	PruneEventsStub        func(context.Context, model.PruneEventsReq) ([]string, error)
+	pruneEventsMutex       sync.RWMutex
+	pruneEventsArgsForCall []struct {
+		arg1 context.Context
+		arg2 model.PruneEventsReq
+	}
+	pruneEventsReturns struct {
+		result1 []string
+		result2 error
+	}
+	pruneEventsReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
 	ResolveDataStub        func(context.Context, string, *model.Creds) (model.DataHandle, error)
 	resolveDataMutex       sync.RWMutex
 	resolveDataArgsForCall []struct {
@@ -181,6 +261,67 @@ func (fake *FakeCore) AddAuthReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeCore) AttachContainer(arg1 context.Context, arg2 model.AttachContainerReq) error {
+	fake.attachContainerMutex.Lock()
+	ret, specificReturn := fake.attachContainerReturnsOnCall[len(fake.attachContainerArgsForCall)]
+	fake.attachContainerArgsForCall = append(fake.attachContainerArgsForCall, struct {
+		arg1 context.Context
+		arg2 model.AttachContainerReq
+	}{arg1, arg2})
+	fake.recordInvocation("AttachContainer", []interface{}{arg1, arg2})
+	fake.attachContainerMutex.Unlock()
+	if fake.AttachContainerStub != nil {
+		return fake.AttachContainerStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.attachContainerReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCore) AttachContainerCallCount() int {
+	fake.attachContainerMutex.RLock()
+	defer fake.attachContainerMutex.RUnlock()
+	return len(fake.attachContainerArgsForCall)
+}
+
+func (fake *FakeCore) AttachContainerCalls(stub func(context.Context, model.AttachContainerReq) error) {
+	fake.attachContainerMutex.Lock()
+	defer fake.attachContainerMutex.Unlock()
+	fake.AttachContainerStub = stub
+}
+
+func (fake *FakeCore) AttachContainerArgsForCall(i int) (context.Context, model.AttachContainerReq) {
+	fake.attachContainerMutex.RLock()
+	defer fake.attachContainerMutex.RUnlock()
+	argsForCall := fake.attachContainerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCore) AttachContainerReturns(result1 error) {
+	fake.attachContainerMutex.Lock()
+	defer fake.attachContainerMutex.Unlock()
+	fake.AttachContainerStub = nil
+	fake.attachContainerReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCore) AttachContainerReturnsOnCall(i int, result1 error) {
+	fake.attachContainerMutex.Lock()
+	defer fake.attachContainerMutex.Unlock()
+	fake.AttachContainerStub = nil
+	if fake.attachContainerReturnsOnCall == nil {
+		fake.attachContainerReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.attachContainerReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeCore) GetData(arg1 context.Context, arg2 model.GetDataReq) (model.ReadSeekCloser, error) {
 	fake.getDataMutex.Lock()
 	ret, specificReturn := fake.getDataReturnsOnCall[len(fake.getDataArgsForCall)]
@@ -309,6 +450,70 @@ func (fake *FakeCore) GetEventStreamReturnsOnCall(i int, result1 <-chan model.Ev
 	}{result1, result2}
 }
 
+func (fake *FakeCore) GetOp(arg1 context.Context, arg2 string) (*model.OpSummary, error) {
+	fake.getOpMutex.Lock()
+	ret, specificReturn := fake.getOpReturnsOnCall[len(fake.getOpArgsForCall)]
+	fake.getOpArgsForCall = append(fake.getOpArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetOp", []interface{}{arg1, arg2})
+	fake.getOpMutex.Unlock()
+	if fake.GetOpStub != nil {
+		return fake.GetOpStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getOpReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCore) GetOpCallCount() int {
+	fake.getOpMutex.RLock()
+	defer fake.getOpMutex.RUnlock()
+	return len(fake.getOpArgsForCall)
+}
+
+func (fake *FakeCore) GetOpCalls(stub func(context.Context, string) (*model.OpSummary, error)) {
+	fake.getOpMutex.Lock()
+	defer fake.getOpMutex.Unlock()
+	fake.GetOpStub = stub
+}
+
+func (fake *FakeCore) GetOpArgsForCall(i int) (context.Context, string) {
+	fake.getOpMutex.RLock()
+	defer fake.getOpMutex.RUnlock()
+	argsForCall := fake.getOpArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCore) GetOpReturns(result1 *model.OpSummary, result2 error) {
+	fake.getOpMutex.Lock()
+	defer fake.getOpMutex.Unlock()
+	fake.GetOpStub = nil
+	fake.getOpReturns = struct {
+		result1 *model.OpSummary
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCore) GetOpReturnsOnCall(i int, result1 *model.OpSummary, result2 error) {
+	fake.getOpMutex.Lock()
+	defer fake.getOpMutex.Unlock()
+	fake.GetOpStub = nil
+	if fake.getOpReturnsOnCall == nil {
+		fake.getOpReturnsOnCall = make(map[int]struct {
+			result1 *model.OpSummary
+			result2 error
+		})
+	}
+	fake.getOpReturnsOnCall[i] = struct {
+		result1 *model.OpSummary
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCore) KillOp(arg1 context.Context, arg2 model.KillOpReq) error {
 	fake.killOpMutex.Lock()
 	ret, specificReturn := fake.killOpReturnsOnCall[len(fake.killOpArgsForCall)]
@@ -370,6 +575,69 @@ func (fake *FakeCore) KillOpReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeCore) ListCacheEntries(arg1 context.Context) ([]*model.CacheEntry, error) {
+	fake.listCacheEntriesMutex.Lock()
+	ret, specificReturn := fake.listCacheEntriesReturnsOnCall[len(fake.listCacheEntriesArgsForCall)]
+	fake.listCacheEntriesArgsForCall = append(fake.listCacheEntriesArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	fake.recordInvocation("ListCacheEntries", []interface{}{arg1})
+	fake.listCacheEntriesMutex.Unlock()
+	if fake.ListCacheEntriesStub != nil {
+		return fake.ListCacheEntriesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.listCacheEntriesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCore) ListCacheEntriesCallCount() int {
+	fake.listCacheEntriesMutex.RLock()
+	defer fake.listCacheEntriesMutex.RUnlock()
+	return len(fake.listCacheEntriesArgsForCall)
+}
+
+func (fake *FakeCore) ListCacheEntriesCalls(stub func(context.Context) ([]*model.CacheEntry, error)) {
+	fake.listCacheEntriesMutex.Lock()
+	defer fake.listCacheEntriesMutex.Unlock()
+	fake.ListCacheEntriesStub = stub
+}
+
+func (fake *FakeCore) ListCacheEntriesArgsForCall(i int) context.Context {
+	fake.listCacheEntriesMutex.RLock()
+	defer fake.listCacheEntriesMutex.RUnlock()
+	argsForCall := fake.listCacheEntriesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCore) ListCacheEntriesReturns(result1 []*model.CacheEntry, result2 error) {
+	fake.listCacheEntriesMutex.Lock()
+	defer fake.listCacheEntriesMutex.Unlock()
+	fake.ListCacheEntriesStub = nil
+	fake.listCacheEntriesReturns = struct {
+		result1 []*model.CacheEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCore) ListCacheEntriesReturnsOnCall(i int, result1 []*model.CacheEntry, result2 error) {
+	fake.listCacheEntriesMutex.Lock()
+	defer fake.listCacheEntriesMutex.Unlock()
+	fake.ListCacheEntriesStub = nil
+	if fake.listCacheEntriesReturnsOnCall == nil {
+		fake.listCacheEntriesReturnsOnCall = make(map[int]struct {
+			result1 []*model.CacheEntry
+			result2 error
+		})
+	}
+	fake.listCacheEntriesReturnsOnCall[i] = struct {
+		result1 []*model.CacheEntry
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCore) ListDescendants(arg1 context.Context, arg2 model.ListDescendantsReq) ([]*model.DirEntry, error) {
 	fake.listDescendantsMutex.Lock()
 	ret, specificReturn := fake.listDescendantsReturnsOnCall[len(fake.listDescendantsArgsForCall)]
@@ -434,6 +702,69 @@ func (fake *FakeCore) ListDescendantsReturnsOnCall(i int, result1 []*model.DirEn
 	}{result1, result2}
 }
 
+func (fake *FakeCore) ListOps(arg1 context.Context) ([]*model.OpSummary, error) {
+	fake.listOpsMutex.Lock()
+	ret, specificReturn := fake.listOpsReturnsOnCall[len(fake.listOpsArgsForCall)]
+	fake.listOpsArgsForCall = append(fake.listOpsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	fake.recordInvocation("ListOps", []interface{}{arg1})
+	fake.listOpsMutex.Unlock()
+	if fake.ListOpsStub != nil {
+		return fake.ListOpsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.listOpsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCore) ListOpsCallCount() int {
+	fake.listOpsMutex.RLock()
+	defer fake.listOpsMutex.RUnlock()
+	return len(fake.listOpsArgsForCall)
+}
+
+func (fake *FakeCore) ListOpsCalls(stub func(context.Context) ([]*model.OpSummary, error)) {
+	fake.listOpsMutex.Lock()
+	defer fake.listOpsMutex.Unlock()
+	fake.ListOpsStub = stub
+}
+
+func (fake *FakeCore) ListOpsArgsForCall(i int) context.Context {
+	fake.listOpsMutex.RLock()
+	defer fake.listOpsMutex.RUnlock()
+	argsForCall := fake.listOpsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCore) ListOpsReturns(result1 []*model.OpSummary, result2 error) {
+	fake.listOpsMutex.Lock()
+	defer fake.listOpsMutex.Unlock()
+	fake.ListOpsStub = nil
+	fake.listOpsReturns = struct {
+		result1 []*model.OpSummary
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCore) ListOpsReturnsOnCall(i int, result1 []*model.OpSummary, result2 error) {
+	fake.listOpsMutex.Lock()
+	defer fake.listOpsMutex.Unlock()
+	fake.ListOpsStub = nil
+	if fake.listOpsReturnsOnCall == nil {
+		fake.listOpsReturnsOnCall = make(map[int]struct {
+			result1 []*model.OpSummary
+			result2 error
+		})
+	}
+	fake.listOpsReturnsOnCall[i] = struct {
+		result1 []*model.OpSummary
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCore) Liveness(arg1 context.Context) error {
 	fake.livenessMutex.Lock()
 	ret, specificReturn := fake.livenessReturnsOnCall[len(fake.livenessArgsForCall)]
@@ -494,6 +825,134 @@ func (fake *FakeCore) LivenessReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeCore) PruneCache(arg1 context.Context, arg2 model.PruneCacheReq) ([]*model.CacheEntry, error) {
+	fake.pruneCacheMutex.Lock()
+	ret, specificReturn := fake.pruneCacheReturnsOnCall[len(fake.pruneCacheArgsForCall)]
+	fake.pruneCacheArgsForCall = append(fake.pruneCacheArgsForCall, struct {
+		arg1 context.Context
+		arg2 model.PruneCacheReq
+	}{arg1, arg2})
+	fake.recordInvocation("PruneCache", []interface{}{arg1, arg2})
+	fake.pruneCacheMutex.Unlock()
+	if fake.PruneCacheStub != nil {
+		return fake.PruneCacheStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.pruneCacheReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCore) PruneCacheCallCount() int {
+	fake.pruneCacheMutex.RLock()
+	defer fake.pruneCacheMutex.RUnlock()
+	return len(fake.pruneCacheArgsForCall)
+}
+
+func (fake *FakeCore) PruneCacheCalls(stub func(context.Context, model.PruneCacheReq) ([]*model.CacheEntry, error)) {
+	fake.pruneCacheMutex.Lock()
+	defer fake.pruneCacheMutex.Unlock()
+	fake.PruneCacheStub = stub
+}
+
+func (fake *FakeCore) PruneCacheArgsForCall(i int) (context.Context, model.PruneCacheReq) {
+	fake.pruneCacheMutex.RLock()
+	defer fake.pruneCacheMutex.RUnlock()
+	argsForCall := fake.pruneCacheArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCore) PruneCacheReturns(result1 []*model.CacheEntry, result2 error) {
+	fake.pruneCacheMutex.Lock()
+	defer fake.pruneCacheMutex.Unlock()
+	fake.PruneCacheStub = nil
+	fake.pruneCacheReturns = struct {
+		result1 []*model.CacheEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCore) PruneCacheReturnsOnCall(i int, result1 []*model.CacheEntry, result2 error) {
+	fake.pruneCacheMutex.Lock()
+	defer fake.pruneCacheMutex.Unlock()
+	fake.PruneCacheStub = nil
+	if fake.pruneCacheReturnsOnCall == nil {
+		fake.pruneCacheReturnsOnCall = make(map[int]struct {
+			result1 []*model.CacheEntry
+			result2 error
+		})
+	}
+	fake.pruneCacheReturnsOnCall[i] = struct {
+		result1 []*model.CacheEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCore) PruneEvents(arg1 context.Context, arg2 model.PruneEventsReq) ([]string, error) {
+	fake.pruneEventsMutex.Lock()
+	ret, specificReturn := fake.pruneEventsReturnsOnCall[len(fake.pruneEventsArgsForCall)]
+	fake.pruneEventsArgsForCall = append(fake.pruneEventsArgsForCall, struct {
+		arg1 context.Context
+		arg2 model.PruneEventsReq
+	}{arg1, arg2})
+	fake.recordInvocation("PruneEvents", []interface{}{arg1, arg2})
+	fake.pruneEventsMutex.Unlock()
+	if fake.PruneEventsStub != nil {
+		return fake.PruneEventsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.pruneEventsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCore) PruneEventsCallCount() int {
+	fake.pruneEventsMutex.RLock()
+	defer fake.pruneEventsMutex.RUnlock()
+	return len(fake.pruneEventsArgsForCall)
+}
+
+func (fake *FakeCore) PruneEventsCalls(stub func(context.Context, model.PruneEventsReq) ([]string, error)) {
+	fake.pruneEventsMutex.Lock()
+	defer fake.pruneEventsMutex.Unlock()
+	fake.PruneEventsStub = stub
+}
+
+func (fake *FakeCore) PruneEventsArgsForCall(i int) (context.Context, model.PruneEventsReq) {
+	fake.pruneEventsMutex.RLock()
+	defer fake.pruneEventsMutex.RUnlock()
+	argsForCall := fake.pruneEventsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCore) PruneEventsReturns(result1 []string, result2 error) {
+	fake.pruneEventsMutex.Lock()
+	defer fake.pruneEventsMutex.Unlock()
+	fake.PruneEventsStub = nil
+	fake.pruneEventsReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCore) PruneEventsReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.pruneEventsMutex.Lock()
+	defer fake.pruneEventsMutex.Unlock()
+	fake.PruneEventsStub = nil
+	if fake.pruneEventsReturnsOnCall == nil {
+		fake.pruneEventsReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.pruneEventsReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCore) ResolveData(arg1 context.Context, arg2 string, arg3 *model.Creds) (model.DataHandle, error) {
 	fake.resolveDataMutex.Lock()
 	ret, specificReturn := fake.resolveDataReturnsOnCall[len(fake.resolveDataArgsForCall)]
@@ -628,16 +1087,28 @@ func (fake *FakeCore) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.addAuthMutex.RLock()
 	defer fake.addAuthMutex.RUnlock()
+	fake.attachContainerMutex.RLock()
+	defer fake.attachContainerMutex.RUnlock()
 	fake.getDataMutex.RLock()
 	defer fake.getDataMutex.RUnlock()
 	fake.getEventStreamMutex.RLock()
 	defer fake.getEventStreamMutex.RUnlock()
+	fake.getOpMutex.RLock()
+	defer fake.getOpMutex.RUnlock()
 	fake.killOpMutex.RLock()
 	defer fake.killOpMutex.RUnlock()
+	fake.listCacheEntriesMutex.RLock()
+	defer fake.listCacheEntriesMutex.RUnlock()
 	fake.listDescendantsMutex.RLock()
 	defer fake.listDescendantsMutex.RUnlock()
+	fake.listOpsMutex.RLock()
+	defer fake.listOpsMutex.RUnlock()
 	fake.livenessMutex.RLock()
 	defer fake.livenessMutex.RUnlock()
+	fake.pruneCacheMutex.RLock()
+	defer fake.pruneCacheMutex.RUnlock()
+	fake.pruneEventsMutex.RLock()
+	defer fake.pruneEventsMutex.RUnlock()
 	fake.resolveDataMutex.RLock()
 	defer fake.resolveDataMutex.RUnlock()
 	fake.startOpMutex.RLock()
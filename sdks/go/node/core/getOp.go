@@ -0,0 +1,17 @@
+package core
+
+import (
+	"context"
+
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+func (this core) GetOp(
+	ctx context.Context,
+	id string,
+) (
+	*model.OpSummary,
+	error,
+) {
+	return this.stateStore.GetOp(id)
+}
@@ -4,31 +4,34 @@ package fakes
 import (
 	"context"
 	"sync"
+	"time"
 )
 
 type FakeCallKiller struct {
-	KillStub        func(context.Context, string, string)
+	KillStub        func(context.Context, string, string, time.Time)
 	killMutex       sync.RWMutex
 	killArgsForCall []struct {
 		arg1 context.Context
 		arg2 string
 		arg3 string
+		arg4 time.Time
 	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeCallKiller) Kill(arg1 context.Context, arg2 string, arg3 string) {
+func (fake *FakeCallKiller) Kill(arg1 context.Context, arg2 string, arg3 string, arg4 time.Time) {
 	fake.killMutex.Lock()
 	fake.killArgsForCall = append(fake.killArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
 		arg3 string
-	}{arg1, arg2, arg3})
-	fake.recordInvocation("Kill", []interface{}{arg1, arg2, arg3})
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("Kill", []interface{}{arg1, arg2, arg3, arg4})
 	fake.killMutex.Unlock()
 	if fake.KillStub != nil {
-		fake.KillStub(arg1, arg2, arg3)
+		fake.KillStub(arg1, arg2, arg3, arg4)
 	}
 }
 
@@ -38,17 +41,17 @@ func (fake *FakeCallKiller) KillCallCount() int {
 	return len(fake.killArgsForCall)
 }
 
-func (fake *FakeCallKiller) KillCalls(stub func(context.Context, string, string)) {
+func (fake *FakeCallKiller) KillCalls(stub func(context.Context, string, string, time.Time)) {
 	fake.killMutex.Lock()
 	defer fake.killMutex.Unlock()
 	fake.KillStub = stub
 }
 
-func (fake *FakeCallKiller) KillArgsForCall(i int) (context.Context, string, string) {
+func (fake *FakeCallKiller) KillArgsForCall(i int) (context.Context, string, string, time.Time) {
 	fake.killMutex.RLock()
 	defer fake.killMutex.RUnlock()
 	argsForCall := fake.killArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
 func (fake *FakeCallKiller) Invocations() map[string][][]interface{} {
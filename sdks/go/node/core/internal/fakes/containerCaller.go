@@ -9,7 +9,7 @@ import (
 )
 
 type FakeContainerCaller struct {
-	CallStub        func(context.Context, *model.ContainerCall, map[string]*model.Value, *model.ContainerCallSpec, string) (map[string]*model.Value, error)
+	CallStub        func(context.Context, *model.ContainerCall, map[string]*model.Value, *model.ContainerCallSpec, string) (map[string]*model.Value, bool, error)
 	callMutex       sync.RWMutex
 	callArgsForCall []struct {
 		arg1 context.Context
@@ -20,17 +20,19 @@ type FakeContainerCaller struct {
 	}
 	callReturns struct {
 		result1 map[string]*model.Value
-		result2 error
+		result2 bool
+		result3 error
 	}
 	callReturnsOnCall map[int]struct {
 		result1 map[string]*model.Value
-		result2 error
+		result2 bool
+		result3 error
 	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeContainerCaller) Call(arg1 context.Context, arg2 *model.ContainerCall, arg3 map[string]*model.Value, arg4 *model.ContainerCallSpec, arg5 string) (map[string]*model.Value, error) {
+func (fake *FakeContainerCaller) Call(arg1 context.Context, arg2 *model.ContainerCall, arg3 map[string]*model.Value, arg4 *model.ContainerCallSpec, arg5 string) (map[string]*model.Value, bool, error) {
 	fake.callMutex.Lock()
 	ret, specificReturn := fake.callReturnsOnCall[len(fake.callArgsForCall)]
 	fake.callArgsForCall = append(fake.callArgsForCall, struct {
@@ -46,10 +48,10 @@ func (fake *FakeContainerCaller) Call(arg1 context.Context, arg2 *model.Containe
 		return fake.CallStub(arg1, arg2, arg3, arg4, arg5)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1, ret.result2, ret.result3
 	}
 	fakeReturns := fake.callReturns
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
 func (fake *FakeContainerCaller) CallCallCount() int {
@@ -58,7 +60,7 @@ func (fake *FakeContainerCaller) CallCallCount() int {
 	return len(fake.callArgsForCall)
 }
 
-func (fake *FakeContainerCaller) CallCalls(stub func(context.Context, *model.ContainerCall, map[string]*model.Value, *model.ContainerCallSpec, string) (map[string]*model.Value, error)) {
+func (fake *FakeContainerCaller) CallCalls(stub func(context.Context, *model.ContainerCall, map[string]*model.Value, *model.ContainerCallSpec, string) (map[string]*model.Value, bool, error)) {
 	fake.callMutex.Lock()
 	defer fake.callMutex.Unlock()
 	fake.CallStub = stub
@@ -71,30 +73,33 @@ func (fake *FakeContainerCaller) CallArgsForCall(i int) (context.Context, *model
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
-func (fake *FakeContainerCaller) CallReturns(result1 map[string]*model.Value, result2 error) {
+func (fake *FakeContainerCaller) CallReturns(result1 map[string]*model.Value, result2 bool, result3 error) {
 	fake.callMutex.Lock()
 	defer fake.callMutex.Unlock()
 	fake.CallStub = nil
 	fake.callReturns = struct {
 		result1 map[string]*model.Value
-		result2 error
-	}{result1, result2}
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeContainerCaller) CallReturnsOnCall(i int, result1 map[string]*model.Value, result2 error) {
+func (fake *FakeContainerCaller) CallReturnsOnCall(i int, result1 map[string]*model.Value, result2 bool, result3 error) {
 	fake.callMutex.Lock()
 	defer fake.callMutex.Unlock()
 	fake.CallStub = nil
 	if fake.callReturnsOnCall == nil {
 		fake.callReturnsOnCall = make(map[int]struct {
 			result1 map[string]*model.Value
-			result2 error
+			result2 bool
+			result3 error
 		})
 	}
 	fake.callReturnsOnCall[i] = struct {
 		result1 map[string]*model.Value
-		result2 error
-	}{result1, result2}
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
 }
 
 func (fake *FakeContainerCaller) Invocations() map[string][][]interface{} {
@@ -9,7 +9,7 @@ import (
 )
 
 type FakeParallelCaller struct {
-	CallStub        func(context.Context, string, map[string]*model.Value, string, string, []*model.CallSpec) (map[string]*model.Value, error)
+	CallStub        func(context.Context, string, map[string]*model.Value, string, string, []*model.CallSpec, *int) (map[string]*model.Value, error)
 	callMutex       sync.RWMutex
 	callArgsForCall []struct {
 		arg1 context.Context
@@ -18,6 +18,7 @@ type FakeParallelCaller struct {
 		arg4 string
 		arg5 string
 		arg6 []*model.CallSpec
+		arg7 *int
 	}
 	callReturns struct {
 		result1 map[string]*model.Value
@@ -31,7 +32,7 @@ type FakeParallelCaller struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeParallelCaller) Call(arg1 context.Context, arg2 string, arg3 map[string]*model.Value, arg4 string, arg5 string, arg6 []*model.CallSpec) (map[string]*model.Value, error) {
+func (fake *FakeParallelCaller) Call(arg1 context.Context, arg2 string, arg3 map[string]*model.Value, arg4 string, arg5 string, arg6 []*model.CallSpec, arg7 *int) (map[string]*model.Value, error) {
 	var arg6Copy []*model.CallSpec
 	if arg6 != nil {
 		arg6Copy = make([]*model.CallSpec, len(arg6))
@@ -46,11 +47,12 @@ func (fake *FakeParallelCaller) Call(arg1 context.Context, arg2 string, arg3 map
 		arg4 string
 		arg5 string
 		arg6 []*model.CallSpec
-	}{arg1, arg2, arg3, arg4, arg5, arg6Copy})
-	fake.recordInvocation("Call", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6Copy})
+		arg7 *int
+	}{arg1, arg2, arg3, arg4, arg5, arg6Copy, arg7})
+	fake.recordInvocation("Call", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6Copy, arg7})
 	fake.callMutex.Unlock()
 	if fake.CallStub != nil {
-		return fake.CallStub(arg1, arg2, arg3, arg4, arg5, arg6)
+		return fake.CallStub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -65,17 +67,17 @@ func (fake *FakeParallelCaller) CallCallCount() int {
 	return len(fake.callArgsForCall)
 }
 
-func (fake *FakeParallelCaller) CallCalls(stub func(context.Context, string, map[string]*model.Value, string, string, []*model.CallSpec) (map[string]*model.Value, error)) {
+func (fake *FakeParallelCaller) CallCalls(stub func(context.Context, string, map[string]*model.Value, string, string, []*model.CallSpec, *int) (map[string]*model.Value, error)) {
 	fake.callMutex.Lock()
 	defer fake.callMutex.Unlock()
 	fake.CallStub = stub
 }
 
-func (fake *FakeParallelCaller) CallArgsForCall(i int) (context.Context, string, map[string]*model.Value, string, string, []*model.CallSpec) {
+func (fake *FakeParallelCaller) CallArgsForCall(i int) (context.Context, string, map[string]*model.Value, string, string, []*model.CallSpec, *int) {
 	fake.callMutex.RLock()
 	defer fake.callMutex.RUnlock()
 	argsForCall := fake.callArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
 }
 
 func (fake *FakeParallelCaller) CallReturns(result1 map[string]*model.Value, result2 error) {
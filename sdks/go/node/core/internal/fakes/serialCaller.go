@@ -9,7 +9,7 @@ import (
 )
 
 type FakeSerialCaller struct {
-	CallStub        func(context.Context, string, map[string]*model.Value, string, string, []*model.CallSpec) (map[string]*model.Value, error)
+	CallStub        func(context.Context, string, map[string]*model.Value, string, string, []*model.CallSpec, []*model.CallSpec) (map[string]*model.Value, error)
 	callMutex       sync.RWMutex
 	callArgsForCall []struct {
 		arg1 context.Context
@@ -18,6 +18,7 @@ type FakeSerialCaller struct {
 		arg4 string
 		arg5 string
 		arg6 []*model.CallSpec
+		arg7 []*model.CallSpec
 	}
 	callReturns struct {
 		result1 map[string]*model.Value
@@ -27,16 +28,40 @@ type FakeSerialCaller struct {
 		result1 map[string]*model.Value
 		result2 error
 	}
+	CallFinallyStub        func(context.Context, string, map[string]*model.Value, error, string, string, []*model.CallSpec) (map[string]*model.Value, error)
+	callFinallyMutex       sync.RWMutex
+	callFinallyArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 map[string]*model.Value
+		arg4 error
+		arg5 string
+		arg6 string
+		arg7 []*model.CallSpec
+	}
+	callFinallyReturns struct {
+		result1 map[string]*model.Value
+		result2 error
+	}
+	callFinallyReturnsOnCall map[int]struct {
+		result1 map[string]*model.Value
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeSerialCaller) Call(arg1 context.Context, arg2 string, arg3 map[string]*model.Value, arg4 string, arg5 string, arg6 []*model.CallSpec) (map[string]*model.Value, error) {
+func (fake *FakeSerialCaller) Call(arg1 context.Context, arg2 string, arg3 map[string]*model.Value, arg4 string, arg5 string, arg6 []*model.CallSpec, arg7 []*model.CallSpec) (map[string]*model.Value, error) {
 	var arg6Copy []*model.CallSpec
 	if arg6 != nil {
 		arg6Copy = make([]*model.CallSpec, len(arg6))
 		copy(arg6Copy, arg6)
 	}
+	var arg7Copy []*model.CallSpec
+	if arg7 != nil {
+		arg7Copy = make([]*model.CallSpec, len(arg7))
+		copy(arg7Copy, arg7)
+	}
 	fake.callMutex.Lock()
 	ret, specificReturn := fake.callReturnsOnCall[len(fake.callArgsForCall)]
 	fake.callArgsForCall = append(fake.callArgsForCall, struct {
@@ -46,11 +71,12 @@ func (fake *FakeSerialCaller) Call(arg1 context.Context, arg2 string, arg3 map[s
 		arg4 string
 		arg5 string
 		arg6 []*model.CallSpec
-	}{arg1, arg2, arg3, arg4, arg5, arg6Copy})
-	fake.recordInvocation("Call", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6Copy})
+		arg7 []*model.CallSpec
+	}{arg1, arg2, arg3, arg4, arg5, arg6Copy, arg7Copy})
+	fake.recordInvocation("Call", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6Copy, arg7Copy})
 	fake.callMutex.Unlock()
 	if fake.CallStub != nil {
-		return fake.CallStub(arg1, arg2, arg3, arg4, arg5, arg6)
+		return fake.CallStub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -65,17 +91,17 @@ func (fake *FakeSerialCaller) CallCallCount() int {
 	return len(fake.callArgsForCall)
 }
 
-func (fake *FakeSerialCaller) CallCalls(stub func(context.Context, string, map[string]*model.Value, string, string, []*model.CallSpec) (map[string]*model.Value, error)) {
+func (fake *FakeSerialCaller) CallCalls(stub func(context.Context, string, map[string]*model.Value, string, string, []*model.CallSpec, []*model.CallSpec) (map[string]*model.Value, error)) {
 	fake.callMutex.Lock()
 	defer fake.callMutex.Unlock()
 	fake.CallStub = stub
 }
 
-func (fake *FakeSerialCaller) CallArgsForCall(i int) (context.Context, string, map[string]*model.Value, string, string, []*model.CallSpec) {
+func (fake *FakeSerialCaller) CallArgsForCall(i int) (context.Context, string, map[string]*model.Value, string, string, []*model.CallSpec, []*model.CallSpec) {
 	fake.callMutex.RLock()
 	defer fake.callMutex.RUnlock()
 	argsForCall := fake.callArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
 }
 
 func (fake *FakeSerialCaller) CallReturns(result1 map[string]*model.Value, result2 error) {
@@ -104,11 +130,87 @@ func (fake *FakeSerialCaller) CallReturnsOnCall(i int, result1 map[string]*model
 	}{result1, result2}
 }
 
+func (fake *FakeSerialCaller) CallFinally(arg1 context.Context, arg2 string, arg3 map[string]*model.Value, arg4 error, arg5 string, arg6 string, arg7 []*model.CallSpec) (map[string]*model.Value, error) {
+	var arg7Copy []*model.CallSpec
+	if arg7 != nil {
+		arg7Copy = make([]*model.CallSpec, len(arg7))
+		copy(arg7Copy, arg7)
+	}
+	fake.callFinallyMutex.Lock()
+	ret, specificReturn := fake.callFinallyReturnsOnCall[len(fake.callFinallyArgsForCall)]
+	fake.callFinallyArgsForCall = append(fake.callFinallyArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 map[string]*model.Value
+		arg4 error
+		arg5 string
+		arg6 string
+		arg7 []*model.CallSpec
+	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7Copy})
+	fake.recordInvocation("CallFinally", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7Copy})
+	fake.callFinallyMutex.Unlock()
+	if fake.CallFinallyStub != nil {
+		return fake.CallFinallyStub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.callFinallyReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSerialCaller) CallFinallyCallCount() int {
+	fake.callFinallyMutex.RLock()
+	defer fake.callFinallyMutex.RUnlock()
+	return len(fake.callFinallyArgsForCall)
+}
+
+func (fake *FakeSerialCaller) CallFinallyCalls(stub func(context.Context, string, map[string]*model.Value, error, string, string, []*model.CallSpec) (map[string]*model.Value, error)) {
+	fake.callFinallyMutex.Lock()
+	defer fake.callFinallyMutex.Unlock()
+	fake.CallFinallyStub = stub
+}
+
+func (fake *FakeSerialCaller) CallFinallyArgsForCall(i int) (context.Context, string, map[string]*model.Value, error, string, string, []*model.CallSpec) {
+	fake.callFinallyMutex.RLock()
+	defer fake.callFinallyMutex.RUnlock()
+	argsForCall := fake.callFinallyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
+}
+
+func (fake *FakeSerialCaller) CallFinallyReturns(result1 map[string]*model.Value, result2 error) {
+	fake.callFinallyMutex.Lock()
+	defer fake.callFinallyMutex.Unlock()
+	fake.CallFinallyStub = nil
+	fake.callFinallyReturns = struct {
+		result1 map[string]*model.Value
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSerialCaller) CallFinallyReturnsOnCall(i int, result1 map[string]*model.Value, result2 error) {
+	fake.callFinallyMutex.Lock()
+	defer fake.callFinallyMutex.Unlock()
+	fake.CallFinallyStub = nil
+	if fake.callFinallyReturnsOnCall == nil {
+		fake.callFinallyReturnsOnCall = make(map[int]struct {
+			result1 map[string]*model.Value
+			result2 error
+		})
+	}
+	fake.callFinallyReturnsOnCall[i] = struct {
+		result1 map[string]*model.Value
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSerialCaller) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.callMutex.RLock()
 	defer fake.callMutex.RUnlock()
+	fake.callFinallyMutex.RLock()
+	defer fake.callFinallyMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,16 @@
+package core
+
+import (
+	"context"
+
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+func (this core) ListCacheEntries(
+	ctx context.Context,
+) (
+	[]*model.CacheEntry,
+	error,
+) {
+	return this.containerCache.List()
+}
@@ -0,0 +1,16 @@
+package core
+
+import (
+	"context"
+
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+func (this core) ListOps(
+	ctx context.Context,
+) (
+	[]*model.OpSummary,
+	error,
+) {
+	return this.stateStore.ListOps()
+}
@@ -0,0 +1,11 @@
+package core
+
+// OutputConflictPolicy sets how parallel calls handle more than one child producing the same output
+type OutputConflictPolicy string
+
+const (
+	// OutputConflictPolicyFail fails parallel calls w/ conflicting outputs
+	OutputConflictPolicyFail OutputConflictPolicy = "fail"
+	// OutputConflictPolicyWarn publishes a CallOutputsConflicted event; the output of the last child wins
+	OutputConflictPolicyWarn OutputConflictPolicy = "warn"
+)
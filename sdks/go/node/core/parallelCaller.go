@@ -4,12 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"runtime/debug"
+	"sync"
 	"time"
 
 	"github.com/opctl/opctl/sdks/go/internal/uniquestring"
 	"github.com/opctl/opctl/sdks/go/model"
 	"github.com/opctl/opctl/sdks/go/opspec"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/parallel"
 	"github.com/opctl/opctl/sdks/go/pubsub"
 )
 
@@ -23,6 +26,7 @@ type parallelCaller interface {
 		rootCallID string,
 		opPath string,
 		callSpecParallelCall []*model.CallSpec,
+		maxConcurrency *int,
 	) (
 		map[string]*model.Value,
 		error,
@@ -32,18 +36,21 @@ type parallelCaller interface {
 func newParallelCaller(
 	caller caller,
 	pubSub pubsub.PubSub,
+	outputConflictPolicy OutputConflictPolicy,
 ) parallelCaller {
 
 	return _parallelCaller{
-		caller: caller,
-		pubSub: pubSub,
+		caller:               caller,
+		outputConflictPolicy: outputConflictPolicy,
+		pubSub:               pubSub,
 	}
 
 }
 
 type _parallelCaller struct {
-	caller caller
-	pubSub pubsub.PubSub
+	caller               caller
+	outputConflictPolicy OutputConflictPolicy
+	pubSub               pubsub.PubSub
 }
 
 func (pc _parallelCaller) Call(
@@ -53,6 +60,7 @@ func (pc _parallelCaller) Call(
 	rootCallID string,
 	opPath string,
 	callSpecParallelCall []*model.CallSpec,
+	maxConcurrency *int,
 ) (
 	map[string]*model.Value,
 	error,
@@ -61,22 +69,41 @@ func (pc _parallelCaller) Call(
 	parallelCtx, cancelParallel := context.WithCancel(parentCtx)
 	defer cancelParallel()
 
+	childCallNames := map[string]bool{}
+	for _, callSpecChildCall := range callSpecParallelCall {
+		if callSpecChildCall.Name != nil {
+			childCallNames[*callSpecChildCall.Name] = true
+		}
+	}
+
 	childCallNeededCountByName := map[string]int{}
+	// closed once the named call is ready
+	childCallReadyChanByName := map[string]chan struct{}{}
 	for _, callSpecChildCall := range callSpecParallelCall {
 		// increment needed by counts for any needs
 		for _, neededCallRef := range callSpecChildCall.Needs {
 			childCallNeededCountByName[opspec.RefToName(neededCallRef)]++
 		}
+		for _, neededCallRef := range callSpecChildCall.NeedsReady {
+			neededCallName := opspec.RefToName(neededCallRef)
+			if !childCallNames[neededCallName] {
+				return nil, fmt.Errorf("unable to find call '%s' referenced by needsReady; must be the name of a sibling call", neededCallName)
+			}
+			childCallNeededCountByName[neededCallName]++
+			childCallReadyChanByName[neededCallName] = make(chan struct{})
+		}
 	}
 
 	startTime := time.Now().UTC()
 	childCallIndexByID := map[string]int{}
+	childCallIDByIndex := make([]string, len(callSpecParallelCall))
 	childCallIDByName := map[string]string{}
-	childCallOutputsByIndex := map[int]map[string]*model.Value{}
+	isChildCallEndedByIndex := map[int]bool{}
+	// use returned outputs rather than those of events; events have secrets redacted
+	childCallOutputsByIndex := make([]map[string]*model.Value, len(callSpecParallelCall))
+	var childCallWaitGroup sync.WaitGroup
 
-	// perform calls in parallel w/ cancellation
 	for childCallIndex, childCall := range callSpecParallelCall {
-
 		childCallID, err := uniquestring.Construct()
 		if err != nil {
 			// end run immediately on any error
@@ -84,12 +111,38 @@ func (pc _parallelCaller) Call(
 		}
 
 		childCallIndexByID[childCallID] = childCallIndex
+		childCallIDByIndex[childCallIndex] = childCallID
 
 		if childCall.Name != nil {
 			childCallIDByName[*childCall.Name] = childCallID
 		}
+	}
+
+	limiter := newConcurrencyLimiter(maxConcurrency, pc.pubSub)
+
+	// perform call in parallel w/ cancellation
+	startChildCall := func(childCallIndex int) {
+		childCall := callSpecParallelCall[childCallIndex]
+		queuedChildCall := model.Call{
+			ID:       childCallIDByIndex[childCallIndex],
+			Name:     childCall.Name,
+			ParentID: &callID,
+			RootID:   rootCallID,
+		}
+
+		// calls needed by siblings live as long as those siblings so would hold their slot while the siblings queue for one;
+		// they aren't counted against maxConcurrency
+		isNeeded := childCall.Name != nil && childCallNeededCountByName[*childCall.Name] != 0
+
+		isSlotAcquired := false
+		if !isNeeded && len(childCall.NeedsReady) == 0 {
+			// queue now so calls queue in order
+			isSlotAcquired = limiter.Queue(queuedChildCall, opPath)
+		}
 
-		go func(childCall *model.CallSpec) {
+		childCallWaitGroup.Add(1)
+		go func() {
+			defer childCallWaitGroup.Done()
 			defer func() {
 				if panicArg := recover(); panicArg != nil {
 					// recover from panics; treat as errors
@@ -100,17 +153,114 @@ func (pc _parallelCaller) Call(
 				}
 			}()
 
-			pc.caller.Call(
+			for _, neededCallRef := range childCall.NeedsReady {
+				select {
+				case <-childCallReadyChanByName[opspec.RefToName(neededCallRef)]:
+				case <-parallelCtx.Done():
+					// the parallel call ended while it awaited readiness so it will never start; skipped calls end like any other
+					pc.pubSub.Publish(
+						model.Event{
+							CallEnded: &model.CallEnded{
+								Call:    queuedChildCall,
+								Outcome: model.OpOutcomeSkipped,
+								Ref:     opPath,
+							},
+							Timestamp: time.Now().UTC(),
+						},
+					)
+					return
+				}
+			}
+
+			if !isNeeded {
+				if len(childCall.NeedsReady) != 0 {
+					// calls needing others to be ready don't hold a slot while they wait
+					isSlotAcquired = limiter.Queue(queuedChildCall, opPath)
+				}
+				if !isSlotAcquired && !limiter.Acquire(parallelCtx, queuedChildCall, opPath) {
+					return
+				}
+				defer limiter.Release()
+			}
+
+			childCallOutputsByIndex[childCallIndex], _ = pc.caller.Call(
 				parallelCtx,
-				childCallID,
+				childCallIDByIndex[childCallIndex],
 				inboundScope,
 				childCall,
 				opPath,
 				&callID,
 				rootCallID,
 			)
+		}()
+	}
+
+	// calls w/ after are pending until every call they're after has ended
+	pendingChildCallByIndex := map[int]model.Call{}
+	for childCallIndex, childCall := range callSpecParallelCall {
+		if len(childCall.After) == 0 {
+			startChildCall(childCallIndex)
+			continue
+		}
+
+		pendingChildCall := model.Call{
+			After:    childCall.After,
+			ID:       childCallIDByIndex[childCallIndex],
+			Name:     childCall.Name,
+			ParentID: &callID,
+			RootID:   rootCallID,
+		}
+		pendingChildCallByIndex[childCallIndex] = pendingChildCall
+
+		pc.pubSub.Publish(
+			model.Event{
+				CallPending: &model.CallPending{
+					Call: pendingChildCall,
+					Ref:  opPath,
+				},
+				Timestamp: time.Now().UTC(),
+			},
+		)
+	}
+
+	childCallOutcomeByName := map[string]string{}
+	startOrSkipPendingChildCalls := func() {
+		for childCallIndex := 0; childCallIndex < len(callSpecParallelCall); childCallIndex++ {
+			pendingChildCall, isPending := pendingChildCallByIndex[childCallIndex]
+			if !isPending {
+				continue
+			}
+
+			isAfterSucceeded := true
+			isAfterEnded := true
+			for _, afterRef := range pendingChildCall.After {
+				outcome, isEnded := childCallOutcomeByName[opspec.RefToName(afterRef)]
+				isAfterEnded = isAfterEnded && isEnded
+				isAfterSucceeded = isAfterSucceeded && outcome == model.OpOutcomeSucceeded
+			}
+			if !isAfterEnded {
+				continue
+			}
+
+			delete(pendingChildCallByIndex, childCallIndex)
 
-		}(childCall)
+			if isAfterSucceeded && parallelCtx.Err() == nil {
+				startChildCall(childCallIndex)
+				continue
+			}
+
+			// a call it's after didn't succeed (or the parallel call ended); skipped calls end like any other
+			pc.pubSub.Publish(
+				model.Event{
+					CallEnded: &model.CallEnded{
+						Call:    pendingChildCall,
+						Outcome: model.OpOutcomeSkipped,
+						Ref:     opPath,
+					},
+					Timestamp: time.Now().UTC(),
+				},
+			)
+		}
 	}
 
 	// subscribe to events
@@ -125,24 +275,71 @@ func (pc _parallelCaller) Call(
 	)
 
 	var isChildErred = false
+	var childErr error = errors.New("child call failed")
 	outputs := map[string]*model.Value{}
 
-eventLoop:
-	for event := range eventChannel {
-		if event.CallEnded != nil {
+	isChildCallReadyByName := map[string]bool{}
+	markChildCallReady := func(childCallIndex int) {
+		childCallName := callSpecParallelCall[childCallIndex].Name
+		if childCallName == nil || isChildCallReadyByName[*childCallName] {
+			return
+		}
+		if readyChan, ok := childCallReadyChanByName[*childCallName]; ok {
+			isChildCallReadyByName[*childCallName] = true
+			close(readyChan)
+		}
+	}
+
+	for {
+		event, ok := <-eventChannel
+		if !ok {
+			return outputs, nil
+		}
+
+		if event.CallReady != nil {
+			if childCallIndex, ok := childCallIndexByID[event.CallReady.CallID]; ok {
+				markChildCallReady(childCallIndex)
+			}
+		}
+
+		if event.CallStarted != nil {
+			if childCallIndex, ok := childCallIndexByID[event.CallStarted.Call.ID]; ok {
+				if event.CallStarted.Call.Container == nil || event.CallStarted.Call.Container.Readiness == nil {
+					// calls w/out readiness are ready once started
+					markChildCallReady(childCallIndex)
+				}
+			}
+		}
+
+		if event.CallEnded != nil && !event.CallEnded.WillRetry {
 			if childCallIndex, isChildCallEnded := childCallIndexByID[event.CallEnded.Call.ID]; isChildCallEnded {
-				childCallOutputsByIndex[childCallIndex] = event.CallEnded.Outputs
-				if event.CallEnded.Error != nil {
+				isChildCallEndedByIndex[childCallIndex] = true
+				if event.CallEnded.Error != nil && !event.CallEnded.Call.ContinueOnError {
 					isChildErred = true
 
 					// cancel all children on any error
 					cancelParallel()
 				}
 
+				if childCallName := callSpecParallelCall[childCallIndex].Name; childCallName != nil {
+					childCallOutcomeByName[*childCallName] = event.CallEnded.Outcome
+
+					if _, isNeededReady := childCallReadyChanByName[*childCallName]; isNeededReady && !isChildCallReadyByName[*childCallName] {
+						isChildErred = true
+						childErr = fmt.Errorf("needed call '%s' ended before becoming ready", *childCallName)
+
+						// calls needing it to be ready can never start
+						cancelParallel()
+					}
+				}
+
 				// decrement needed by counts for any needs
 				for _, neededCallRef := range callSpecParallelCall[childCallIndex].Needs {
 					childCallNeededCountByName[opspec.RefToName(neededCallRef)]--
 				}
+				for _, neededCallRef := range callSpecParallelCall[childCallIndex].NeedsReady {
+					childCallNeededCountByName[opspec.RefToName(neededCallRef)]--
+				}
 
 				for neededCallName, neededCount := range childCallNeededCountByName {
 					if 1 > neededCount {
@@ -161,28 +358,74 @@ eventLoop:
 						}
 					}
 				}
+
+				startOrSkipPendingChildCalls()
 			}
+		}
 
-			if len(childCallOutputsByIndex) == len(childCallIndexByID) {
-				// all calls have ended
+		if len(isChildCallEndedByIndex) == len(childCallIndexByID) {
+			// all calls have ended
+			childCallWaitGroup.Wait()
 
-				// construct parallel outputs
-				for i := 0; i < len(callSpecParallelCall); i++ {
-					callOutputs := childCallOutputsByIndex[i]
-					for varName, varData := range callOutputs {
-						outputs[varName] = varData
+			// construct parallel outputs
+			childCallOutputNamesByIndex := make([]map[string]bool, len(callSpecParallelCall))
+			producedValuesByName := map[string][]*model.Value{}
+			for i := 0; i < len(callSpecParallelCall); i++ {
+				callOutputs := childCallOutputsByIndex[i]
+				childCallOutputNamesByIndex[i] = map[string]bool{}
+				for varName, varData := range callOutputs {
+					outputs[varName] = varData
+					if !reflect.DeepEqual(inboundScope[varName], varData) {
+						// only count outputs the child produced rather than passed through
+						childCallOutputNamesByIndex[i][varName] = true
+						producedValuesByName[varName] = append(producedValuesByName[varName], varData)
 					}
 				}
+			}
+			for varName, producedValues := range producedValuesByName {
+				if isAllEqual(producedValues) {
+					// which of equal values is kept doesn't matter so they don't conflict
+					for _, outputNames := range childCallOutputNamesByIndex {
+						delete(outputNames, varName)
+					}
+				}
+			}
+
+			if isChildErred {
+				return nil, childErr
+			}
 
-				if isChildErred {
-					return nil, errors.New("child call failed")
+			if conflicts := parallel.OutputConflicts(callSpecParallelCall, childCallOutputNamesByIndex); len(conflicts) != 0 {
+				if pc.outputConflictPolicy == OutputConflictPolicyFail {
+					return nil, parallel.OutputConflictsError{CallLabelsByOutputName: conflicts}
 				}
 
-				break eventLoop
+				pc.pubSub.Publish(
+					model.Event{
+						CallOutputsConflicted: &model.CallOutputsConflicted{
+							CallID:                 callID,
+							CallLabelsByOutputName: conflicts,
+							OpRef:                  opPath,
+							RootCallID:             rootCallID,
+						},
+						Timestamp: time.Now().UTC(),
+					},
+				)
 			}
 
+			return outputs, nil
 		}
 	}
+}
 
-	return outputs, nil
+// isAllEqual returns whether values are all equal
+func isAllEqual(
+	values []*model.Value,
+) bool {
+	for _, value := range values[1:] {
+		if !reflect.DeepEqual(values[0], value) {
+			return false
+		}
+	}
+	return true
 }
@@ -2,10 +2,14 @@ package core
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sync"
+	"time"
 
 	"github.com/dgraph-io/badger/v3"
 	. "github.com/onsi/ginkgo"
@@ -24,10 +28,54 @@ var _ = Context("parallelCaller", func() {
 			Expect(newParallelCaller(
 				new(FakeCaller),
 				new(FakePubSub),
+				OutputConflictPolicyFail,
 			)).To(Not(BeNil()))
 		})
 	})
 	Context("Call", func() {
+		newObjectUnderTestWithPolicy := func(
+			fakeContainerRuntime *containerRuntimeFakes.FakeContainerRuntime,
+			outputConflictPolicy OutputConflictPolicy,
+		) _parallelCaller {
+			dbDir, err := ioutil.TempDir("", "")
+			if err != nil {
+				panic(err)
+			}
+
+			db, err := badger.Open(
+				badger.DefaultOptions(dbDir).WithLogger(nil),
+			)
+			if err != nil {
+				panic(err)
+			}
+			pubSub := pubsub.New(db)
+
+			return _parallelCaller{
+				caller: newCaller(
+					newContainerCaller(
+						newContainerCache(dbDir),
+						fakeContainerRuntime,
+						dbDir,
+						pubSub,
+						newStateStore(
+							context.Background(),
+							db,
+							pubSub,
+						),
+					),
+					dbDir,
+					pubSub,
+					outputConflictPolicy,
+				),
+				outputConflictPolicy: outputConflictPolicy,
+				pubSub:               pubSub,
+			}
+		}
+		newObjectUnderTest := func(
+			fakeContainerRuntime *containerRuntimeFakes.FakeContainerRuntime,
+		) _parallelCaller {
+			return newObjectUnderTestWithPolicy(fakeContainerRuntime, OutputConflictPolicyFail)
+		}
 
 		Context("caller errors", func() {
 
@@ -49,7 +97,9 @@ var _ = Context("parallelCaller", func() {
 				objectUnderTest := _parallelCaller{
 					caller: newCaller(
 						newContainerCaller(
+							newContainerCache(dbDir),
 							new(containerRuntimeFakes.FakeContainerRuntime),
+							dbDir,
 							pubSub,
 							newStateStore(
 								context.Background(),
@@ -59,6 +109,7 @@ var _ = Context("parallelCaller", func() {
 						),
 						dbDir,
 						pubSub,
+						OutputConflictPolicyFail,
 					),
 					pubSub: pubSub,
 				}
@@ -76,6 +127,7 @@ var _ = Context("parallelCaller", func() {
 							Container: &model.ContainerCallSpec{},
 						},
 					},
+					nil,
 				)
 
 				/* assert */
@@ -83,6 +135,762 @@ var _ = Context("parallelCaller", func() {
 			})
 		})
 
+		Context("needsReady references unknown call", func() {
+			It("should return expected error", func() {
+				/* arrange */
+				objectUnderTest := _parallelCaller{
+					caller: new(FakeCaller),
+					pubSub: new(FakePubSub),
+				}
+
+				/* act */
+				_, actualErr := objectUnderTest.Call(
+					context.Background(),
+					"callID",
+					map[string]*model.Value{},
+					"rootCallID",
+					"opPath",
+					[]*model.CallSpec{
+						{
+							Container:  &model.ContainerCallSpec{},
+							NeedsReady: []string{"db"},
+						},
+					},
+					nil,
+				)
+
+				/* assert */
+				Expect(actualErr).To(MatchError("unable to find call 'db' referenced by needsReady; must be the name of a sibling call"))
+			})
+		})
+
+		Context("needsReady references call w/ readiness", func() {
+			serviceName := "db"
+			readinessInterval := "10ms"
+			callSpecs := []*model.CallSpec{
+				{
+					Container: &model.ContainerCallSpec{
+						Image: &model.ContainerCallImageSpec{Ref: "dummyServiceImageRef"},
+						Readiness: &model.ContainerCallReadinessSpec{
+							Exec:     []interface{}{"pg_isready"},
+							Interval: &readinessInterval,
+						},
+					},
+					Name: &serviceName,
+				},
+				{
+					Container: &model.ContainerCallSpec{
+						Image: &model.ContainerCallImageSpec{Ref: "dummyImageRef"},
+					},
+					NeedsReady: []string{serviceName},
+				},
+			}
+
+			Context("call becomes ready", func() {
+				It("should start calls needing it once ready then kill it", func() {
+					/* arrange */
+					var readyMutex sync.Mutex
+					isReady := false
+					isStartedBeforeReady := false
+
+					fakeContainerRuntime := new(containerRuntimeFakes.FakeContainerRuntime)
+					fakeContainerRuntime.ProbeContainerStub = func(
+						ctx context.Context,
+						containerID string,
+						readiness *model.ContainerCallReadiness,
+					) error {
+						readyMutex.Lock()
+						defer readyMutex.Unlock()
+						isReady = true
+						return nil
+					}
+					fakeContainerRuntime.RunContainerStub = func(
+						ctx context.Context,
+						req *model.ContainerCall,
+						rootCallID string,
+						eventPublisher pubsub.EventPublisher,
+						stdin io.Reader,
+						stdOut io.WriteCloser,
+						stdErr io.WriteCloser,
+					) (*int64, error) {
+						defer stdErr.Close()
+						defer stdOut.Close()
+
+						if req.Readiness != nil {
+							// run until killed
+							<-ctx.Done()
+						} else {
+							readyMutex.Lock()
+							isStartedBeforeReady = !isReady
+							readyMutex.Unlock()
+						}
+
+						return nil, nil
+					}
+
+					objectUnderTest := newObjectUnderTest(fakeContainerRuntime)
+
+					/* act */
+					_, actualErr := objectUnderTest.Call(
+						context.Background(),
+						"callID",
+						map[string]*model.Value{},
+						"rootCallID",
+						"opPath",
+						callSpecs,
+						nil,
+					)
+
+					/* assert */
+					Expect(actualErr).To(BeNil())
+					Expect(fakeContainerRuntime.RunContainerCallCount()).To(Equal(2))
+					Expect(isStartedBeforeReady).To(BeFalse())
+				})
+			})
+
+			Context("call ends before becoming ready", func() {
+				It("should return expected error & not start calls needing it", func() {
+					/* arrange */
+					fakeContainerRuntime := new(containerRuntimeFakes.FakeContainerRuntime)
+					fakeContainerRuntime.ProbeContainerReturns(errors.New("dummyError"))
+					fakeContainerRuntime.RunContainerStub = func(
+						ctx context.Context,
+						req *model.ContainerCall,
+						rootCallID string,
+						eventPublisher pubsub.EventPublisher,
+						stdin io.Reader,
+						stdOut io.WriteCloser,
+						stdErr io.WriteCloser,
+					) (*int64, error) {
+						stdErr.Close()
+						stdOut.Close()
+
+						return nil, nil
+					}
+
+					objectUnderTest := newObjectUnderTest(fakeContainerRuntime)
+
+					/* act */
+					_, actualErr := objectUnderTest.Call(
+						context.Background(),
+						"callID",
+						map[string]*model.Value{},
+						"rootCallID",
+						"opPath",
+						callSpecs,
+						nil,
+					)
+
+					/* assert */
+					Expect(actualErr).To(MatchError("needed call 'db' ended before becoming ready"))
+					Expect(fakeContainerRuntime.RunContainerCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("call ends before becoming ready & calls are after those needing it", func() {
+				It("should skip calls needing it & calls after those & return expected error", func() {
+					/* arrange */
+					needingName := "needing"
+					providedCallSpecs := []*model.CallSpec{
+						callSpecs[0],
+						{
+							Container: &model.ContainerCallSpec{
+								Image: &model.ContainerCallImageSpec{Ref: "dummyImageRef"},
+							},
+							Name:       &needingName,
+							NeedsReady: []string{serviceName},
+						},
+						{
+							Container: &model.ContainerCallSpec{
+								Image: &model.ContainerCallImageSpec{Ref: "dummyImageRef"},
+							},
+							After: []string{needingName},
+						},
+					}
+
+					fakeContainerRuntime := new(containerRuntimeFakes.FakeContainerRuntime)
+					fakeContainerRuntime.ProbeContainerReturns(errors.New("dummyError"))
+					fakeContainerRuntime.RunContainerStub = func(
+						ctx context.Context,
+						req *model.ContainerCall,
+						rootCallID string,
+						eventPublisher pubsub.EventPublisher,
+						stdin io.Reader,
+						stdOut io.WriteCloser,
+						stdErr io.WriteCloser,
+					) (*int64, error) {
+						stdErr.Close()
+						stdOut.Close()
+
+						return nil, nil
+					}
+
+					objectUnderTest := newObjectUnderTest(fakeContainerRuntime)
+
+					/* act */
+					errChan := make(chan error, 1)
+					go func() {
+						_, err := objectUnderTest.Call(
+							context.Background(),
+							"callID",
+							map[string]*model.Value{},
+							"rootCallID",
+							"opPath",
+							providedCallSpecs,
+							nil,
+						)
+						errChan <- err
+					}()
+
+					/* assert */
+					var actualErr error
+					Eventually(errChan, 5*time.Second).Should(Receive(&actualErr))
+					Expect(actualErr).To(MatchError("needed call 'db' ended before becoming ready"))
+					Expect(fakeContainerRuntime.RunContainerCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		Context("after references calls", func() {
+			newContainerCallSpec := func(cmd string) *model.ContainerCallSpec {
+				return &model.ContainerCallSpec{
+					Cmd:   []interface{}{cmd},
+					Image: &model.ContainerCallImageSpec{Ref: "dummyImageRef"},
+				}
+			}
+			firstName := "first"
+
+			Context("calls succeed", func() {
+				It("should start calls after those they're after", func() {
+					/* arrange */
+					var cmdsMutex sync.Mutex
+					actualCmds := []string{}
+
+					fakeContainerRuntime := new(containerRuntimeFakes.FakeContainerRuntime)
+					fakeContainerRuntime.RunContainerStub = func(
+						ctx context.Context,
+						req *model.ContainerCall,
+						rootCallID string,
+						eventPublisher pubsub.EventPublisher,
+						stdin io.Reader,
+						stdOut io.WriteCloser,
+						stdErr io.WriteCloser,
+					) (*int64, error) {
+						defer stdErr.Close()
+						defer stdOut.Close()
+
+						if req.Cmd[0] == firstName {
+							// give calls which aren't after it a chance to start early
+							time.Sleep(50 * time.Millisecond)
+						}
+
+						cmdsMutex.Lock()
+						defer cmdsMutex.Unlock()
+						actualCmds = append(actualCmds, req.Cmd[0])
+
+						return nil, nil
+					}
+
+					objectUnderTest := newObjectUnderTest(fakeContainerRuntime)
+
+					/* act */
+					_, actualErr := objectUnderTest.Call(
+						context.Background(),
+						"callID",
+						map[string]*model.Value{},
+						"rootCallID",
+						"opPath",
+						[]*model.CallSpec{
+							{
+								After:     []string{firstName},
+								Container: newContainerCallSpec("second"),
+							},
+							{
+								Container: newContainerCallSpec(firstName),
+								Name:      &firstName,
+							},
+						},
+						nil,
+					)
+
+					/* assert */
+					Expect(actualErr).To(BeNil())
+					Expect(actualCmds).To(Equal([]string{firstName, "second"}))
+				})
+			})
+
+			Context("call fails", func() {
+				It("should skip calls after it", func() {
+					/* arrange */
+					fakeContainerRuntime := new(containerRuntimeFakes.FakeContainerRuntime)
+					fakeContainerRuntime.RunContainerStub = func(
+						ctx context.Context,
+						req *model.ContainerCall,
+						rootCallID string,
+						eventPublisher pubsub.EventPublisher,
+						stdin io.Reader,
+						stdOut io.WriteCloser,
+						stdErr io.WriteCloser,
+					) (*int64, error) {
+						stdErr.Close()
+						stdOut.Close()
+
+						exitCode := int64(1)
+						return &exitCode, nil
+					}
+
+					objectUnderTest := newObjectUnderTest(fakeContainerRuntime)
+
+					eventChannel, err := objectUnderTest.pubSub.Subscribe(
+						context.Background(),
+						model.EventFilter{},
+					)
+					if err != nil {
+						panic(err)
+					}
+
+					secondName := "second"
+					thirdName := "third"
+
+					/* act */
+					_, actualErr := objectUnderTest.Call(
+						context.Background(),
+						"callID",
+						map[string]*model.Value{},
+						"rootCallID",
+						"opPath",
+						[]*model.CallSpec{
+							{
+								Container: newContainerCallSpec(firstName),
+								Name:      &firstName,
+							},
+							{
+								After:     []string{firstName},
+								Container: newContainerCallSpec(secondName),
+								Name:      &secondName,
+							},
+							{
+								After:     []string{secondName},
+								Container: newContainerCallSpec(thirdName),
+								Name:      &thirdName,
+							},
+						},
+						nil,
+					)
+
+					/* assert */
+					Expect(actualErr).To(MatchError("child call failed"))
+					Expect(fakeContainerRuntime.RunContainerCallCount()).To(Equal(1))
+
+					actualPendingCallNames := []string{}
+					actualSkippedCallNames := []string{}
+					for event := range eventChannel {
+						if event.CallPending != nil {
+							actualPendingCallNames = append(actualPendingCallNames, *event.CallPending.Call.Name)
+						}
+						if event.CallEnded != nil && event.CallEnded.Outcome == model.OpOutcomeSkipped {
+							actualSkippedCallNames = append(actualSkippedCallNames, *event.CallEnded.Call.Name)
+						}
+						if len(actualSkippedCallNames) == 2 {
+							break
+						}
+					}
+					Expect(actualPendingCallNames).To(ConsistOf(secondName, thirdName))
+					Expect(actualSkippedCallNames).To(Equal([]string{secondName, thirdName}))
+				})
+			})
+		})
+
+		Context("children produce same output", func() {
+			outputRef := "$(output)"
+			firstName := "first"
+			secondName := "second"
+			newCallSpecs := func(isConditional bool) []*model.CallSpec {
+				callSpecs := []*model.CallSpec{}
+				for _, name := range []string{firstName, secondName} {
+					// copy name before taking address; range vars have same address for every iteration
+					name := name
+					callSpec := &model.CallSpec{
+						Container: &model.ContainerCallSpec{
+							Image:  &model.ContainerCallImageSpec{Ref: "dummyImageRef"},
+							StdOut: &model.ContainerCallStdioSpec{String: &outputRef},
+						},
+						Name: &name,
+					}
+					if isConditional {
+						callSpec.If = &[]*model.PredicateSpec{{Eq: &[]interface{}{true, true}}}
+					}
+					callSpecs = append(callSpecs, callSpec)
+				}
+				return callSpecs
+			}
+			// newFakeContainerRuntime returns a runtime whose containers output distinct stdout unless isSameStdOut
+			newFakeContainerRuntime := func(isSameStdOut bool) *containerRuntimeFakes.FakeContainerRuntime {
+				var stdOutMutex sync.Mutex
+				nextStdOut := 0
+
+				fakeContainerRuntime := new(containerRuntimeFakes.FakeContainerRuntime)
+				fakeContainerRuntime.RunContainerStub = func(
+					ctx context.Context,
+					req *model.ContainerCall,
+					rootCallID string,
+					eventPublisher pubsub.EventPublisher,
+					stdin io.Reader,
+					stdOut io.WriteCloser,
+					stdErr io.WriteCloser,
+				) (*int64, error) {
+					defer stdErr.Close()
+					defer stdOut.Close()
+
+					stdOutMutex.Lock()
+					defer stdOutMutex.Unlock()
+					fmt.Fprint(stdOut, nextStdOut)
+					if !isSameStdOut {
+						nextStdOut++
+					}
+					return nil, nil
+				}
+				return fakeContainerRuntime
+			}
+			expectedErr := "conflicting outputs; 'output' produced by calls 'first', 'second'"
+			expectedCallOutputsConflicted := &model.CallOutputsConflicted{
+				CallID: "callID",
+				CallLabelsByOutputName: map[string][]string{
+					"output": {firstName, secondName},
+				},
+				OpRef:      "opPath",
+				RootCallID: "rootCallID",
+			}
+
+			Context("bindings static", func() {
+				Context("outputConflictPolicy fail", func() {
+					It("should return expected error & not start children", func() {
+						/* arrange */
+						fakeContainerRuntime := newFakeContainerRuntime(false)
+						objectUnderTest := newObjectUnderTestWithPolicy(fakeContainerRuntime, OutputConflictPolicyFail)
+						providedCallSpecs := newCallSpecs(false)
+
+						/* act */
+						_, actualErr := objectUnderTest.caller.Call(
+							context.Background(),
+							"callID",
+							map[string]*model.Value{},
+							&model.CallSpec{Parallel: &providedCallSpecs},
+							"opPath",
+							nil,
+							"rootCallID",
+						)
+
+						/* assert */
+						Expect(actualErr).To(MatchError(expectedErr))
+						Expect(fakeContainerRuntime.RunContainerCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("outputConflictPolicy warn", func() {
+					It("should publish expected event & return outputs", func() {
+						/* arrange */
+						fakeContainerRuntime := newFakeContainerRuntime(false)
+						objectUnderTest := newObjectUnderTestWithPolicy(fakeContainerRuntime, OutputConflictPolicyWarn)
+						providedCallSpecs := newCallSpecs(false)
+
+						startTime := time.Now().UTC()
+
+						/* act */
+						actualOutputs, actualErr := objectUnderTest.caller.Call(
+							context.Background(),
+							"callID",
+							map[string]*model.Value{},
+							&model.CallSpec{Parallel: &providedCallSpecs},
+							"opPath",
+							nil,
+							"rootCallID",
+						)
+
+						/* assert */
+						Expect(actualErr).To(BeNil())
+						Expect(actualOutputs).To(HaveKey("output"))
+						Expect(fakeContainerRuntime.RunContainerCallCount()).To(Equal(2))
+
+						eventChannel, _ := objectUnderTest.pubSub.Subscribe(
+							context.Background(),
+							model.EventFilter{
+								Kinds: []string{model.EventKindCallOutputsConflicted},
+								Since: &startTime,
+							},
+						)
+						Expect((<-eventChannel).CallOutputsConflicted).To(Equal(expectedCallOutputsConflicted))
+					})
+				})
+			})
+
+			Context("bindings dynamic", func() {
+				Context("values equal", func() {
+					It("should return outputs", func() {
+						/* arrange */
+						objectUnderTest := newObjectUnderTestWithPolicy(newFakeContainerRuntime(true), OutputConflictPolicyFail)
+
+						/* act */
+						actualOutputs, actualErr := objectUnderTest.Call(
+							context.Background(),
+							"callID",
+							map[string]*model.Value{},
+							"rootCallID",
+							"opPath",
+							newCallSpecs(true),
+							nil,
+						)
+
+						/* assert */
+						Expect(actualErr).To(BeNil())
+						Expect(actualOutputs).To(HaveKey("output"))
+					})
+				})
+
+				Context("values equal to those in scope", func() {
+					It("should return outputs", func() {
+						/* arrange */
+						objectUnderTest := newObjectUnderTestWithPolicy(newFakeContainerRuntime(false), OutputConflictPolicyFail)
+
+						scopeOutput := "0"
+
+						/* act */
+						actualOutputs, actualErr := objectUnderTest.Call(
+							context.Background(),
+							"callID",
+							map[string]*model.Value{
+								// a distinct pointer to an equal value; passed through rather than produced
+								"output": {String: &scopeOutput},
+							},
+							"rootCallID",
+							"opPath",
+							newCallSpecs(true),
+							nil,
+						)
+
+						/* assert */
+						Expect(actualErr).To(BeNil())
+						Expect(actualOutputs).To(HaveKey("output"))
+					})
+				})
+
+				Context("outputConflictPolicy fail", func() {
+					It("should return expected error", func() {
+						/* arrange */
+						fakeContainerRuntime := newFakeContainerRuntime(false)
+						objectUnderTest := newObjectUnderTestWithPolicy(fakeContainerRuntime, OutputConflictPolicyFail)
+
+						/* act */
+						_, actualErr := objectUnderTest.Call(
+							context.Background(),
+							"callID",
+							map[string]*model.Value{},
+							"rootCallID",
+							"opPath",
+							newCallSpecs(true),
+							nil,
+						)
+
+						/* assert */
+						Expect(actualErr).To(MatchError(expectedErr))
+						Expect(fakeContainerRuntime.RunContainerCallCount()).To(Equal(2))
+					})
+				})
+
+				Context("outputConflictPolicy warn", func() {
+					It("should publish expected event & return outputs", func() {
+						/* arrange */
+						objectUnderTest := newObjectUnderTestWithPolicy(newFakeContainerRuntime(false), OutputConflictPolicyWarn)
+
+						startTime := time.Now().UTC()
+
+						/* act */
+						actualOutputs, actualErr := objectUnderTest.Call(
+							context.Background(),
+							"callID",
+							map[string]*model.Value{},
+							"rootCallID",
+							"opPath",
+							newCallSpecs(true),
+							nil,
+						)
+
+						/* assert */
+						Expect(actualErr).To(BeNil())
+						Expect(actualOutputs).To(HaveKey("output"))
+
+						eventChannel, _ := objectUnderTest.pubSub.Subscribe(
+							context.Background(),
+							model.EventFilter{
+								Kinds: []string{model.EventKindCallOutputsConflicted},
+								Since: &startTime,
+							},
+						)
+						Expect((<-eventChannel).CallOutputsConflicted).To(Equal(expectedCallOutputsConflicted))
+					})
+				})
+			})
+		})
+
+		Context("maxConcurrency set", func() {
+			It("should queue calls over maxConcurrency until running calls end", func() {
+				/* arrange */
+				var runningMutex sync.Mutex
+				runningCount := 0
+				actualMaxRunningCount := 0
+
+				fakeContainerRuntime := new(containerRuntimeFakes.FakeContainerRuntime)
+				fakeContainerRuntime.RunContainerStub = func(
+					ctx context.Context,
+					req *model.ContainerCall,
+					rootCallID string,
+					eventPublisher pubsub.EventPublisher,
+					stdin io.Reader,
+					stdOut io.WriteCloser,
+					stdErr io.WriteCloser,
+				) (*int64, error) {
+					defer stdErr.Close()
+					defer stdOut.Close()
+
+					runningMutex.Lock()
+					runningCount++
+					if runningCount > actualMaxRunningCount {
+						actualMaxRunningCount = runningCount
+					}
+					runningMutex.Unlock()
+
+					// give queued calls a chance to start early
+					time.Sleep(20 * time.Millisecond)
+
+					runningMutex.Lock()
+					runningCount--
+					runningMutex.Unlock()
+
+					return nil, nil
+				}
+
+				objectUnderTest := newObjectUnderTest(fakeContainerRuntime)
+
+				eventChannel, err := objectUnderTest.pubSub.Subscribe(
+					context.Background(),
+					model.EventFilter{},
+				)
+				if err != nil {
+					panic(err)
+				}
+
+				callSpecs := []*model.CallSpec{}
+				for i := 0; i < 4; i++ {
+					callSpecs = append(
+						callSpecs,
+						&model.CallSpec{
+							Container: &model.ContainerCallSpec{
+								Image: &model.ContainerCallImageSpec{Ref: "dummyImageRef"},
+							},
+						},
+					)
+				}
+				providedMaxConcurrency := 2
+
+				/* act */
+				_, actualErr := objectUnderTest.Call(
+					context.Background(),
+					"callID",
+					map[string]*model.Value{},
+					"rootCallID",
+					"opPath",
+					callSpecs,
+					&providedMaxConcurrency,
+				)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(fakeContainerRuntime.RunContainerCallCount()).To(Equal(4))
+				Expect(actualMaxRunningCount).To(Equal(providedMaxConcurrency))
+
+				actualQueuedCallCount := 0
+				endedCallCount := 0
+				for event := range eventChannel {
+					if event.CallPending != nil {
+						actualQueuedCallCount++
+					}
+					if event.CallEnded != nil {
+						endedCallCount++
+					}
+					if endedCallCount == len(callSpecs) {
+						break
+					}
+				}
+				Expect(actualQueuedCallCount).To(Equal(2))
+			})
+			Context("calls needed by siblings", func() {
+				It("should not count them against maxConcurrency", func() {
+					/* arrange */
+					fakeContainerRuntime := new(containerRuntimeFakes.FakeContainerRuntime)
+					fakeContainerRuntime.RunContainerStub = func(
+						ctx context.Context,
+						req *model.ContainerCall,
+						rootCallID string,
+						eventPublisher pubsub.EventPublisher,
+						stdin io.Reader,
+						stdOut io.WriteCloser,
+						stdErr io.WriteCloser,
+					) (*int64, error) {
+						defer stdErr.Close()
+						defer stdOut.Close()
+
+						if len(req.Cmd) != 0 && req.Cmd[0] == "serve" {
+							// run until killed
+							<-ctx.Done()
+						}
+
+						return nil, nil
+					}
+
+					objectUnderTest := newObjectUnderTest(fakeContainerRuntime)
+
+					serviceName := "db"
+					callSpecs := []*model.CallSpec{
+						{
+							Container: &model.ContainerCallSpec{
+								Cmd:   []interface{}{"serve"},
+								Image: &model.ContainerCallImageSpec{Ref: "dummyServiceImageRef"},
+							},
+							Name: &serviceName,
+						},
+						{
+							Container: &model.ContainerCallSpec{
+								Image: &model.ContainerCallImageSpec{Ref: "dummyImageRef"},
+							},
+							Needs: []string{serviceName},
+						},
+					}
+					providedMaxConcurrency := 1
+
+					/* act */
+					var actualErr error
+					callDone := make(chan struct{})
+					go func() {
+						defer close(callDone)
+						_, actualErr = objectUnderTest.Call(
+							context.Background(),
+							"callID",
+							map[string]*model.Value{},
+							"rootCallID",
+							"opPath",
+							callSpecs,
+							&providedMaxConcurrency,
+						)
+					}()
+
+					/* assert */
+					Eventually(callDone, 5*time.Second).Should(BeClosed())
+					Expect(actualErr).To(BeNil())
+					Expect(fakeContainerRuntime.RunContainerCallCount()).To(Equal(2))
+				})
+			})
+		})
+
 		It("should start each child as expected", func() {
 
 			/* arrange */
@@ -119,6 +927,7 @@ var _ = Context("parallelCaller", func() {
 				req *model.ContainerCall,
 				rootCallID string,
 				eventPublisher pubsub.EventPublisher,
+				stdin io.Reader,
 				stdOut io.WriteCloser,
 				stdErr io.WriteCloser,
 			) (*int64, error) {
@@ -145,7 +954,9 @@ var _ = Context("parallelCaller", func() {
 			objectUnderTest := _parallelCaller{
 				caller: newCaller(
 					newContainerCaller(
+						newContainerCache(dbDir),
 						fakeContainerRuntime,
+						dbDir,
 						pubSub,
 						newStateStore(
 							ctx,
@@ -155,6 +966,7 @@ var _ = Context("parallelCaller", func() {
 					),
 					dbDir,
 					pubSub,
+					OutputConflictPolicyFail,
 				),
 				pubSub: pubSub,
 			}
@@ -184,6 +996,7 @@ var _ = Context("parallelCaller", func() {
 						},
 					},
 				},
+				nil,
 			)
 
 			/* assert */
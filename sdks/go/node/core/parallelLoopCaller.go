@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"runtime/debug"
+	"sync"
 	"time"
 
 	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/loop"
@@ -67,6 +68,12 @@ func (plpr _parallelLoopCaller) Call(
 	childCallIndex := 0
 	startTime := time.Now().UTC()
 	childCallIndexByID := map[string]int{}
+	// use returned outputs rather than those of events; events have secrets redacted
+	childCallOutputsByIndex := map[int]map[string]*model.Value{}
+	var childCallOutputsMutex sync.Mutex
+	var childCallWaitGroup sync.WaitGroup
+	var limiter concurrencyLimiter
+	var loopRange *model.Value
 
 	for {
 
@@ -95,13 +102,30 @@ func (plpr _parallelLoopCaller) Call(
 			return nil, interpretErr
 		}
 
+		if childCallIndex == 0 {
+			loopRange = callParallelLoop.Range
+		}
+
 		if parallelloop.IsIterationComplete(childCallIndex, *callParallelLoop) {
 			break
 		}
 
+		if childCallIndex == 0 {
+			limiter = newConcurrencyLimiter(callParallelLoop.MaxConcurrency, plpr.pubSub)
+		}
+
 		childCallIndexByID[childCallID] = childCallIndex
 
-		go func() {
+		queuedChildCall := model.Call{
+			ID:       childCallID,
+			ParentID: parentCallID,
+			RootID:   rootCallID,
+		}
+		isSlotAcquired := limiter.Queue(queuedChildCall, opPath)
+
+		childCallWaitGroup.Add(1)
+		go func(childCallIndex int) {
+			defer childCallWaitGroup.Done()
 			defer func() {
 				if panicArg := recover(); panicArg != nil {
 					// recover from panics; treat as errors
@@ -112,7 +136,12 @@ func (plpr _parallelLoopCaller) Call(
 				}
 			}()
 
-			plpr.caller.Call(
+			if !isSlotAcquired && !limiter.Acquire(parallelLoopCtx, queuedChildCall, opPath) {
+				return
+			}
+			defer limiter.Release()
+
+			childCallOutputs, _ := plpr.caller.Call(
 				parallelLoopCtx,
 				childCallID,
 				childCallScope,
@@ -121,13 +150,28 @@ func (plpr _parallelLoopCaller) Call(
 				parentCallID,
 				rootCallID,
 			)
-		}()
+
+			childCallOutputsMutex.Lock()
+			defer childCallOutputsMutex.Unlock()
+			childCallOutputsByIndex[childCallIndex] = childCallOutputs
+		}(childCallIndex)
 
 		childCallIndex++
 
 	}
 
 	if len(childCallIndexByID) == 0 {
+		if len(callSpecParallelLoop.Collect) != 0 {
+			// bind empty collections
+			return loop.DeScope(
+				inboundScope,
+				loopRange,
+				callSpecParallelLoop.Vars,
+				callSpecParallelLoop.Collect,
+				inboundScope,
+				nil,
+			), nil
+		}
 		return nil, nil
 	}
 
@@ -143,15 +187,16 @@ func (plpr _parallelLoopCaller) Call(
 	)
 
 	var isChildErred = false
-	childCallOutputsByIndex := map[int]map[string]*model.Value{}
+	isChildCallEndedByIndex := map[int]bool{}
 	outputs := inboundScope
+	iterationOutputs := make([]map[string]*model.Value, len(childCallIndexByID))
 
 eventLoop:
 	for event := range eventChannel {
-		if event.CallEnded != nil {
+		if event.CallEnded != nil && !event.CallEnded.WillRetry {
 			if childCallIndex, isChildCallEnded := childCallIndexByID[event.CallEnded.Call.ID]; isChildCallEnded {
-				childCallOutputsByIndex[childCallIndex] = event.CallEnded.Outputs
-				if event.CallEnded.Error != nil {
+				isChildCallEndedByIndex[childCallIndex] = true
+				if event.CallEnded.Error != nil && !event.CallEnded.Call.ContinueOnError {
 					isChildErred = true
 
 					// cancel all children on any error
@@ -159,8 +204,9 @@ eventLoop:
 				}
 			}
 
-			if len(childCallOutputsByIndex) == len(childCallIndexByID) {
+			if len(isChildCallEndedByIndex) == len(childCallIndexByID) {
 				// all calls have ended
+				childCallWaitGroup.Wait()
 
 				// construct parallel outputs
 				for i := 0; i < len(childCallIndexByID); i++ {
@@ -168,6 +214,7 @@ eventLoop:
 					for varName, varData := range callOutputs {
 						outputs[varName] = varData
 					}
+					iterationOutputs[i] = callOutputs
 				}
 
 				if isChildErred {
@@ -182,8 +229,10 @@ eventLoop:
 
 	return loop.DeScope(
 		inboundScope,
-		callSpecParallelLoop.Range,
+		loopRange,
 		callSpecParallelLoop.Vars,
+		callSpecParallelLoop.Collect,
 		outputs,
+		iterationOutputs,
 	), nil
 }
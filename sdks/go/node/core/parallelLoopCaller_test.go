@@ -4,6 +4,9 @@ import (
 	"context"
 	"io"
 	"io/ioutil"
+	"strconv"
+	"sync"
+	"time"
 
 	"github.com/dgraph-io/badger/v3"
 	. "github.com/onsi/ginkgo"
@@ -77,7 +80,9 @@ var _ = Context("parallelLoopCaller", func() {
 
 				caller := newCaller(
 					newContainerCaller(
+						newContainerCache(dbDir),
 						new(containerRuntimeFakes.FakeContainerRuntime),
+						dbDir,
 						pubSub,
 						newStateStore(
 							providedCtx,
@@ -87,6 +92,7 @@ var _ = Context("parallelLoopCaller", func() {
 					),
 					dbDir,
 					pubSub,
+					OutputConflictPolicyFail,
 				)
 
 				objectUnderTest := _parallelLoopCaller{
@@ -118,6 +124,216 @@ var _ = Context("parallelLoopCaller", func() {
 			})
 		})
 
+		Context("maxConcurrency set", func() {
+			It("should queue iterations over maxConcurrency until running iterations end", func() {
+				/* arrange */
+				dbDir, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				db, err := badger.Open(
+					badger.DefaultOptions(dbDir).WithLogger(nil),
+				)
+				if err != nil {
+					panic(err)
+				}
+				pubSub := pubsub.New(db)
+
+				var runningMutex sync.Mutex
+				runningCount := 0
+				actualMaxRunningCount := 0
+
+				fakeContainerRuntime := new(containerRuntimeFakes.FakeContainerRuntime)
+				fakeContainerRuntime.RunContainerStub = func(
+					ctx context.Context,
+					req *model.ContainerCall,
+					rootCallID string,
+					eventPublisher pubsub.EventPublisher,
+					stdin io.Reader,
+					stdOut io.WriteCloser,
+					stdErr io.WriteCloser,
+				) (*int64, error) {
+					defer stdErr.Close()
+					defer stdOut.Close()
+
+					runningMutex.Lock()
+					runningCount++
+					if runningCount > actualMaxRunningCount {
+						actualMaxRunningCount = runningCount
+					}
+					runningMutex.Unlock()
+
+					// wait (bounded) for another iteration to be running so iterations overlap & queued ones have a chance to start early
+					for waitStart := time.Now(); time.Since(waitStart) < 200*time.Millisecond; time.Sleep(time.Millisecond) {
+						runningMutex.Lock()
+						isOverlapping := runningCount > 1
+						runningMutex.Unlock()
+						if isOverlapping {
+							break
+						}
+					}
+					time.Sleep(20 * time.Millisecond)
+
+					runningMutex.Lock()
+					runningCount--
+					runningMutex.Unlock()
+
+					return nil, nil
+				}
+
+				objectUnderTest := _parallelLoopCaller{
+					caller: newCaller(
+						newContainerCaller(
+							newContainerCache(dbDir),
+							fakeContainerRuntime,
+							dbDir,
+							pubSub,
+							newStateStore(
+								context.Background(),
+								db,
+								pubSub,
+							),
+						),
+						dbDir,
+						pubSub,
+						OutputConflictPolicyFail,
+					),
+					pubSub: pubSub,
+				}
+
+				/* act */
+				_, actualErr := objectUnderTest.Call(
+					context.Background(),
+					"id",
+					map[string]*model.Value{},
+					model.ParallelLoopCallSpec{
+						MaxConcurrency: 2,
+						Range:          []interface{}{1, 2, 3, 4, 5},
+						Run: model.CallSpec{
+							Container: &model.ContainerCallSpec{
+								Image: &model.ContainerCallImageSpec{Ref: "dummyImageRef"},
+							},
+						},
+					},
+					"opPath",
+					new(string),
+					"rootCallID",
+				)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(fakeContainerRuntime.RunContainerCallCount()).To(Equal(5))
+				Expect(actualMaxRunningCount).To(Equal(2))
+			})
+		})
+		Context("collect set", func() {
+			It("should collect outputs of each iteration in iteration order", func() {
+				/* arrange */
+				dbDir, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				db, err := badger.Open(
+					badger.DefaultOptions(dbDir).WithLogger(nil),
+				)
+				if err != nil {
+					panic(err)
+				}
+				pubSub := pubsub.New(db)
+
+				fakeContainerRuntime := new(containerRuntimeFakes.FakeContainerRuntime)
+				fakeContainerRuntime.RunContainerStub = func(
+					ctx context.Context,
+					req *model.ContainerCall,
+					rootCallID string,
+					eventPublisher pubsub.EventPublisher,
+					stdin io.Reader,
+					stdOut io.WriteCloser,
+					stdErr io.WriteCloser,
+				) (*int64, error) {
+					defer stdErr.Close()
+					defer stdOut.Close()
+
+					exitCode, err := strconv.ParseInt(req.EnvVars["EXIT_CODE"], 10, 64)
+					if err != nil {
+						return nil, err
+					}
+
+					// end later iterations first
+					time.Sleep(time.Duration(10-exitCode) * 5 * time.Millisecond)
+
+					return &exitCode, nil
+				}
+
+				objectUnderTest := _parallelLoopCaller{
+					caller: newCaller(
+						newContainerCaller(
+							newContainerCache(dbDir),
+							fakeContainerRuntime,
+							dbDir,
+							pubSub,
+							newStateStore(
+								context.Background(),
+								db,
+								pubSub,
+							),
+						),
+						dbDir,
+						pubSub,
+						OutputConflictPolicyFail,
+					),
+					pubSub: pubSub,
+				}
+
+				exitCodeRef := "$(exitCode)"
+				valueRef := "$(value)"
+
+				/* act */
+				actualOutputs, actualErr := objectUnderTest.Call(
+					context.Background(),
+					"id",
+					map[string]*model.Value{},
+					model.ParallelLoopCallSpec{
+						Collect: map[string]string{
+							"exitCode": "$(exitCodes)",
+						},
+						Range: map[string]interface{}{
+							"c": 3,
+							"a": 1,
+							"b": 2,
+						},
+						Run: model.CallSpec{
+							Container: &model.ContainerCallSpec{
+								AllowNonZeroExit: true,
+								EnvVars: map[string]interface{}{
+									"EXIT_CODE": valueRef,
+								},
+								ExitCode: &exitCodeRef,
+								Image:    &model.ContainerCallImageSpec{Ref: "dummyImageRef"},
+							},
+						},
+						Vars: &model.LoopVarsSpec{
+							Value: &valueRef,
+						},
+					},
+					"opPath",
+					new(string),
+					"rootCallID",
+				)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+
+				actualExitCodes, err := actualOutputs["exitCodes"].Unbox()
+				if err != nil {
+					panic(err)
+				}
+				Expect(actualExitCodes).To(Equal(map[string]interface{}{"a": 1.0, "b": 2.0, "c": 3.0}))
+			})
+		})
+
 		It("should start each child as expected", func() {
 			/* arrange */
 			dbDir, err := ioutil.TempDir("", "")
@@ -146,6 +362,7 @@ var _ = Context("parallelLoopCaller", func() {
 				req *model.ContainerCall,
 				rootCallID string,
 				eventPublisher pubsub.EventPublisher,
+				stdin io.Reader,
 				stdOut io.WriteCloser,
 				stdErr io.WriteCloser,
 			) (*int64, error) {
@@ -167,7 +384,9 @@ var _ = Context("parallelLoopCaller", func() {
 			objectUnderTest := _parallelLoopCaller{
 				caller: newCaller(
 					newContainerCaller(
+						newContainerCache(dbDir),
 						fakeContainerRuntime,
+						dbDir,
 						pubSub,
 						newStateStore(
 							ctx,
@@ -177,6 +396,7 @@ var _ = Context("parallelLoopCaller", func() {
 					),
 					dbDir,
 					pubSub,
+					OutputConflictPolicyFail,
 				),
 				pubSub: pubSub,
 			}
@@ -0,0 +1,17 @@
+package core
+
+import (
+	"context"
+
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+func (this core) PruneCache(
+	ctx context.Context,
+	req model.PruneCacheReq,
+) (
+	[]*model.CacheEntry,
+	error,
+) {
+	return this.containerCache.Prune(req.Before)
+}
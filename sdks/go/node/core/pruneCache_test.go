@@ -0,0 +1,55 @@
+package core
+
+import (
+	"context"
+	"io/ioutil"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+var _ = Context("core", func() {
+	Context("PruneCache", func() {
+		It("should return pruned entries", func() {
+			/* arrange */
+			dataDir, err := ioutil.TempDir("", "")
+			if err != nil {
+				panic(err)
+			}
+
+			imageRef := "imageRef"
+			containerCache := newContainerCache(dataDir)
+			err = containerCache.Store(
+				"key",
+				&model.ContainerCall{Image: &model.ContainerCallImage{Ref: &imageRef}},
+				map[string]*model.Value{},
+				nil,
+			)
+			if err != nil {
+				panic(err)
+			}
+
+			objectUnderTest := core{
+				containerCache: containerCache,
+			}
+
+			/* act */
+			actualPruned, actualErr := objectUnderTest.PruneCache(
+				context.Background(),
+				model.PruneCacheReq{},
+			)
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(actualPruned).To(HaveLen(1))
+			Expect(actualPruned[0].Key).To(Equal("key"))
+
+			actualEntries, err := objectUnderTest.ListCacheEntries(context.Background())
+			if err != nil {
+				panic(err)
+			}
+			Expect(actualEntries).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,57 @@
+package core
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/pubsub"
+)
+
+func (this core) PruneEvents(
+	ctx context.Context,
+	req model.PruneEventsReq,
+) (
+	[]string,
+	error,
+) {
+	policy := pubsub.RetentionPolicy{
+		All:          req.All,
+		MaxSizeBytes: req.MaxSizeBytes,
+		MaxRootCalls: req.MaxRootCalls,
+	}
+	if req.Before != nil {
+		policy.MaxAge = time.Since(*req.Before)
+		if policy.MaxAge <= 0 {
+			// zero would disable the limit; prune everything ended before now
+			policy.MaxAge = time.Nanosecond
+		}
+	}
+
+	return pruneEvents(this.pubSub, this.dataDirPath, policy)
+}
+
+// pruneEvents prunes events according to policy & removes scratch dirs of pruned calls
+func pruneEvents(
+	pubSub pubsub.EventPruner,
+	dataDirPath string,
+	policy pubsub.RetentionPolicy,
+) (
+	[]string,
+	error,
+) {
+	prunedCallIDs, err := pubSub.Prune(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, callID := range prunedCallIDs {
+		if err := os.RemoveAll(filepath.Join(dataDirPath, "dcg", callID)); err != nil {
+			return nil, err
+		}
+	}
+
+	return prunedCallIDs, nil
+}
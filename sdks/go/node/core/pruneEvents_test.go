@@ -0,0 +1,86 @@
+package core
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/pubsub"
+	. "github.com/opctl/opctl/sdks/go/pubsub/fakes"
+)
+
+var _ = Context("core", func() {
+	Context("PruneEvents", func() {
+		It("should call pubSub.Prune w/ expected args", func() {
+			/* arrange */
+			providedBefore := time.Now().Add(-time.Hour)
+			providedReq := model.PruneEventsReq{
+				All:          true,
+				Before:       &providedBefore,
+				MaxRootCalls: 2,
+				MaxSizeBytes: 3,
+			}
+
+			fakePubSub := new(FakePubSub)
+
+			objectUnderTest := core{
+				pubSub: fakePubSub,
+			}
+
+			/* act */
+			objectUnderTest.PruneEvents(
+				context.Background(),
+				providedReq,
+			)
+
+			/* assert */
+			actualPolicy := fakePubSub.PruneArgsForCall(0)
+			Expect(actualPolicy.All).To(BeTrue())
+			Expect(actualPolicy.MaxAge).To(BeNumerically("~", time.Hour, time.Minute))
+			Expect(actualPolicy.MaxRootCalls).To(Equal(providedReq.MaxRootCalls))
+			Expect(actualPolicy.MaxSizeBytes).To(Equal(providedReq.MaxSizeBytes))
+		})
+		It("should remove scratch dirs of pruned calls", func() {
+			/* arrange */
+			dataDir, err := ioutil.TempDir("", "")
+			if err != nil {
+				panic(err)
+			}
+
+			prunedCallID := "prunedCallID"
+			prunedScratchDirPath := filepath.Join(dataDir, "dcg", prunedCallID)
+			retainedScratchDirPath := filepath.Join(dataDir, "dcg", "retainedCallID")
+			for _, scratchDirPath := range []string{prunedScratchDirPath, retainedScratchDirPath} {
+				if err := os.MkdirAll(scratchDirPath, 0700); err != nil {
+					panic(err)
+				}
+			}
+
+			fakePubSub := new(FakePubSub)
+			fakePubSub.PruneReturns([]string{prunedCallID}, nil)
+
+			objectUnderTest := core{
+				dataDirPath: dataDir,
+				pubSub:      fakePubSub,
+			}
+
+			/* act */
+			actualPrunedCallIDs, actualErr := objectUnderTest.PruneEvents(
+				context.Background(),
+				model.PruneEventsReq{},
+			)
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(actualPrunedCallIDs).To(Equal([]string{prunedCallID}))
+			Expect(fakePubSub.PruneArgsForCall(0)).To(Equal(pubsub.RetentionPolicy{}))
+			Expect(prunedScratchDirPath).NotTo(BeADirectory())
+			Expect(retainedScratchDirPath).To(BeADirectory())
+		})
+	})
+})
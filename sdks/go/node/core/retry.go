@@ -0,0 +1,70 @@
+package core
+
+import (
+	"errors"
+	"math"
+	"regexp"
+	"time"
+
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+// isRetryable determines if an ended attempt of a call should be retried according to the call's retry policy
+func isRetryable(
+	attempt model.CallAttempt,
+	retry model.Retry,
+	callEnded *model.CallEnded,
+	err error,
+) bool {
+	if attempt.Number >= attempt.Max {
+		return false
+	}
+
+	if callEnded.Outcome != model.OpOutcomeFailed && callEnded.Outcome != model.OpOutcomeTimedOut {
+		return false
+	}
+
+	if retry.On == nil {
+		// retry on any failure
+		return true
+	}
+
+	if retry.On.ErrorMatches != nil && callEnded.Error != nil {
+		// already validated during interpretation
+		if regexp.MustCompile(*retry.On.ErrorMatches).MatchString(callEnded.Error.Message) {
+			return true
+		}
+	}
+
+	var exitCodeErr nonZeroExitCodeError
+	if errors.As(err, &exitCodeErr) {
+		for _, exitCode := range retry.On.ExitCodes {
+			if exitCode == exitCodeErr.exitCode {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// getRetryDelay gets the delay before the attempt following the provided one
+func getRetryDelay(
+	attempt model.CallAttempt,
+	retry model.Retry,
+) time.Duration {
+	if retry.Delay == nil {
+		return 0
+	}
+
+	// already validated during interpretation
+	delay, _ := time.ParseDuration(*retry.Delay)
+
+	if retry.Backoff == nil {
+		return delay
+	}
+
+	return time.Duration(
+		float64(delay) * math.Pow(*retry.Backoff, float64(attempt.Number-1)),
+	)
+}
@@ -0,0 +1,127 @@
+package core
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+var _ = Context("retry", func() {
+	Context("isRetryable", func() {
+		Context("attempt is last", func() {
+			It("should return false", func() {
+				/* arrange/act/assert */
+				Expect(isRetryable(
+					model.CallAttempt{Number: 2, Max: 2},
+					model.Retry{MaxAttempts: 2},
+					&model.CallEnded{Outcome: model.OpOutcomeFailed},
+					errors.New("dummyError"),
+				)).To(BeFalse())
+			})
+		})
+		Context("outcome is KILLED", func() {
+			It("should return false", func() {
+				/* arrange/act/assert */
+				Expect(isRetryable(
+					model.CallAttempt{Number: 1, Max: 2},
+					model.Retry{MaxAttempts: 2},
+					&model.CallEnded{Outcome: model.OpOutcomeKilled},
+					nil,
+				)).To(BeFalse())
+			})
+		})
+		Context("retry.On nil", func() {
+			It("should return true", func() {
+				/* arrange/act/assert */
+				Expect(isRetryable(
+					model.CallAttempt{Number: 1, Max: 2},
+					model.Retry{MaxAttempts: 2},
+					&model.CallEnded{Outcome: model.OpOutcomeTimedOut},
+					nil,
+				)).To(BeTrue())
+			})
+		})
+		Context("retry.On.ErrorMatches not nil", func() {
+			errorMatches := "connection (reset|refused)"
+			Context("error message matches", func() {
+				It("should return true", func() {
+					/* arrange/act/assert */
+					Expect(isRetryable(
+						model.CallAttempt{Number: 1, Max: 2},
+						model.Retry{MaxAttempts: 2, On: &model.RetryOn{ErrorMatches: &errorMatches}},
+						&model.CallEnded{
+							Error:   &model.CallEndedError{Message: "dial tcp: connection refused"},
+							Outcome: model.OpOutcomeFailed,
+						},
+						nil,
+					)).To(BeTrue())
+				})
+			})
+			Context("error message doesn't match", func() {
+				It("should return false", func() {
+					/* arrange/act/assert */
+					Expect(isRetryable(
+						model.CallAttempt{Number: 1, Max: 2},
+						model.Retry{MaxAttempts: 2, On: &model.RetryOn{ErrorMatches: &errorMatches}},
+						&model.CallEnded{
+							Error:   &model.CallEndedError{Message: "no such file or directory"},
+							Outcome: model.OpOutcomeFailed,
+						},
+						nil,
+					)).To(BeFalse())
+				})
+			})
+		})
+		Context("retry.On.ExitCodes not nil", func() {
+			Context("exit code matches", func() {
+				It("should return true", func() {
+					/* arrange/act/assert */
+					Expect(isRetryable(
+						model.CallAttempt{Number: 1, Max: 2},
+						model.Retry{MaxAttempts: 2, On: &model.RetryOn{ExitCodes: []int64{1, 137}}},
+						&model.CallEnded{Outcome: model.OpOutcomeFailed},
+						nonZeroExitCodeError{exitCode: 137},
+					)).To(BeTrue())
+				})
+			})
+			Context("exit code doesn't match", func() {
+				It("should return false", func() {
+					/* arrange/act/assert */
+					Expect(isRetryable(
+						model.CallAttempt{Number: 1, Max: 2},
+						model.Retry{MaxAttempts: 2, On: &model.RetryOn{ExitCodes: []int64{1, 137}}},
+						&model.CallEnded{Outcome: model.OpOutcomeFailed},
+						nonZeroExitCodeError{exitCode: 2},
+					)).To(BeFalse())
+				})
+			})
+		})
+	})
+	Context("getRetryDelay", func() {
+		Context("retry.Delay nil", func() {
+			It("should return 0", func() {
+				/* arrange/act/assert */
+				Expect(getRetryDelay(
+					model.CallAttempt{Number: 1, Max: 2},
+					model.Retry{MaxAttempts: 2},
+				)).To(Equal(time.Duration(0)))
+			})
+		})
+		Context("retry.Backoff not nil", func() {
+			It("should return expected result", func() {
+				/* arrange */
+				delay := "2s"
+				backoff := float64(3)
+
+				/* act/assert */
+				Expect(getRetryDelay(
+					model.CallAttempt{Number: 3, Max: 4},
+					model.Retry{MaxAttempts: 4, Delay: &delay, Backoff: &backoff},
+				)).To(Equal(18 * time.Second))
+			})
+		})
+	})
+})
@@ -0,0 +1,459 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/opctl/opctl/sdks/go/data/coerce"
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/opspec/opfile"
+	"github.com/opctl/opctl/sdks/go/pubsub"
+)
+
+// maxSecretFileSizeBytes is the max size of secret files whose contents are redacted
+const maxSecretFileSizeBytes = 1e6
+
+// newSecretRedactingPubSub returns a pubsub.PubSub which redacts secrets from events before publishing them to pubSub.
+//
+// Secrets are the values of inputs & outputs of op calls w/ params declaring isSecret. They're tracked per root call &
+// replaced by redactedValue wherever they occur; including w/in values derived from them via interpolation &
+// container std err/out.
+func newSecretRedactingPubSub(
+	ctx context.Context,
+	pubSub pubsub.PubSub,
+) pubsub.PubSub {
+	return &_secretRedactingPubSub{
+		ctx:                 ctx,
+		PubSub:              pubSub,
+		pendingStreamsByKey: map[string]*pendingStream{},
+		secretsByRootCallID: map[string][]string{},
+	}
+}
+
+// pendingStream is the unpublished end of a container std err/out stream which could be the start of a secret
+type pendingStream struct {
+	data []byte
+	// last event of the stream
+	event model.Event
+}
+
+type _secretRedactingPubSub struct {
+	pubsub.PubSub
+	ctx context.Context
+	// synchronize publishing via mutex so chunks of container std err/out are published in order
+	mux                 sync.Mutex
+	pendingStreamsByKey map[string]*pendingStream
+	// secrets are sorted longest first so secrets containing other secrets are redacted whole
+	secretsByRootCallID map[string][]string
+}
+
+func (srps *_secretRedactingPubSub) Publish(
+	event model.Event,
+) {
+	srps.mux.Lock()
+	defer srps.mux.Unlock()
+
+	switch {
+	case event.CallStarted != nil:
+		call := event.CallStarted.Call
+		if call.Op != nil {
+			srps.addSecrets(call.RootID, call.Op.OpPath, call.Op.Inputs, func(opFile *model.OpSpec) map[string]*model.ParamSpec {
+				return opFile.Inputs
+			})
+		}
+	case event.CallEnded != nil:
+		call := event.CallEnded.Call
+		if call.Op != nil {
+			srps.addSecrets(call.RootID, call.Op.OpPath, event.CallEnded.Outputs, func(opFile *model.OpSpec) map[string]*model.ParamSpec {
+				return opFile.Outputs
+			})
+		}
+
+		if call.Container != nil {
+			// streams end w/ their container
+			srps.flushStream(getStdErrStreamKey(call.Container.ContainerID))
+			srps.flushStream(getStdOutStreamKey(call.Container.ContainerID))
+		}
+
+		if call.ID == call.RootID && !event.CallEnded.WillRetry {
+			defer delete(srps.secretsByRootCallID, call.RootID)
+		}
+	case event.ContainerStdErrWrittenTo != nil:
+		writtenTo := *event.ContainerStdErrWrittenTo
+		writtenTo.Data = srps.redactStream(
+			getStdErrStreamKey(writtenTo.ContainerID),
+			srps.secretsByRootCallID[writtenTo.RootCallID],
+			writtenTo.Data,
+			event,
+		)
+		if len(writtenTo.Data) == 0 {
+			return
+		}
+		event.ContainerStdErrWrittenTo = &writtenTo
+		srps.PubSub.Publish(event)
+		return
+	case event.ContainerStdOutWrittenTo != nil:
+		writtenTo := *event.ContainerStdOutWrittenTo
+		writtenTo.Data = srps.redactStream(
+			getStdOutStreamKey(writtenTo.ContainerID),
+			srps.secretsByRootCallID[writtenTo.RootCallID],
+			writtenTo.Data,
+			event,
+		)
+		if len(writtenTo.Data) == 0 {
+			return
+		}
+		event.ContainerStdOutWrittenTo = &writtenTo
+		srps.PubSub.Publish(event)
+		return
+	}
+
+	srps.PubSub.Publish(
+		redactEvent(event, srps.secretsByRootCallID[getRootCallID(event)]),
+	)
+}
+
+// addSecrets adds the values of secret params to the secrets of the root call w/ rootCallID
+func (srps *_secretRedactingPubSub) addSecrets(
+	rootCallID string,
+	opPath string,
+	values map[string]*model.Value,
+	getParamSpecs func(opFile *model.OpSpec) map[string]*model.ParamSpec,
+) {
+	if len(values) == 0 {
+		return
+	}
+
+	opFile, err := opfile.Get(srps.ctx, opPath)
+	if err != nil {
+		// best effort; secrets can't be determined
+		return
+	}
+
+	secrets := srps.secretsByRootCallID[rootCallID]
+	for name, value := range values {
+		if paramSpec := getParamSpecs(opFile)[name]; paramSpec != nil && paramSpec.IsSecret() {
+			for _, secret := range getSecrets(value) {
+				if secret != "" && !containsString(secrets, secret) {
+					secrets = append(secrets, secret)
+				}
+			}
+		}
+	}
+
+	// longest first
+	sort.SliceStable(secrets, func(i, j int) bool {
+		return len(secrets[i]) > len(secrets[j])
+	})
+
+	srps.secretsByRootCallID[rootCallID] = secrets
+}
+
+// flushStream publishes the pending end of the stream w/ streamKey (if any)
+func (srps *_secretRedactingPubSub) flushStream(
+	streamKey string,
+) {
+	stream, ok := srps.pendingStreamsByKey[streamKey]
+	if !ok {
+		return
+	}
+	delete(srps.pendingStreamsByKey, streamKey)
+
+	event := stream.event
+	if event.ContainerStdErrWrittenTo != nil {
+		writtenTo := *event.ContainerStdErrWrittenTo
+		writtenTo.Data = stream.data
+		event.ContainerStdErrWrittenTo = &writtenTo
+	}
+	if event.ContainerStdOutWrittenTo != nil {
+		writtenTo := *event.ContainerStdOutWrittenTo
+		writtenTo.Data = stream.data
+		event.ContainerStdOutWrittenTo = &writtenTo
+	}
+
+	srps.PubSub.Publish(event)
+}
+
+// redactStream returns the redacted data of a chunk of the stream w/ streamKey which can be published.
+//
+// Since secrets can be split across chunks, the end of data which could be the start of a secret is held back
+// until the next chunk of the stream or the stream ends.
+func (srps *_secretRedactingPubSub) redactStream(
+	streamKey string,
+	secrets []string,
+	data []byte,
+	event model.Event,
+) []byte {
+	if stream, ok := srps.pendingStreamsByKey[streamKey]; ok {
+		data = append(stream.data, data...)
+		delete(srps.pendingStreamsByKey, streamKey)
+	}
+
+	if len(secrets) == 0 {
+		return data
+	}
+
+	for _, secret := range secrets {
+		data = bytes.ReplaceAll(data, []byte(secret), []byte(redactedValue))
+	}
+
+	pendingLength := 0
+	for _, secret := range secrets {
+		for length := len(secret) - 1; length > pendingLength; length-- {
+			if length <= len(data) && bytes.HasSuffix(data, []byte(secret[:length])) {
+				pendingLength = length
+				break
+			}
+		}
+	}
+
+	if pendingLength > 0 {
+		srps.pendingStreamsByKey[streamKey] = &pendingStream{
+			data:  append([]byte{}, data[len(data)-pendingLength:]...),
+			event: event,
+		}
+	}
+
+	return data[:len(data)-pendingLength]
+}
+
+// redactEvent returns a copy of event w/ secrets replaced by redactedValue.
+//
+// Only fields carrying values are redacted; ids & paths are left as is since short secrets
+// could otherwise corrupt them.
+func redactEvent(
+	event model.Event,
+	secrets []string,
+) model.Event {
+	if len(secrets) == 0 {
+		return event
+	}
+
+	// deep copy so values shared w/ callers aren't mutated
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		return event
+	}
+	redactedEvent := model.Event{}
+	if err := json.Unmarshal(eventBytes, &redactedEvent); err != nil {
+		return event
+	}
+
+	redact := func(s string) string {
+		for _, secret := range secrets {
+			s = strings.ReplaceAll(s, secret, redactedValue)
+		}
+		return s
+	}
+
+	switch {
+	case redactedEvent.CallEnded != nil:
+		redactCall(&redactedEvent.CallEnded.Call, redact)
+		redactValues(redactedEvent.CallEnded.Outputs, redact)
+		if redactedEvent.CallEnded.Error != nil {
+			redactedEvent.CallEnded.Error.Message = redact(redactedEvent.CallEnded.Error.Message)
+		}
+	case redactedEvent.CallPending != nil:
+		redactCall(&redactedEvent.CallPending.Call, redact)
+	case redactedEvent.CallStarted != nil:
+		redactCall(&redactedEvent.CallStarted.Call, redact)
+	}
+
+	return redactedEvent
+}
+
+// redactCall applies redact to the values of call; op inputs & container cmd & env vars
+func redactCall(
+	call *model.Call,
+	redact func(s string) string,
+) {
+	if call.Op != nil {
+		redactValues(call.Op.Inputs, redact)
+	}
+
+	if call.Container != nil {
+		for i, arg := range call.Container.Cmd {
+			call.Container.Cmd[i] = redact(arg)
+		}
+		for name, value := range call.Container.EnvVars {
+			call.Container.EnvVars[name] = redact(value)
+		}
+	}
+}
+
+// redactValues applies redact to the strings w/in values; dir, file & socket paths aren't values so are left as is
+func redactValues(
+	values map[string]*model.Value,
+	redact func(s string) string,
+) {
+	for _, value := range values {
+		if value == nil {
+			continue
+		}
+		if value.String != nil {
+			redactedString := redact(*value.String)
+			value.String = &redactedString
+		}
+		if value.Array != nil {
+			redactStrings(reflect.ValueOf(value.Array), redact)
+		}
+		if value.Object != nil {
+			redactStrings(reflect.ValueOf(value.Object), redact)
+		}
+	}
+}
+
+// redactStrings applies redact to all strings reachable from value
+func redactStrings(
+	value reflect.Value,
+	redact func(s string) string,
+) {
+	switch value.Kind() {
+	case reflect.Ptr:
+		if !value.IsNil() {
+			redactStrings(value.Elem(), redact)
+		}
+	case reflect.Interface:
+		if value.IsNil() {
+			return
+		}
+		elem := reflect.New(value.Elem().Type()).Elem()
+		elem.Set(value.Elem())
+		redactStrings(elem, redact)
+		if value.CanSet() {
+			value.Set(elem)
+		}
+	case reflect.Map:
+		for _, key := range value.MapKeys() {
+			elem := reflect.New(value.Type().Elem()).Elem()
+			elem.Set(value.MapIndex(key))
+			redactStrings(elem, redact)
+			value.SetMapIndex(key, elem)
+		}
+	case reflect.Slice:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			// bytes aren't strings
+			return
+		}
+		for i := 0; i < value.Len(); i++ {
+			redactStrings(value.Index(i), redact)
+		}
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			if value.Type().Field(i).PkgPath != "" {
+				// unexported
+				continue
+			}
+			redactStrings(value.Field(i), redact)
+		}
+	case reflect.String:
+		if value.CanSet() {
+			value.SetString(redact(value.String()))
+		}
+	}
+}
+
+// getSecrets gets the strings a secret value could appear as
+func getSecrets(
+	value *model.Value,
+) []string {
+	if value == nil {
+		return nil
+	}
+
+	if value.File != nil {
+		if fileInfo, err := os.Stat(*value.File); err != nil || fileInfo.Size() > maxSecretFileSizeBytes {
+			return nil
+		}
+	}
+
+	secrets := []string{}
+	if stringValue, err := coerce.ToString(value); err == nil {
+		secrets = append(secrets, *stringValue.String)
+		if value.File != nil {
+			// files commonly end w/ a newline which won't be present once interpolated into other values
+			secrets = append(secrets, strings.TrimRight(*stringValue.String, "\r\n"))
+		}
+	}
+
+	if value.Array != nil || value.Object != nil {
+		if nativeValue, err := value.Unbox(); err == nil {
+			secrets = append(secrets, getNativeSecrets(nativeValue)...)
+		}
+	}
+
+	return secrets
+}
+
+// getNativeSecrets gets the strings & numbers w/in a native array/object
+func getNativeSecrets(
+	nativeValue interface{},
+) []string {
+	switch typedValue := nativeValue.(type) {
+	case string:
+		return []string{typedValue}
+	case float64:
+		return []string{strconv.FormatFloat(typedValue, 'f', -1, 64)}
+	case []interface{}:
+		secrets := []string{}
+		for _, item := range typedValue {
+			secrets = append(secrets, getNativeSecrets(item)...)
+		}
+		return secrets
+	case map[string]interface{}:
+		secrets := []string{}
+		for _, property := range typedValue {
+			secrets = append(secrets, getNativeSecrets(property)...)
+		}
+		return secrets
+	}
+	return nil
+}
+
+func getRootCallID(
+	event model.Event,
+) string {
+	switch {
+	case event.CallEnded != nil:
+		return event.CallEnded.Call.RootID
+	case event.CallKillRequested != nil:
+		return event.CallKillRequested.Request.RootCallID
+	case event.CallOutputsConflicted != nil:
+		return event.CallOutputsConflicted.RootCallID
+	case event.CallPending != nil:
+		return event.CallPending.Call.RootID
+	case event.CallReady != nil:
+		return event.CallReady.RootCallID
+	case event.CallStarted != nil:
+		return event.CallStarted.Call.RootID
+	case event.ContainerStdErrWrittenTo != nil:
+		return event.ContainerStdErrWrittenTo.RootCallID
+	case event.ContainerStdOutWrittenTo != nil:
+		return event.ContainerStdOutWrittenTo.RootCallID
+	}
+	return ""
+}
+
+func getStdErrStreamKey(containerID string) string {
+	return "stdErr_" + containerID
+}
+
+func getStdOutStreamKey(containerID string) string {
+	return "stdOut_" + containerID
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
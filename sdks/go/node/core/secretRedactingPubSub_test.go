@@ -0,0 +1,223 @@
+package core
+
+import (
+	"context"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/pubsub/fakes"
+)
+
+var _ = Context("secretRedactingPubSub", func() {
+	opPath, err := filepath.Abs("testdata/secretRedactingPubSub")
+	if err != nil {
+		panic(err)
+	}
+	rootCallID := "rootCallID"
+	password := "s3cret"
+	username := "username"
+
+	// publishRootCallStarted publishes the start of an op w/ a secret input
+	publishRootCallStarted := func(objectUnderTest *_secretRedactingPubSub) {
+		objectUnderTest.Publish(model.Event{
+			CallStarted: &model.CallStarted{
+				Call: model.Call{
+					ID: rootCallID,
+					Op: &model.OpCall{
+						BaseCall: model.BaseCall{OpPath: opPath},
+						Inputs: map[string]*model.Value{
+							"password": {String: &password},
+							"username": {String: &username},
+						},
+					},
+					RootID: rootCallID,
+				},
+			},
+		})
+	}
+
+	Context("Publish", func() {
+		It("should redact secret inputs & values derived from them", func() {
+			/* arrange */
+			fakePubSub := new(fakes.FakePubSub)
+			objectUnderTest := newSecretRedactingPubSub(context.Background(), fakePubSub).(*_secretRedactingPubSub)
+
+			containerCall := model.Call{
+				Container: &model.ContainerCall{
+					Cmd: []string{"login", username + ":" + password},
+					EnvVars: map[string]string{
+						"PASSWORD": password,
+					},
+				},
+				ID:     "containerCallID",
+				RootID: rootCallID,
+			}
+
+			/* act */
+			publishRootCallStarted(objectUnderTest)
+			objectUnderTest.Publish(model.Event{
+				CallStarted: &model.CallStarted{
+					Call: containerCall,
+				},
+			})
+
+			/* assert */
+			actualOpCall := fakePubSub.PublishArgsForCall(0).CallStarted.Call.Op
+			Expect(*actualOpCall.Inputs["password"].String).To(Equal(redactedValue))
+			Expect(*actualOpCall.Inputs["username"].String).To(Equal(username))
+
+			actualContainerCall := fakePubSub.PublishArgsForCall(1).CallStarted.Call.Container
+			Expect(actualContainerCall.Cmd).To(Equal([]string{"login", username + ":" + redactedValue}))
+			Expect(actualContainerCall.EnvVars).To(Equal(map[string]string{"PASSWORD": redactedValue}))
+
+			// provided event shouldn't be mutated
+			Expect(containerCall.Container.EnvVars["PASSWORD"]).To(Equal(password))
+		})
+		It("should redact secret outputs", func() {
+			/* arrange */
+			fakePubSub := new(fakes.FakePubSub)
+			objectUnderTest := newSecretRedactingPubSub(context.Background(), fakePubSub).(*_secretRedactingPubSub)
+
+			token := "token"
+
+			/* act */
+			objectUnderTest.Publish(model.Event{
+				CallEnded: &model.CallEnded{
+					Call: model.Call{
+						ID: rootCallID,
+						Op: &model.OpCall{
+							BaseCall: model.BaseCall{OpPath: opPath},
+						},
+						RootID: rootCallID,
+					},
+					Outputs: map[string]*model.Value{
+						"token": {String: &token},
+					},
+				},
+			})
+
+			/* assert */
+			Expect(*fakePubSub.PublishArgsForCall(0).CallEnded.Outputs["token"].String).To(Equal(redactedValue))
+		})
+		It("should not redact ids & paths containing secrets", func() {
+			/* arrange */
+			fakePubSub := new(fakes.FakePubSub)
+			objectUnderTest := newSecretRedactingPubSub(context.Background(), fakePubSub).(*_secretRedactingPubSub)
+
+			// short secrets are commonly w/in hex ids
+			shortPassword := "ab"
+			shortRootCallID := "ab12cd"
+			shortContainerCallID := "34ab56"
+			filePath := "/tmp/ab/file"
+
+			/* act */
+			objectUnderTest.Publish(model.Event{
+				CallStarted: &model.CallStarted{
+					Call: model.Call{
+						ID: shortRootCallID,
+						Op: &model.OpCall{
+							BaseCall: model.BaseCall{OpPath: opPath},
+							Inputs: map[string]*model.Value{
+								"password": {String: &shortPassword},
+							},
+						},
+						RootID: shortRootCallID,
+					},
+				},
+			})
+			objectUnderTest.Publish(model.Event{
+				CallEnded: &model.CallEnded{
+					Call: model.Call{
+						Container: &model.ContainerCall{
+							ContainerID: shortContainerCallID,
+						},
+						ID:       shortContainerCallID,
+						ParentID: &shortRootCallID,
+						RootID:   shortRootCallID,
+					},
+					Error: &model.CallEndedError{
+						Message: "invalid password: " + shortPassword,
+					},
+					Outputs: map[string]*model.Value{
+						"file": {File: &filePath},
+					},
+				},
+			})
+
+			/* assert */
+			actualStartedCall := fakePubSub.PublishArgsForCall(0).CallStarted.Call
+			Expect(actualStartedCall.ID).To(Equal(shortRootCallID))
+			Expect(actualStartedCall.RootID).To(Equal(shortRootCallID))
+			Expect(*actualStartedCall.Op.Inputs["password"].String).To(Equal(redactedValue))
+
+			actualCallEnded := fakePubSub.PublishArgsForCall(1).CallEnded
+			Expect(actualCallEnded.Call.ID).To(Equal(shortContainerCallID))
+			Expect(*actualCallEnded.Call.ParentID).To(Equal(shortRootCallID))
+			Expect(actualCallEnded.Call.RootID).To(Equal(shortRootCallID))
+			Expect(actualCallEnded.Call.Container.ContainerID).To(Equal(shortContainerCallID))
+			Expect(*actualCallEnded.Outputs["file"].File).To(Equal(filePath))
+			Expect(actualCallEnded.Error.Message).To(Equal("invalid password: " + redactedValue))
+		})
+		It("should redact secrets split across chunks of container std out", func() {
+			/* arrange */
+			fakePubSub := new(fakes.FakePubSub)
+			objectUnderTest := newSecretRedactingPubSub(context.Background(), fakePubSub).(*_secretRedactingPubSub)
+
+			containerID := "containerID"
+			publishStdOut := func(data string) {
+				objectUnderTest.Publish(model.Event{
+					ContainerStdOutWrittenTo: &model.ContainerStdOutWrittenTo{
+						ContainerID: containerID,
+						Data:        []byte(data),
+						RootCallID:  rootCallID,
+					},
+				})
+			}
+
+			/* act */
+			publishRootCallStarted(objectUnderTest)
+			publishStdOut("a s3")
+			publishStdOut("cret b s3")
+			objectUnderTest.Publish(model.Event{
+				CallEnded: &model.CallEnded{
+					Call: model.Call{
+						Container: &model.ContainerCall{ContainerID: containerID},
+						ID:        containerID,
+						RootID:    rootCallID,
+					},
+				},
+			})
+
+			/* assert */
+			actualData := []string{}
+			for i := 1; i < fakePubSub.PublishCallCount(); i++ {
+				if writtenTo := fakePubSub.PublishArgsForCall(i).ContainerStdOutWrittenTo; writtenTo != nil {
+					actualData = append(actualData, string(writtenTo.Data))
+				}
+			}
+			Expect(actualData).To(Equal([]string{"a ", redactedValue + " b ", "s3"}))
+			Expect(fakePubSub.PublishArgsForCall(fakePubSub.PublishCallCount() - 1).CallEnded).NotTo(BeNil())
+		})
+		It("should stop redacting once root call ends", func() {
+			/* arrange */
+			fakePubSub := new(fakes.FakePubSub)
+			objectUnderTest := newSecretRedactingPubSub(context.Background(), fakePubSub).(*_secretRedactingPubSub)
+
+			/* act */
+			publishRootCallStarted(objectUnderTest)
+			objectUnderTest.Publish(model.Event{
+				CallEnded: &model.CallEnded{
+					Call: model.Call{
+						ID:     rootCallID,
+						RootID: rootCallID,
+					},
+				},
+			})
+
+			/* assert */
+			Expect(objectUnderTest.secretsByRootCallID).To(BeEmpty())
+		})
+	})
+})
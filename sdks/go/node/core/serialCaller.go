@@ -3,10 +3,12 @@ package core
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/opctl/opctl/sdks/go/internal/uniquestring"
 	"github.com/opctl/opctl/sdks/go/model"
+	callpkg "github.com/opctl/opctl/sdks/go/opspec/interpreter/call"
 	"github.com/opctl/opctl/sdks/go/pubsub"
 )
 
@@ -20,6 +22,21 @@ type serialCaller interface {
 		rootCallID string,
 		opPath string,
 		callSpecSerialCall []*model.CallSpec,
+		callSpecFinally []*model.CallSpec,
+	) (
+		map[string]*model.Value,
+		error,
+	)
+
+	// Executes finally calls of a call which ended w/ callErr
+	CallFinally(
+		ctx context.Context,
+		callID string,
+		scope map[string]*model.Value,
+		callErr error,
+		rootCallID string,
+		opPath string,
+		callSpecFinally []*model.CallSpec,
 	) (
 		map[string]*model.Value,
 		error,
@@ -50,6 +67,140 @@ func (sc _serialCaller) Call(
 	rootCallID string,
 	opPath string,
 	callSpecSerialCall []*model.CallSpec,
+	callSpecFinally []*model.CallSpec,
+) (
+	map[string]*model.Value,
+	error,
+) {
+	outputs, err := sc.callChildren(
+		ctx,
+		callID,
+		inboundScope,
+		rootCallID,
+		opPath,
+		callSpecSerialCall,
+	)
+	if len(callSpecFinally) == 0 {
+		if err != nil {
+			return nil, err
+		}
+		return outputs, nil
+	}
+
+	finallyOutputs, finallyErr := sc.CallFinally(
+		ctx,
+		callID,
+		outputs,
+		err,
+		rootCallID,
+		opPath,
+		callSpecFinally,
+	)
+	if err != nil {
+		// failure of the serial call takes precedence over failure of its finally calls
+		return nil, err
+	}
+	if finallyErr != nil {
+		return nil, finallyErr
+	}
+
+	return finallyOutputs, nil
+}
+
+// CallFinally calls finally calls in serial w/ the outcome (& error) of the call bound in scope.
+// They're called even if ctx is done; killing the call again ends them.
+func (sc _serialCaller) CallFinally(
+	ctx context.Context,
+	callID string,
+	scope map[string]*model.Value,
+	callErr error,
+	rootCallID string,
+	opPath string,
+	callSpecFinally []*model.CallSpec,
+) (
+	map[string]*model.Value,
+	error,
+) {
+	// outcome & error can't be bound over variables defined in scope
+	for _, varName := range []string{callpkg.FinallyOutcomeVarName, callpkg.FinallyErrorVarName} {
+		if _, ok := scope[varName]; ok {
+			return nil, fmt.Errorf("unable to call finally: '%s' already defined; finally calls bind it", varName)
+		}
+	}
+
+	outcome := model.OpOutcomeSucceeded
+	switch {
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		outcome = model.OpOutcomeTimedOut
+	case ctx.Err() != nil:
+		outcome = model.OpOutcomeKilled
+	case callErr != nil:
+		outcome = model.OpOutcomeFailed
+	}
+
+	finallyScope := map[string]*model.Value{}
+	for varName, varData := range scope {
+		finallyScope[varName] = varData
+	}
+	finallyScope[callpkg.FinallyOutcomeVarName] = &model.Value{String: &outcome}
+	if callErr != nil {
+		callErrMessage := callErr.Error()
+		finallyScope[callpkg.FinallyErrorVarName] = &model.Value{String: &callErrMessage}
+	}
+
+	finallyCtx := ctx
+	if ctx.Err() != nil {
+		var cancelFinally context.CancelFunc
+		finallyCtx, cancelFinally = context.WithCancel(detachedContext{ctx})
+		defer cancelFinally()
+
+		finallyStartTime := time.Now().UTC()
+		// @TODO: handle err channel
+		eventChannel, _ := sc.pubSub.Subscribe(
+			finallyCtx,
+			model.EventFilter{
+				Roots: []string{rootCallID},
+				Since: &finallyStartTime,
+			},
+		)
+
+		go func() {
+			for event := range eventChannel {
+				// kill requests cascading from a kill before finally calls started keep the timestamp of that kill
+				if event.CallKillRequested != nil &&
+					event.CallKillRequested.Request.OpID == callID &&
+					!event.Timestamp.Before(finallyStartTime) {
+					cancelFinally()
+					return
+				}
+			}
+		}()
+	}
+
+	outputs, err := sc.callChildren(
+		finallyCtx,
+		callID,
+		finallyScope,
+		rootCallID,
+		opPath,
+		callSpecFinally,
+	)
+
+	// bound outcome & error are only in scope of finally calls
+	delete(outputs, callpkg.FinallyOutcomeVarName)
+	delete(outputs, callpkg.FinallyErrorVarName)
+
+	return outputs, err
+}
+
+// callChildren calls each child in serial until one fails; outputs of children called so far are returned regardless
+func (sc _serialCaller) callChildren(
+	ctx context.Context,
+	callID string,
+	inboundScope map[string]*model.Value,
+	rootCallID string,
+	opPath string,
+	callSpecSerialCall []*model.CallSpec,
 ) (
 	map[string]*model.Value,
 	error,
@@ -76,10 +227,11 @@ func (sc _serialCaller) Call(
 		childCallID, err := uniquestring.Construct()
 		if err != nil {
 			// end run immediately on any error
-			return nil, err
+			return outputs, err
 		}
 
-		sc.caller.Call(
+		// use returned outputs rather than those of events; events have secrets redacted
+		childCallOutputs, _ := sc.caller.Call(
 			ctx,
 			childCallID,
 			outputs,
@@ -93,12 +245,12 @@ func (sc _serialCaller) Call(
 		for event := range eventChannel {
 			// merge child outboundScope w/ outboundScope, child outboundScope having precedence
 			switch {
-			case event.CallEnded != nil && event.CallEnded.Call.ID == childCallID:
-				if event.CallEnded.Error != nil {
+			case event.CallEnded != nil && event.CallEnded.Call.ID == childCallID && !event.CallEnded.WillRetry:
+				if event.CallEnded.Error != nil && !event.CallEnded.Call.ContinueOnError {
 					// end on any error
-					return nil, errors.New(event.CallEnded.Error.Message)
+					return outputs, errors.New(event.CallEnded.Error.Message)
 				}
-				for name, value := range event.CallEnded.Outputs {
+				for name, value := range childCallOutputs {
 					outputs[name] = value
 				}
 				break eventLoop
@@ -50,7 +50,9 @@ var _ = Context("serialCaller", func() {
 				objectUnderTest := _serialCaller{
 					caller: newCaller(
 						newContainerCaller(
+							newContainerCache(dbDir),
 							new(containerRuntimeFakes.FakeContainerRuntime),
+							dbDir,
 							pubSub,
 							newStateStore(
 								context.Background(),
@@ -60,6 +62,7 @@ var _ = Context("serialCaller", func() {
 						),
 						dbDir,
 						pubSub,
+						OutputConflictPolicyFail,
 					),
 					pubSub: pubSub,
 				}
@@ -77,12 +80,262 @@ var _ = Context("serialCaller", func() {
 							Container: &model.ContainerCallSpec{},
 						},
 					},
+					nil,
 				)
 
 				/* assert */
 				Expect(actualErr).To(MatchError("image required"))
 			})
 		})
+		Context("finally & continueOnError", func() {
+			newObjectUnderTest := func(
+				fakeContainerRuntime *containerRuntimeFakes.FakeContainerRuntime,
+			) _serialCaller {
+				dbDir, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				db, err := badger.Open(
+					badger.DefaultOptions(dbDir).WithLogger(nil),
+				)
+				if err != nil {
+					panic(err)
+				}
+				pubSub := pubsub.New(db)
+
+				return _serialCaller{
+					caller: newCaller(
+						newContainerCaller(
+							newContainerCache(dbDir),
+							fakeContainerRuntime,
+							dbDir,
+							pubSub,
+							newStateStore(
+								context.Background(),
+								db,
+								pubSub,
+							),
+						),
+						dbDir,
+						pubSub,
+						OutputConflictPolicyFail,
+					),
+					pubSub: pubSub,
+				}
+			}
+			newContainerCallSpec := func(cmd string) *model.ContainerCallSpec {
+				return &model.ContainerCallSpec{
+					Cmd:   []interface{}{cmd},
+					Image: &model.ContainerCallImageSpec{Ref: "dummyImageRef"},
+				}
+			}
+			finallyCallSpecs := []*model.CallSpec{
+				{
+					Container: &model.ContainerCallSpec{
+						Cmd: []interface{}{"finally"},
+						EnvVars: map[string]interface{}{
+							"OUTCOME": "$(outcome)",
+						},
+						Image: &model.ContainerCallImageSpec{Ref: "dummyImageRef"},
+					},
+				},
+			}
+
+			Context("child fails", func() {
+				It("should call finally calls w/ outcome in scope & return the child's error", func() {
+					/* arrange */
+					var actualFinallyEnvVars map[string]string
+
+					fakeContainerRuntime := new(containerRuntimeFakes.FakeContainerRuntime)
+					fakeContainerRuntime.RunContainerStub = func(
+						ctx context.Context,
+						req *model.ContainerCall,
+						rootCallID string,
+						eventPublisher pubsub.EventPublisher,
+						stdin io.Reader,
+						stdOut io.WriteCloser,
+						stdErr io.WriteCloser,
+					) (*int64, error) {
+						stdErr.Close()
+						stdOut.Close()
+
+						if req.Cmd[0] == "finally" {
+							actualFinallyEnvVars = req.EnvVars
+							return nil, nil
+						}
+
+						exitCode := int64(1)
+						return &exitCode, nil
+					}
+
+					objectUnderTest := newObjectUnderTest(fakeContainerRuntime)
+
+					/* act */
+					_, actualErr := objectUnderTest.Call(
+						context.Background(),
+						"callID",
+						map[string]*model.Value{},
+						"rootCallID",
+						"opPath",
+						[]*model.CallSpec{
+							{Container: newContainerCallSpec("first")},
+							{Container: newContainerCallSpec("second")},
+						},
+						finallyCallSpecs,
+					)
+
+					/* assert */
+					Expect(actualErr).To(MatchError("nonzero container exit code: 1"))
+					// second never called
+					Expect(fakeContainerRuntime.RunContainerCallCount()).To(Equal(2))
+					Expect(actualFinallyEnvVars).To(Equal(map[string]string{"OUTCOME": model.OpOutcomeFailed}))
+				})
+			})
+
+			Context("killed", func() {
+				It("should call finally calls w/ outcome in scope", func() {
+					/* arrange */
+					ctx, cancel := context.WithCancel(context.Background())
+					var actualFinallyEnvVars map[string]string
+
+					fakeContainerRuntime := new(containerRuntimeFakes.FakeContainerRuntime)
+					fakeContainerRuntime.RunContainerStub = func(
+						ctx context.Context,
+						req *model.ContainerCall,
+						rootCallID string,
+						eventPublisher pubsub.EventPublisher,
+						stdin io.Reader,
+						stdOut io.WriteCloser,
+						stdErr io.WriteCloser,
+					) (*int64, error) {
+						stdErr.Close()
+						stdOut.Close()
+
+						if req.Cmd[0] == "finally" {
+							actualFinallyEnvVars = req.EnvVars
+							return nil, nil
+						}
+
+						cancel()
+						<-ctx.Done()
+						return nil, ctx.Err()
+					}
+
+					objectUnderTest := newObjectUnderTest(fakeContainerRuntime)
+
+					/* act */
+					objectUnderTest.Call(
+						ctx,
+						"callID",
+						map[string]*model.Value{},
+						"rootCallID",
+						"opPath",
+						[]*model.CallSpec{
+							{Container: newContainerCallSpec("first")},
+						},
+						finallyCallSpecs,
+					)
+
+					/* assert */
+					Expect(fakeContainerRuntime.RunContainerCallCount()).To(Equal(2))
+					Expect(actualFinallyEnvVars).To(Equal(map[string]string{"OUTCOME": model.OpOutcomeKilled}))
+				})
+			})
+
+			Context("child binds outcome", func() {
+				It("should return expected error & not call finally calls", func() {
+					/* arrange */
+					fakeContainerRuntime := new(containerRuntimeFakes.FakeContainerRuntime)
+					fakeContainerRuntime.RunContainerStub = func(
+						ctx context.Context,
+						req *model.ContainerCall,
+						rootCallID string,
+						eventPublisher pubsub.EventPublisher,
+						stdin io.Reader,
+						stdOut io.WriteCloser,
+						stdErr io.WriteCloser,
+					) (*int64, error) {
+						stdErr.Close()
+						stdOut.Close()
+						return nil, nil
+					}
+
+					childCallSpec := newContainerCallSpec("first")
+					outcomeRef := "$(outcome)"
+					childCallSpec.StdOut = &model.ContainerCallStdioSpec{
+						String: &outcomeRef,
+					}
+
+					objectUnderTest := newObjectUnderTest(fakeContainerRuntime)
+
+					/* act */
+					_, actualErr := objectUnderTest.Call(
+						context.Background(),
+						"callID",
+						map[string]*model.Value{},
+						"rootCallID",
+						"opPath",
+						[]*model.CallSpec{
+							{Container: childCallSpec},
+						},
+						finallyCallSpecs,
+					)
+
+					/* assert */
+					Expect(actualErr).To(MatchError("unable to call finally: 'outcome' already defined; finally calls bind it"))
+					Expect(fakeContainerRuntime.RunContainerCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("child fails w/ continueOnError", func() {
+				It("should call following children & not return an error", func() {
+					/* arrange */
+					fakeContainerRuntime := new(containerRuntimeFakes.FakeContainerRuntime)
+					fakeContainerRuntime.RunContainerStub = func(
+						ctx context.Context,
+						req *model.ContainerCall,
+						rootCallID string,
+						eventPublisher pubsub.EventPublisher,
+						stdin io.Reader,
+						stdOut io.WriteCloser,
+						stdErr io.WriteCloser,
+					) (*int64, error) {
+						stdErr.Close()
+						stdOut.Close()
+
+						if req.Cmd[0] == "first" {
+							exitCode := int64(1)
+							return &exitCode, nil
+						}
+						return nil, nil
+					}
+
+					objectUnderTest := newObjectUnderTest(fakeContainerRuntime)
+
+					/* act */
+					_, actualErr := objectUnderTest.Call(
+						context.Background(),
+						"callID",
+						map[string]*model.Value{},
+						"rootCallID",
+						"opPath",
+						[]*model.CallSpec{
+							{
+								Container:       newContainerCallSpec("first"),
+								ContinueOnError: true,
+							},
+							{Container: newContainerCallSpec("second")},
+						},
+						nil,
+					)
+
+					/* assert */
+					Expect(actualErr).To(BeNil())
+					Expect(fakeContainerRuntime.RunContainerCallCount()).To(Equal(2))
+				})
+			})
+		})
 		It("should start each child as expected", func() {
 			/* arrange */
 			dbDir, err := ioutil.TempDir("", "")
@@ -120,6 +373,7 @@ var _ = Context("serialCaller", func() {
 				req *model.ContainerCall,
 				rootCallID string,
 				eventPublisher pubsub.EventPublisher,
+				stdin io.Reader,
 				stdOut io.WriteCloser,
 				stdErr io.WriteCloser,
 			) (*int64, error) {
@@ -148,7 +402,9 @@ var _ = Context("serialCaller", func() {
 			objectUnderTest := _serialCaller{
 				caller: newCaller(
 					newContainerCaller(
+						newContainerCache(dbDir),
 						fakeContainerRuntime,
+						dbDir,
 						pubSub,
 						newStateStore(
 							ctx,
@@ -158,6 +414,7 @@ var _ = Context("serialCaller", func() {
 					),
 					dbDir,
 					pubSub,
+					OutputConflictPolicyFail,
 				),
 				pubSub: pubSub,
 			}
@@ -190,6 +447,7 @@ var _ = Context("serialCaller", func() {
 						},
 					},
 				},
+				nil,
 			)
 
 			/* assert */
@@ -60,6 +60,8 @@ func (lpr _serialLoopCaller) Call(
 ) {
 	outboundScope := map[string]*model.Value{}
 	var callSerialLoop *model.SerialLoopCall
+	// use returned outputs rather than those of events; events have secrets redacted
+	var iterationOutputs []map[string]*model.Value
 
 	index := 0
 	outboundScope, err := iteration.Scope(
@@ -90,7 +92,7 @@ func (lpr _serialLoopCaller) Call(
 			return nil, err
 		}
 
-		lpr.caller.Call(
+		childCallOutputs, _ := lpr.caller.Call(
 			ctx,
 			callID,
 			outboundScope,
@@ -114,14 +116,15 @@ func (lpr _serialLoopCaller) Call(
 		for event := range eventChannel {
 			// merge child outboundScope w/ outboundScope, child outboundScope having precedence
 			switch {
-			case event.CallEnded != nil && event.CallEnded.Call.ID == callID:
-				if event.CallEnded.Error != nil {
+			case event.CallEnded != nil && event.CallEnded.Call.ID == callID && !event.CallEnded.WillRetry:
+				if event.CallEnded.Error != nil && !event.CallEnded.Call.ContinueOnError {
 					err = errors.New(event.CallEnded.Error.Message)
 					return nil, err
 				}
-				for name, value := range event.CallEnded.Outputs {
+				for name, value := range childCallOutputs {
 					outboundScope[name] = value
 				}
+				iterationOutputs = append(iterationOutputs, childCallOutputs)
 				break eventLoop
 			}
 		}
@@ -154,9 +157,11 @@ func (lpr _serialLoopCaller) Call(
 
 	outboundScope = loop.DeScope(
 		inboundScope,
-		callSpecSerialLoop.Range,
+		callSerialLoop.Range,
 		callSpecSerialLoop.Vars,
+		callSpecSerialLoop.Collect,
 		outboundScope,
+		iterationOutputs,
 	)
 
 	return outboundScope, err
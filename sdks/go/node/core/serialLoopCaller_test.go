@@ -114,7 +114,9 @@ var _ = Context("serialLoopCaller", func() {
 
 					caller := newCaller(
 						newContainerCaller(
+							newContainerCache(dbDir),
 							new(containerRuntimeFakes.FakeContainerRuntime),
+							dbDir,
 							pubSub,
 							newStateStore(
 								context.Background(),
@@ -124,6 +126,7 @@ var _ = Context("serialLoopCaller", func() {
 						),
 						dbDir,
 						pubSub,
+						OutputConflictPolicyFail,
 					)
 
 					objectUnderTest := _serialLoopCaller{
@@ -183,6 +186,7 @@ var _ = Context("serialLoopCaller", func() {
 					req *model.ContainerCall,
 					rootCallID string,
 					eventPublisher pubsub.EventPublisher,
+					stdin io.Reader,
 					stdOut io.WriteCloser,
 					stdErr io.WriteCloser,
 				) (*int64, error) {
@@ -204,7 +208,9 @@ var _ = Context("serialLoopCaller", func() {
 				objectUnderTest := _serialLoopCaller{
 					caller: newCaller(
 						newContainerCaller(
+							newContainerCache(dbDir),
 							fakeContainerRuntime,
+							dbDir,
 							pubSub,
 							newStateStore(
 								ctx,
@@ -214,6 +220,7 @@ var _ = Context("serialLoopCaller", func() {
 						),
 						dbDir,
 						pubSub,
+						OutputConflictPolicyFail,
 					),
 					pubSub: pubSub,
 				}
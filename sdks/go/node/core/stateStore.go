@@ -3,6 +3,7 @@ package core
 import (
 	"context"
 	"encoding/json"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -18,16 +19,25 @@ import (
 // State is materialized by applying events in the order in which they are received.
 //
 // efficient startup:
-// A lastAppliedEventTimestamp is maintained and used at startup to pickup applying events
+// A lastAppliedEventSequence is maintained and used at startup to pickup applying events
 // from where we left off.
 type stateStore interface {
-	// lists all calls w/ parentID
-	ListWithParentID(parentID string) []*model.Call
+	// lists all calls w/ parentID which started before startedBefore
+	ListWithParentID(parentID string, startedBefore time.Time) []*model.Call
 
 	TryGet(id string) *model.Call
 
 	// TryGetCreds returns creds for a ref if any exist
 	TryGetAuth(resource string) *model.Auth
+
+	// ListOps lists summaries of all ops (root calls); most recently started first
+	ListOps() ([]*model.OpSummary, error)
+
+	// GetOp gets the summary of an op (root call)
+	//
+	// expected errs:
+	//  - ErrOpNotFound if no op w/ id exists
+	GetOp(id string) (*model.OpSummary, error)
 }
 
 func newStateStore(
@@ -37,26 +47,24 @@ func newStateStore(
 ) stateStore {
 
 	stateStore := &_stateStore{
-		authsByResourcesKeyPrefix:    "authsByResources_",
-		callsByID:                    make(map[string]*model.Call),
-		db:                           db,
-		lastAppliedEventTimestampKey: "lastAppliedEventTimestamp",
+		authsByResourcesKeyPrefix:   "authsByResources_",
+		callsByID:                   make(map[string]*model.Call),
+		callStartTimesByID:          make(map[string]time.Time),
+		db:                          db,
+		lastAppliedEventSequenceKey: "lastAppliedEventSequence",
+		opSummariesByIDKeyPrefix:    "opSummariesByID_",
 	}
 
 	go func() {
 		// apply events in background
 
-		// make best effort to get lastAppliedEventTimestamp
-		lastAppliedEventTimestamp, _ := stateStore.getLastAppliedEventTimestamp()
-
-		// replay from second before last applied event to ensure we see events
-		// at least once (multiple events w/ same timestamp could exist)
-		since := lastAppliedEventTimestamp.Add(-time.Second)
+		// make best effort to get lastAppliedEventSequence
+		lastAppliedEventSequence, _ := stateStore.getLastAppliedEventSequence()
 
 		eventChannel, _ := pubSub.Subscribe(
 			ctx,
 			model.EventFilter{
-				Since: &since,
+				AfterSequence: lastAppliedEventSequence,
 			},
 		)
 
@@ -65,12 +73,12 @@ func newStateStore(
 			case event.AuthAdded != nil:
 				stateStore.applyAuthAdded(*event.AuthAdded)
 			case event.CallEnded != nil:
-				stateStore.applyCallEnded(*event.CallEnded)
+				stateStore.applyCallEnded(ctx, *event.CallEnded, event.Timestamp)
 			case event.CallStarted != nil:
-				stateStore.applyCallStarted(*event.CallStarted)
+				stateStore.applyCallStarted(ctx, *event.CallStarted, event.Timestamp)
 			}
 
-			stateStore.updateLastAppliedEventTimestamp(event.Timestamp)
+			stateStore.updateLastAppliedEventSequence(event.Sequence)
 		}
 	}()
 
@@ -79,41 +87,39 @@ func newStateStore(
 }
 
 type _stateStore struct {
-	lastAppliedEventTimestampKey string
-	authsByResourcesKeyPrefix    string
-	callsByID                    map[string]*model.Call
-	db                           *badger.DB
+	lastAppliedEventSequenceKey string
+	authsByResourcesKeyPrefix   string
+	callsByID                   map[string]*model.Call
+	callStartTimesByID          map[string]time.Time
+	db                          *badger.DB
+	opSummariesByIDKeyPrefix    string
 	// synchronize access via mutex
 	mux sync.RWMutex
 }
 
-func (ss *_stateStore) getLastAppliedEventTimestamp() (time.Time, error) {
-	var lastAppliedEventTimestamp time.Time
+func (ss *_stateStore) getLastAppliedEventSequence() (uint64, error) {
+	var lastAppliedEventSequence uint64
 	err := ss.db.View(func(txn *badger.Txn) error {
-		item, err := txn.Get([]byte(ss.lastAppliedEventTimestampKey))
+		item, err := txn.Get([]byte(ss.lastAppliedEventSequenceKey))
 		if err != nil {
 			return err
 		}
 
 		return item.Value(func(val []byte) error {
-			seconds, err := strconv.ParseInt(string(val), 10, 64)
-			if err != nil {
-				return err
-			}
-			lastAppliedEventTimestamp = time.Unix(seconds-1, 0)
-			return nil
+			lastAppliedEventSequence, err = strconv.ParseUint(string(val), 10, 64)
+			return err
 		})
 	})
 
-	return lastAppliedEventTimestamp, err
+	return lastAppliedEventSequence, err
 }
 
-func (ss *_stateStore) updateLastAppliedEventTimestamp(lastAppliedEventTimestamp time.Time) error {
+func (ss *_stateStore) updateLastAppliedEventSequence(lastAppliedEventSequence uint64) error {
 	return ss.db.Update(func(txn *badger.Txn) error {
 		return txn.Set(
-			[]byte(ss.lastAppliedEventTimestampKey),
+			[]byte(ss.lastAppliedEventSequenceKey),
 			[]byte(
-				strconv.FormatInt(lastAppliedEventTimestamp.Unix(), 10),
+				strconv.FormatUint(lastAppliedEventSequence, 10),
 			),
 		)
 	})
@@ -134,7 +140,33 @@ func (ss *_stateStore) applyAuthAdded(authAdded model.AuthAdded) error {
 	})
 }
 
-func (ss *_stateStore) applyCallEnded(callEnded model.CallEnded) {
+func (ss *_stateStore) applyCallEnded(
+	ctx context.Context,
+	callEnded model.CallEnded,
+	timestamp time.Time,
+) {
+	call := callEnded.Call
+	if call.ID == call.RootID && !callEnded.WillRetry {
+		// make best effort to record end of op
+		ss.updateOpSummary(call.ID, func(opSummary *model.OpSummary) {
+			if opSummary.StartTime.IsZero() {
+				// op ended before it started i.e. failed interpretation
+				opSummary.ID = call.ID
+				opSummary.Ref = callEnded.Ref
+				opSummary.StartTime = timestamp
+			}
+			endTime := timestamp
+			opSummary.EndTime = &endTime
+			opSummary.Error = callEnded.Error
+			opSummary.Outcome = callEnded.Outcome
+			if call.Op != nil {
+				opSummary.Outputs = redactSecrets(ctx, call.Op.OpPath, callEnded.Outputs, func(opFile *model.OpSpec) map[string]*model.ParamSpec {
+					return opFile.Outputs
+				})
+			}
+		})
+	}
+
 	if callEnded.Outcome != model.OpOutcomeFailed {
 		return
 	}
@@ -149,22 +181,81 @@ func (ss *_stateStore) applyCallEnded(callEnded model.CallEnded) {
 }
 
 // O(1) complexity
-func (ss *_stateStore) applyCallStarted(callStarted model.CallStarted) {
+func (ss *_stateStore) applyCallStarted(
+	ctx context.Context,
+	callStarted model.CallStarted,
+	timestamp time.Time,
+) {
+	call := callStarted.Call
+	if call.ID == call.RootID && call.Op != nil {
+		// make best effort to record start of op
+		ss.updateOpSummary(call.ID, func(opSummary *model.OpSummary) {
+			if opSummary.StartTime.IsZero() {
+				// retried ops keep the time of their first attempt
+				opSummary.StartTime = timestamp
+			}
+			opSummary.Args = redactSecrets(ctx, call.Op.OpPath, call.Op.Inputs, func(opFile *model.OpSpec) map[string]*model.ParamSpec {
+				return opFile.Inputs
+			})
+			opSummary.ID = call.ID
+			opSummary.Ref = callStarted.Ref
+		})
+	}
+
 	ss.mux.Lock()
 	defer ss.mux.Unlock()
 
-	call := callStarted.Call
 	ss.callsByID[call.ID] = &call
+	if _, ok := ss.callStartTimesByID[call.ID]; !ok {
+		ss.callStartTimesByID[call.ID] = timestamp
+	}
+}
+
+func (ss *_stateStore) getOpSummaryKey(id string) []byte {
+	return []byte(ss.opSummariesByIDKeyPrefix + id)
+}
+
+// updateOpSummary applies update to the summary of the op w/ id; creating it if it doesn't exist
+func (ss *_stateStore) updateOpSummary(
+	id string,
+	update func(opSummary *model.OpSummary),
+) error {
+	return ss.db.Update(func(txn *badger.Txn) error {
+		opSummary := &model.OpSummary{}
+
+		item, err := txn.Get(ss.getOpSummaryKey(id))
+		if err == nil {
+			if err := item.Value(func(value []byte) error {
+				return json.Unmarshal(value, opSummary)
+			}); err != nil {
+				return err
+			}
+		} else if err != badger.ErrKeyNotFound {
+			return err
+		}
+
+		update(opSummary)
+
+		encodedOpSummary, err := json.Marshal(opSummary)
+		if err != nil {
+			return err
+		}
+
+		return txn.Set(
+			ss.getOpSummaryKey(id),
+			encodedOpSummary,
+		)
+	})
 }
 
 // O(n) complexity (n being active call count)
-func (ss *_stateStore) ListWithParentID(parentID string) []*model.Call {
+func (ss *_stateStore) ListWithParentID(parentID string, startedBefore time.Time) []*model.Call {
 	ss.mux.RLock()
 	defer ss.mux.RUnlock()
 
 	results := []*model.Call{}
 	for _, call := range ss.callsByID {
-		if call.ParentID != nil && *call.ParentID == parentID {
+		if call.ParentID != nil && *call.ParentID == parentID && ss.callStartTimesByID[call.ID].Before(startedBefore) {
 			results = append(results, call)
 		}
 	}
@@ -184,6 +275,63 @@ func (ss *_stateStore) TryGet(
 	return nil
 }
 
+// O(n) complexity (n being op count)
+func (ss *_stateStore) ListOps() ([]*model.OpSummary, error) {
+	opSummaries := []*model.OpSummary{}
+	err := ss.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+		prefixBytes := []byte(ss.opSummariesByIDKeyPrefix)
+		for it.Seek(prefixBytes); it.ValidForPrefix(prefixBytes); it.Next() {
+			if err := it.Item().Value(func(value []byte) error {
+				opSummary := &model.OpSummary{}
+				if err := json.Unmarshal(value, opSummary); err != nil {
+					return err
+				}
+				opSummaries = append(opSummaries, opSummary)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// most recently started first
+	sort.Slice(opSummaries, func(i, j int) bool {
+		return opSummaries[i].StartTime.After(opSummaries[j].StartTime)
+	})
+
+	return opSummaries, nil
+}
+
+// O(1) complexity
+func (ss *_stateStore) GetOp(
+	id string,
+) (*model.OpSummary, error) {
+	opSummary := &model.OpSummary{}
+	err := ss.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(ss.getOpSummaryKey(id))
+		if err != nil {
+			return err
+		}
+
+		return item.Value(func(value []byte) error {
+			return json.Unmarshal(value, opSummary)
+		})
+	})
+	if err == badger.ErrKeyNotFound {
+		return nil, model.ErrOpNotFound{}
+	} else if err != nil {
+		return nil, err
+	}
+
+	return opSummary, nil
+}
+
 func (ss *_stateStore) TryGetAuth(
 	ref string,
 ) *model.Auth {
@@ -3,6 +3,8 @@ package core
 import (
 	"context"
 	"io/ioutil"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/dgraph-io/badger/v3"
@@ -92,4 +94,134 @@ var _ = Context("stateStore", func() {
 			})
 		})
 	})
+	Context("GetOp", func() {
+		It("should return expected op summary w/ secrets redacted", func() {
+			/* arrange */
+			dbDir, err := ioutil.TempDir("", "")
+			if err != nil {
+				panic(err)
+			}
+
+			db, err := badger.Open(
+				badger.DefaultOptions(dbDir).WithLogger(nil),
+			)
+			if err != nil {
+				panic(err)
+			}
+
+			wd, err := os.Getwd()
+			if err != nil {
+				panic(err)
+			}
+			opPath := filepath.Join(wd, "testdata/stateStore")
+
+			pubSub := pubsub.New(db)
+
+			objectUnderTest := newStateStore(
+				context.Background(),
+				db,
+				pubSub,
+			)
+
+			password := "password"
+			token := "token"
+			username := "username"
+			startTime := time.Now().UTC()
+			endTime := startTime.Add(time.Second)
+
+			pubSub.Publish(model.Event{
+				CallStarted: &model.CallStarted{
+					Call: model.Call{
+						ID: "id",
+						Op: &model.OpCall{
+							BaseCall: model.BaseCall{
+								OpPath: opPath,
+							},
+							Inputs: map[string]*model.Value{
+								"password": {String: &password},
+								"username": {String: &username},
+							},
+						},
+						RootID: "id",
+					},
+					Ref: opPath,
+				},
+				Timestamp: startTime,
+			})
+			pubSub.Publish(model.Event{
+				CallEnded: &model.CallEnded{
+					Call: model.Call{
+						ID: "id",
+						Op: &model.OpCall{
+							BaseCall: model.BaseCall{
+								OpPath: opPath,
+							},
+						},
+						RootID: "id",
+					},
+					Outcome: model.OpOutcomeSucceeded,
+					Outputs: map[string]*model.Value{
+						"token": {String: &token},
+					},
+					Ref: opPath,
+				},
+				Timestamp: endTime,
+			})
+
+			expectedOpSummary := &model.OpSummary{
+				Args: map[string]*model.Value{
+					"password": {String: &redactedValue},
+					"username": {String: &username},
+				},
+				EndTime: &endTime,
+				ID:      "id",
+				Outcome: model.OpOutcomeSucceeded,
+				Outputs: map[string]*model.Value{
+					"token": {String: &redactedValue},
+				},
+				Ref:       opPath,
+				StartTime: startTime,
+			}
+
+			/* act/assert */
+			Eventually(
+				func() *model.OpSummary {
+					actualOpSummary, _ := objectUnderTest.GetOp("id")
+					return actualOpSummary
+				},
+			).Should(
+				Equal(expectedOpSummary),
+			)
+
+			actualOpSummaries, err := objectUnderTest.ListOps()
+			Expect(err).To(BeNil())
+			Expect(actualOpSummaries).To(Equal([]*model.OpSummary{expectedOpSummary}))
+		})
+		It("should return ErrOpNotFound for unknown id", func() {
+			/* arrange */
+			dbDir, err := ioutil.TempDir("", "")
+			if err != nil {
+				panic(err)
+			}
+
+			db, err := badger.Open(
+				badger.DefaultOptions(dbDir).WithLogger(nil),
+			)
+			if err != nil {
+				panic(err)
+			}
+
+			objectUnderTest := newStateStore(
+				context.Background(),
+				db,
+				pubsub.New(db),
+			)
+
+			/* act */
+			_, actualErr := objectUnderTest.GetOp("id")
+
+			/* assert */
+			Expect(actualErr).To(Equal(model.ErrOpNotFound{}))
+		})
+	})
 })
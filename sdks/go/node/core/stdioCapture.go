@@ -0,0 +1,133 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/opspec"
+)
+
+// maxStdioStringBytes is the most of a std stream which will be buffered in memory to bind it to a string
+const maxStdioStringBytes = 10 * 1024 * 1024
+
+// stdioCapture captures a std stream of a container so it can be bound to variables
+type stdioCapture struct {
+	buffer   *bytes.Buffer
+	err      error
+	file     *os.File
+	filePath string
+	// name of the std stream e.g. stdout
+	name string
+	spec *model.ContainerCallStdioSpec
+}
+
+// newStdioCapture returns a capture of a std stream per spec; nil spec returns nil.
+// filePath is where the stream will be written if it's bound to a file.
+func newStdioCapture(
+	name string,
+	spec *model.ContainerCallStdioSpec,
+	filePath string,
+) (*stdioCapture, error) {
+	if spec == nil {
+		return nil, nil
+	}
+
+	capture := &stdioCapture{
+		filePath: filePath,
+		name:     name,
+		spec:     spec,
+	}
+
+	if spec.String != nil {
+		capture.buffer = &bytes.Buffer{}
+	}
+
+	if spec.File != nil {
+		if err := os.MkdirAll(filepath.Dir(filePath), 0700); err != nil {
+			return nil, err
+		}
+
+		var err error
+		capture.file, err = os.Create(filePath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return capture, nil
+}
+
+// Write writes to the capture; writes to nil captures are ignored
+func (c *stdioCapture) Write(p []byte) (int, error) {
+	if c == nil {
+		return len(p), nil
+	}
+
+	if c.buffer != nil {
+		if c.buffer.Len()+len(p) > maxStdioStringBytes {
+			// stop buffering so a chatty container can't exhaust memory; the call fails once it ends
+			c.buffer = nil
+			c.err = fmt.Errorf(
+				"unable to bind %s to %s: exceeded limit of %d bytes; bind it to a file instead",
+				c.name,
+				*c.spec.String,
+				maxStdioStringBytes,
+			)
+		} else {
+			c.buffer.Write(p)
+		}
+	}
+
+	if c.file != nil {
+		if _, err := c.file.Write(p); err != nil && c.err == nil {
+			// keep consuming the stream so the container isn't blocked; the call fails once it ends
+			c.err = fmt.Errorf(
+				"unable to bind %s to %s: %w",
+				c.name,
+				*c.spec.File,
+				err,
+			)
+		}
+	}
+
+	return len(p), nil
+}
+
+// Err returns the error, if any, which prevented the stream being captured; nil captures return nil
+func (c *stdioCapture) Err() error {
+	if c == nil {
+		return nil
+	}
+
+	return c.err
+}
+
+// addOutputs adds captured values to outputs; nil captures add nothing
+func (c *stdioCapture) addOutputs(
+	outputs map[string]*model.Value,
+) {
+	if c == nil {
+		return
+	}
+
+	if c.buffer != nil {
+		value := c.buffer.String()
+		outputs[opspec.RefToName(*c.spec.String)] = &model.Value{String: &value}
+	}
+
+	if c.file != nil {
+		value := c.filePath
+		outputs[opspec.RefToName(*c.spec.File)] = &model.Value{File: &value}
+	}
+}
+
+func (c *stdioCapture) close() error {
+	if c == nil || c.file == nil {
+		return nil
+	}
+
+	return c.file.Close()
+}
@@ -0,0 +1,95 @@
+package core
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+var _ = Context("stdioCapture", func() {
+	Context("bound to string", func() {
+		stringRef := "$(stdout)"
+
+		Context("writes within limit", func() {
+			It("should add expected output", func() {
+				/* arrange */
+				objectUnderTest, err := newStdioCapture(
+					"stdout",
+					&model.ContainerCallStdioSpec{String: &stringRef},
+					"",
+				)
+				if err != nil {
+					panic(err)
+				}
+
+				actualOutputs := map[string]*model.Value{}
+
+				/* act */
+				objectUnderTest.Write([]byte("std"))
+				objectUnderTest.Write([]byte("out"))
+				objectUnderTest.addOutputs(actualOutputs)
+
+				/* assert */
+				Expect(objectUnderTest.Err()).To(BeNil())
+				Expect(*actualOutputs["stdout"].String).To(Equal("stdout"))
+			})
+		})
+		Context("writes exceed limit", func() {
+			It("should return expected error", func() {
+				/* arrange */
+				objectUnderTest, err := newStdioCapture(
+					"stdout",
+					&model.ContainerCallStdioSpec{String: &stringRef},
+					"",
+				)
+				if err != nil {
+					panic(err)
+				}
+
+				/* act */
+				objectUnderTest.Write(make([]byte, maxStdioStringBytes))
+				objectUnderTest.Write([]byte("overflow"))
+
+				/* assert */
+				Expect(objectUnderTest.Err()).To(MatchError("unable to bind stdout to $(stdout): exceeded limit of 10485760 bytes; bind it to a file instead"))
+				Expect(objectUnderTest.buffer).To(BeNil())
+			})
+		})
+	})
+	Context("bound to file", func() {
+		fileRef := "$(stdout)"
+
+		Context("file write fails", func() {
+			It("should return expected error", func() {
+				/* arrange */
+				tempDir, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+				filePath := filepath.Join(tempDir, "stdout")
+				objectUnderTest, err := newStdioCapture(
+					"stdout",
+					&model.ContainerCallStdioSpec{File: &fileRef},
+					filePath,
+				)
+				if err != nil {
+					panic(err)
+				}
+				objectUnderTest.close()
+
+				/* act */
+				actualN, actualErr := objectUnderTest.Write([]byte("std"))
+				objectUnderTest.Write([]byte("out"))
+
+				/* assert */
+				Expect(actualN).To(Equal(3))
+				Expect(actualErr).To(BeNil())
+				Expect(objectUnderTest.Err()).To(MatchError(fmt.Sprintf("unable to bind stdout to $(stdout): write %s: file already closed", filePath)))
+			})
+		})
+	})
+})
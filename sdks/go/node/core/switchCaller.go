@@ -0,0 +1,127 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/opctl/opctl/sdks/go/internal/uniquestring"
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/pubsub"
+)
+
+//counterfeiter:generate -o internal/fakes/switchCaller.go . switchCaller
+type switchCaller interface {
+	// Executes a switch call
+	Call(
+		ctx context.Context,
+		callID string,
+		inboundScope map[string]*model.Value,
+		rootCallID string,
+		opPath string,
+		callSwitch *model.SwitchCall,
+		callSpecSwitch model.SwitchCallSpec,
+	) (
+		map[string]*model.Value,
+		error,
+	)
+}
+
+func newSwitchCaller(
+	caller caller,
+	pubSub pubsub.PubSub,
+) switchCaller {
+	return _switchCaller{
+		caller: caller,
+		pubSub: pubSub,
+	}
+}
+
+type _switchCaller struct {
+	caller caller
+	pubSub pubsub.PubSub
+}
+
+func (sc _switchCaller) Call(
+	ctx context.Context,
+	callID string,
+	inboundScope map[string]*model.Value,
+	rootCallID string,
+	opPath string,
+	callSwitch *model.SwitchCall,
+	callSpecSwitch model.SwitchCallSpec,
+) (
+	map[string]*model.Value,
+	error,
+) {
+	outputs := map[string]*model.Value{}
+	for varName, varData := range inboundScope {
+		outputs[varName] = varData
+	}
+
+	// the matched case is called; otherwise the default (if any) is
+	matchedCallSpec := callSpecSwitch.Default
+	skippedCallSpecs := []*model.CallSpec{}
+	for caseIndex, caseSpec := range callSpecSwitch.Cases {
+		if callSwitch.CaseIndex != nil && *callSwitch.CaseIndex == caseIndex {
+			matchedCallSpec = &caseSpec.Run
+		} else {
+			skippedCallSpecs = append(skippedCallSpecs, &caseSpec.Run)
+		}
+	}
+	if callSwitch.CaseIndex != nil && callSpecSwitch.Default != nil {
+		skippedCallSpecs = append(skippedCallSpecs, callSpecSwitch.Default)
+	}
+
+	// branches not called end as skipped so they're shown in the call graph
+	for _, skippedCallSpec := range skippedCallSpecs {
+		skippedCallID, err := uniquestring.Construct()
+		if err != nil {
+			return nil, err
+		}
+
+		sc.pubSub.Publish(
+			model.Event{
+				CallEnded: &model.CallEnded{
+					Call: model.Call{
+						ID:       skippedCallID,
+						Name:     skippedCallSpec.Name,
+						ParentID: &callID,
+						RootID:   rootCallID,
+					},
+					Outcome: model.OpOutcomeSkipped,
+					Ref:     opPath,
+				},
+				Timestamp: time.Now().UTC(),
+			},
+		)
+	}
+
+	if matchedCallSpec == nil {
+		// no case matched & no default
+		return outputs, nil
+	}
+
+	childCallID, err := uniquestring.Construct()
+	if err != nil {
+		return nil, err
+	}
+
+	childCallOutputs, err := sc.caller.Call(
+		ctx,
+		childCallID,
+		outputs,
+		matchedCallSpec,
+		opPath,
+		&callID,
+		rootCallID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for name, value := range childCallOutputs {
+		outputs[name] = value
+	}
+
+	return outputs, nil
+}
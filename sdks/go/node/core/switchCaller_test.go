@@ -0,0 +1,219 @@
+package core
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+	. "github.com/opctl/opctl/sdks/go/node/core/internal/fakes"
+	. "github.com/opctl/opctl/sdks/go/pubsub/fakes"
+)
+
+var _ = Context("switchCaller", func() {
+	Context("newSwitchCaller", func() {
+		It("should return switchCaller", func() {
+			/* arrange/act/assert */
+			Expect(newSwitchCaller(
+				new(FakeCaller),
+				new(FakePubSub),
+			)).To(Not(BeNil()))
+		})
+	})
+	Context("Call", func() {
+		prodName := "prod"
+		stagingName := "staging"
+		defaultName := "default"
+		providedCallSpecSwitch := model.SwitchCallSpec{
+			Cases: []*model.SwitchCaseSpec{
+				{
+					Run: model.CallSpec{Name: &prodName},
+				},
+				{
+					Run: model.CallSpec{Name: &stagingName},
+				},
+			},
+			Default: &model.CallSpec{Name: &defaultName},
+		}
+
+		Context("case matched", func() {
+			It("should call caller.Call w/ expected args & publish skipped branches", func() {
+				/* arrange */
+				providedCallID := "providedCallID"
+				providedOpPath := "providedOpPath"
+				providedRootCallID := "providedRootCallID"
+				providedScope := map[string]*model.Value{
+					"name": {String: new(string)},
+				}
+				caseIndex := 1
+
+				fakeCaller := new(FakeCaller)
+				fakePubSub := new(FakePubSub)
+
+				objectUnderTest := _switchCaller{
+					caller: fakeCaller,
+					pubSub: fakePubSub,
+				}
+
+				/* act */
+				objectUnderTest.Call(
+					context.Background(),
+					providedCallID,
+					providedScope,
+					providedRootCallID,
+					providedOpPath,
+					&model.SwitchCall{CaseIndex: &caseIndex},
+					providedCallSpecSwitch,
+				)
+
+				/* assert */
+				Expect(fakeCaller.CallCallCount()).To(Equal(1))
+				_,
+					_,
+					actualScope,
+					actualCallSpec,
+					actualOpPath,
+					actualParentCallID,
+					actualRootCallID := fakeCaller.CallArgsForCall(0)
+				Expect(actualScope).To(Equal(providedScope))
+				Expect(*actualCallSpec).To(Equal(providedCallSpecSwitch.Cases[1].Run))
+				Expect(actualOpPath).To(Equal(providedOpPath))
+				Expect(*actualParentCallID).To(Equal(providedCallID))
+				Expect(actualRootCallID).To(Equal(providedRootCallID))
+
+				Expect(fakePubSub.PublishCallCount()).To(Equal(2))
+				actualSkippedNames := []string{}
+				for i := 0; i < fakePubSub.PublishCallCount(); i++ {
+					actualEvent := fakePubSub.PublishArgsForCall(i)
+					Expect(actualEvent.CallEnded.Outcome).To(Equal(model.OpOutcomeSkipped))
+					Expect(*actualEvent.CallEnded.Call.ParentID).To(Equal(providedCallID))
+					actualSkippedNames = append(actualSkippedNames, *actualEvent.CallEnded.Call.Name)
+				}
+				Expect(actualSkippedNames).To(Equal([]string{prodName, defaultName}))
+			})
+		})
+		Context("no case matched", func() {
+			It("should call default", func() {
+				/* arrange */
+				fakeCaller := new(FakeCaller)
+
+				objectUnderTest := _switchCaller{
+					caller: fakeCaller,
+					pubSub: new(FakePubSub),
+				}
+
+				/* act */
+				objectUnderTest.Call(
+					context.Background(),
+					"callID",
+					map[string]*model.Value{},
+					"rootCallID",
+					"opPath",
+					&model.SwitchCall{},
+					providedCallSpecSwitch,
+				)
+
+				/* assert */
+				_, _, _, actualCallSpec, _, _, _ := fakeCaller.CallArgsForCall(0)
+				Expect(actualCallSpec).To(Equal(providedCallSpecSwitch.Default))
+			})
+			Context("no default", func() {
+				It("should return inboundScope", func() {
+					/* arrange */
+					providedScope := map[string]*model.Value{
+						"name": {String: new(string)},
+					}
+					fakeCaller := new(FakeCaller)
+
+					objectUnderTest := _switchCaller{
+						caller: fakeCaller,
+						pubSub: new(FakePubSub),
+					}
+
+					/* act */
+					actualOutputs, actualErr := objectUnderTest.Call(
+						context.Background(),
+						"callID",
+						providedScope,
+						"rootCallID",
+						"opPath",
+						&model.SwitchCall{},
+						model.SwitchCallSpec{},
+					)
+
+					/* assert */
+					Expect(actualErr).To(BeNil())
+					Expect(actualOutputs).To(Equal(providedScope))
+					Expect(fakeCaller.CallCallCount()).To(Equal(0))
+				})
+			})
+		})
+		Context("caller.Call errs", func() {
+			It("should return expected result", func() {
+				/* arrange */
+				expectedErr := errors.New("expectedErr")
+				fakeCaller := new(FakeCaller)
+				fakeCaller.CallReturns(nil, expectedErr)
+
+				objectUnderTest := _switchCaller{
+					caller: fakeCaller,
+					pubSub: new(FakePubSub),
+				}
+
+				/* act */
+				_, actualErr := objectUnderTest.Call(
+					context.Background(),
+					"callID",
+					map[string]*model.Value{},
+					"rootCallID",
+					"opPath",
+					&model.SwitchCall{},
+					providedCallSpecSwitch,
+				)
+
+				/* assert */
+				Expect(actualErr).To(Equal(expectedErr))
+			})
+		})
+		Context("caller.Call returns outputs", func() {
+			It("should merge them w/ inboundScope", func() {
+				/* arrange */
+				inboundValue := "inbound"
+				outputValue := "output"
+				fakeCaller := new(FakeCaller)
+				fakeCaller.CallReturns(
+					map[string]*model.Value{
+						"output": {String: &outputValue},
+					},
+					nil,
+				)
+
+				objectUnderTest := _switchCaller{
+					caller: fakeCaller,
+					pubSub: new(FakePubSub),
+				}
+
+				/* act */
+				actualOutputs, actualErr := objectUnderTest.Call(
+					context.Background(),
+					"callID",
+					map[string]*model.Value{
+						"inbound": {String: &inboundValue},
+					},
+					"rootCallID",
+					"opPath",
+					&model.SwitchCall{},
+					providedCallSpecSwitch,
+				)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualOutputs).To(Equal(map[string]*model.Value{
+					"inbound": {String: &inboundValue},
+					"output":  {String: &outputValue},
+				}))
+			})
+		})
+	})
+})
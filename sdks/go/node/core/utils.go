@@ -2,7 +2,11 @@ package core
 
 import (
 	"bufio"
+	"context"
 	"io"
+
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/opspec/opfile"
 )
 
 // readChunks reads from an io.Reader in chunks
@@ -32,3 +36,37 @@ func readChunks(
 	}
 	return err
 }
+
+// redactedValue replaces values of secret params
+var redactedValue = "***"
+
+// redactSecrets returns a copy of values w/ values of secret params replaced by redactedValue.
+// If the params of the op can't be determined, all values are redacted.
+func redactSecrets(
+	ctx context.Context,
+	opPath string,
+	values map[string]*model.Value,
+	getParamSpecs func(opFile *model.OpSpec) map[string]*model.ParamSpec,
+) map[string]*model.Value {
+	if values == nil {
+		return nil
+	}
+
+	opFile, err := opfile.Get(ctx, opPath)
+
+	redactedValues := map[string]*model.Value{}
+	for name, value := range values {
+		if err != nil {
+			redactedValues[name] = &model.Value{String: &redactedValue}
+			continue
+		}
+
+		if paramSpec := getParamSpecs(opFile)[name]; paramSpec != nil && paramSpec.IsSecret() {
+			redactedValues[name] = &model.Value{String: &redactedValue}
+			continue
+		}
+
+		redactedValues[name] = value
+	}
+	return redactedValues
+}
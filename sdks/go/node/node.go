@@ -18,6 +18,12 @@ type Node interface {
 		req model.AddAuthReq,
 	) error
 
+	// AttachContainer attaches a terminal to the TTY of an interactive container; it returns once the container exits
+	AttachContainer(
+		ctx context.Context,
+		req model.AttachContainerReq,
+	) error
+
 	GetEventStream(
 		ctx context.Context,
 		req *model.GetEventStreamReq,
@@ -39,6 +45,52 @@ type Node interface {
 		ctx context.Context,
 	) error
 
+	// ListCacheEntries lists entries of the container call result cache
+	ListCacheEntries(
+		ctx context.Context,
+	) (
+		[]*model.CacheEntry,
+		error,
+	)
+
+	// ListOps lists summaries of ops run by the node; most recently started first
+	ListOps(
+		ctx context.Context,
+	) (
+		[]*model.OpSummary,
+		error,
+	)
+
+	// GetOp gets the summary of an op run by the node
+	//
+	// expected errs:
+	//  - ErrOpNotFound if no op w/ id exists
+	GetOp(
+		ctx context.Context,
+		id string,
+	) (
+		*model.OpSummary,
+		error,
+	)
+
+	// PruneCache removes entries from the container call result cache and returns the removed entries
+	PruneCache(
+		ctx context.Context,
+		req model.PruneCacheReq,
+	) (
+		[]*model.CacheEntry,
+		error,
+	)
+
+	// PruneEvents removes events & scratch dirs of ended root calls and returns the ids of all calls pruned
+	PruneEvents(
+		ctx context.Context,
+		req model.PruneEventsReq,
+	) (
+		[]string,
+		error,
+	)
+
 	// StartOp starts an op and returns the root call ID
 	StartOp(
 		ctx context.Context,
@@ -3,25 +3,63 @@ package dirs
 import (
 	"fmt"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/golang-utils/dircopier"
 	"github.com/opctl/opctl/sdks/go/model"
 	"github.com/opctl/opctl/sdks/go/opspec"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/boolean"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/container/resources"
 	"github.com/opctl/opctl/sdks/go/opspec/interpreter/dir"
 )
 
-// Interpret container dirs
+// Interpret container dirs; returns dirs, tmpfs mounts & paths of dirs mounted read only
 func Interpret(
 	scope map[string]*model.Value,
 	containerCallSpecDirs map[string]interface{},
 	scratchDirPath string,
 	dataCachePath string,
-) (map[string]string, error) {
+) (map[string]string, map[string]int64, []string, error) {
 	containerCallDirs := map[string]string{}
+	var containerCallTmpfs map[string]int64
+	var readOnlyPaths []string
 dirLoop:
 	for callSpecContainerDirPath, dirExpression := range containerCallSpecDirs {
 
+		isReadOnly := false
+		mountSpec, err := toMountSpec(dirExpression)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("unable to bind directory %v to %v: %w", callSpecContainerDirPath, dirExpression, err)
+		}
+		if mountSpec != nil {
+			if mountSpec.Tmpfs != nil {
+				if mountSpec.Ref != nil || mountSpec.ReadOnly != nil {
+					return nil, nil, nil, fmt.Errorf("unable to bind directory %v: tmpfs can't be combined w/ ref or readOnly", callSpecContainerDirPath)
+				}
+
+				size, err := resources.InterpretBytes(scope, mountSpec.Tmpfs.Size)
+				if err != nil {
+					return nil, nil, nil, fmt.Errorf("unable to interpret tmpfs size of directory %v: %w", callSpecContainerDirPath, err)
+				}
+				if containerCallTmpfs == nil {
+					containerCallTmpfs = map[string]int64{}
+				}
+				containerCallTmpfs[callSpecContainerDirPath] = size
+				continue dirLoop
+			}
+
+			if mountSpec.ReadOnly != nil {
+				readOnly, err := boolean.Interpret(scope, mountSpec.ReadOnly)
+				if err != nil {
+					return nil, nil, nil, fmt.Errorf("unable to interpret readOnly of directory %v: %w", callSpecContainerDirPath, err)
+				}
+				isReadOnly = *readOnly.Boolean
+			}
+
+			dirExpression = mountSpec.Ref
+		}
+
 		if dirExpression == nil {
 			// bound implicitly
 			dirExpression = opspec.NameToRef(callSpecContainerDirPath)
@@ -34,11 +72,15 @@ dirLoop:
 			true,
 		)
 		if err != nil {
-			return nil, fmt.Errorf("unable to bind directory %v to %v: %w", callSpecContainerDirPath, dirExpression, err)
+			return nil, nil, nil, fmt.Errorf("unable to bind directory %v to %v: %w", callSpecContainerDirPath, dirExpression, err)
+		}
+
+		if isReadOnly {
+			readOnlyPaths = append(readOnlyPaths, callSpecContainerDirPath)
 		}
 
-		if *dirValue.Dir != "" && !strings.HasPrefix(*dirValue.Dir, dataCachePath) {
-			// bound to non dataCachePath
+		if isReadOnly || (*dirValue.Dir != "" && !strings.HasPrefix(*dirValue.Dir, dataCachePath)) {
+			// bound read only or to non dataCachePath
 			containerCallDirs[callSpecContainerDirPath] = *dirValue.Dir
 			continue dirLoop
 		}
@@ -51,9 +93,60 @@ dirLoop:
 			*dirValue.Dir,
 			containerCallDirs[callSpecContainerDirPath],
 		); err != nil {
-			return nil, fmt.Errorf("unable to bind %v to %v: %w", callSpecContainerDirPath, dirExpression, err)
+			return nil, nil, nil, fmt.Errorf("unable to bind %v to %v: %w", callSpecContainerDirPath, dirExpression, err)
+		}
+
+	}
+
+	// sort to make order deterministic
+	sort.Strings(readOnlyPaths)
+
+	return containerCallDirs, containerCallTmpfs, readOnlyPaths, nil
+}
+
+// toMountSpec returns a mount spec if expression is one, otherwise nil.
+// Expressions are mount specs if they're objects w/out any dir entries (which always start w/ "/").
+func toMountSpec(
+	expression interface{},
+) (*model.ContainerCallMountSpec, error) {
+	expressionMap, ok := expression.(map[string]interface{})
+	if !ok || len(expressionMap) == 0 {
+		return nil, nil
+	}
+
+	for key := range expressionMap {
+		if strings.HasPrefix(key, "/") {
+			return nil, nil
 		}
+	}
+
+	mountSpec := &model.ContainerCallMountSpec{}
+	for key, value := range expressionMap {
+		switch key {
+		case "ref":
+			mountSpec.Ref = value
+		case "readOnly":
+			mountSpec.ReadOnly = value
+		case "tmpfs":
+			mountSpec.Tmpfs = &model.ContainerCallTmpfsSpec{}
+			if value == nil {
+				continue
+			}
 
+			tmpfsMap, ok := value.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("tmpfs must be an object")
+			}
+			for tmpfsKey, tmpfsValue := range tmpfsMap {
+				if tmpfsKey != "size" {
+					return nil, fmt.Errorf("unknown tmpfs property %v", tmpfsKey)
+				}
+				mountSpec.Tmpfs.Size = tmpfsValue
+			}
+		default:
+			return nil, fmt.Errorf("unknown mount property %v", key)
+		}
 	}
-	return containerCallDirs, nil
+
+	return mountSpec, nil
 }
@@ -21,7 +21,7 @@ var _ = Context("Interpret", func() {
 			}
 
 			/* act */
-			_, actualErr := Interpret(
+			_, _, _, actualErr := Interpret(
 				map[string]*model.Value{
 					identifier: {
 						Socket: new(string),
@@ -58,7 +58,7 @@ var _ = Context("Interpret", func() {
 				}
 
 				/* act */
-				actualContainerCallDirs, actualErr := Interpret(
+				actualContainerCallDirs, _, _, actualErr := Interpret(
 					map[string]*model.Value{
 						identifier: {Dir: &dirPath},
 					},
@@ -97,7 +97,7 @@ var _ = Context("Interpret", func() {
 					}
 
 					/* act */
-					actualResult, actualErr := Interpret(
+					actualResult, _, _, actualErr := Interpret(
 						map[string]*model.Value{
 							identifier: {Dir: &dirValue},
 						},
@@ -115,5 +115,66 @@ var _ = Context("Interpret", func() {
 				})
 			})
 		})
+		Context("mount is readOnly", func() {
+			It("should mount value.Dir w/out copying & return expected results", func() {
+				/* arrange */
+				identifier := "identifier"
+				containerPath := "/something"
+				dirValue, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				scratchDirPath, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				/* act */
+				actualDirs, _, actualReadOnlyPaths, actualErr := Interpret(
+					map[string]*model.Value{
+						identifier: {Dir: &dirValue},
+					},
+					map[string]interface{}{
+						containerPath: map[string]interface{}{
+							"ref":      fmt.Sprintf("$(%s)", identifier),
+							"readOnly": true,
+						},
+					},
+					scratchDirPath,
+					filepath.Dir(dirValue),
+				)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualDirs).To(Equal(map[string]string{containerPath: dirValue}))
+				Expect(actualReadOnlyPaths).To(Equal([]string{containerPath}))
+			})
+		})
+	})
+	Context("mount is tmpfs", func() {
+		It("should return expected results", func() {
+			/* arrange */
+			containerPath := "/tmp"
+
+			/* act */
+			actualDirs, actualTmpfs, _, actualErr := Interpret(
+				map[string]*model.Value{},
+				map[string]interface{}{
+					containerPath: map[string]interface{}{
+						"tmpfs": map[string]interface{}{
+							"size": "64m",
+						},
+					},
+				},
+				"dummyScratchDirPath",
+				"dataDirPath",
+			)
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(actualDirs).To(BeEmpty())
+			Expect(actualTmpfs).To(Equal(map[string]int64{containerPath: 64 * 1024 * 1024}))
+		})
 	})
 })
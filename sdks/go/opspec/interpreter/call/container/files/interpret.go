@@ -3,6 +3,7 @@ package files
 import (
 	"fmt"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"os"
@@ -10,20 +11,35 @@ import (
 	"github.com/golang-utils/filecopier"
 	"github.com/opctl/opctl/sdks/go/model"
 	"github.com/opctl/opctl/sdks/go/opspec"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/boolean"
 	"github.com/opctl/opctl/sdks/go/opspec/interpreter/file"
 )
 
-// Interpret container files
+// Interpret container files; returns files & paths of files mounted read only
 func Interpret(
 	scope map[string]*model.Value,
 	containerCallSpecFiles map[string]interface{},
 	scratchDirPath string,
 	dataCachePath string,
-) (map[string]string, error) {
+) (map[string]string, []string, error) {
 	containerCallFiles := map[string]string{}
+	var readOnlyPaths []string
 fileLoop:
 	for callSpecContainerFilePath, fileExpression := range containerCallSpecFiles {
 
+		isReadOnly := false
+		if mountSpec := toMountSpec(fileExpression); mountSpec != nil {
+			if mountSpec.ReadOnly != nil {
+				readOnly, err := boolean.Interpret(scope, mountSpec.ReadOnly)
+				if err != nil {
+					return nil, nil, fmt.Errorf("unable to interpret readOnly of file %v: %w", callSpecContainerFilePath, err)
+				}
+				isReadOnly = *readOnly.Boolean
+			}
+
+			fileExpression = mountSpec.Ref
+		}
+
 		if fileExpression == nil {
 			// bound implicitly
 			fileExpression = opspec.NameToRef(callSpecContainerFilePath)
@@ -36,11 +52,15 @@ fileLoop:
 			true,
 		)
 		if err != nil {
-			return nil, fmt.Errorf("unable to bind file %v to %v: %w", callSpecContainerFilePath, fileExpression, err)
+			return nil, nil, fmt.Errorf("unable to bind file %v to %v: %w", callSpecContainerFilePath, fileExpression, err)
 		}
 
-		if !strings.HasPrefix(*fileValue.File, dataCachePath) {
-			// bound to non dataCachePath
+		if isReadOnly {
+			readOnlyPaths = append(readOnlyPaths, callSpecContainerFilePath)
+		}
+
+		if isReadOnly || !strings.HasPrefix(*fileValue.File, dataCachePath) {
+			// bound read only or to non dataCachePath
 			containerCallFiles[callSpecContainerFilePath] = *fileValue.File
 			continue fileLoop
 		}
@@ -53,7 +73,7 @@ fileLoop:
 			filepath.Dir(containerCallFiles[callSpecContainerFilePath]),
 			0777,
 		); err != nil {
-			return nil, fmt.Errorf("unable to bind %v to %v: %w", callSpecContainerFilePath, fileExpression, err)
+			return nil, nil, fmt.Errorf("unable to bind %v to %v: %w", callSpecContainerFilePath, fileExpression, err)
 		}
 
 		// copy file
@@ -63,9 +83,38 @@ fileLoop:
 			containerCallFiles[callSpecContainerFilePath],
 		)
 		if err != nil {
-			return nil, fmt.Errorf("unable to bind %v to %v: %w", callSpecContainerFilePath, fileExpression, err)
+			return nil, nil, fmt.Errorf("unable to bind %v to %v: %w", callSpecContainerFilePath, fileExpression, err)
 		}
 
 	}
-	return containerCallFiles, nil
+
+	// sort to make order deterministic
+	sort.Strings(readOnlyPaths)
+
+	return containerCallFiles, readOnlyPaths, nil
+}
+
+// toMountSpec returns a mount spec if expression is one, otherwise nil.
+// Since any object can initialize a file, only objects w/ exclusively "ref" &/or "readOnly" properties are mount specs.
+func toMountSpec(
+	expression interface{},
+) *model.ContainerCallMountSpec {
+	expressionMap, ok := expression.(map[string]interface{})
+	if !ok || len(expressionMap) == 0 {
+		return nil
+	}
+
+	mountSpec := &model.ContainerCallMountSpec{}
+	for key, value := range expressionMap {
+		switch key {
+		case "ref":
+			mountSpec.Ref = value
+		case "readOnly":
+			mountSpec.ReadOnly = value
+		default:
+			return nil
+		}
+	}
+
+	return mountSpec
 }
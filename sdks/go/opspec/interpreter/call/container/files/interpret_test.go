@@ -21,7 +21,7 @@ var _ = Context("Interpret", func() {
 			}
 
 			/* act */
-			_, actualErr := Interpret(
+			_, _, actualErr := Interpret(
 				map[string]*model.Value{
 					identifier: {Socket: new(string)},
 				},
@@ -54,7 +54,7 @@ var _ = Context("Interpret", func() {
 			}
 
 			/* act */
-			actualResult, actualErr := Interpret(
+			actualResult, _, actualErr := Interpret(
 				providedScope,
 				providedContainerCallSpecFiles,
 				"dummyScratchDirPath",
@@ -90,7 +90,7 @@ var _ = Context("Interpret", func() {
 			}
 
 			/* act */
-			actualResult, actualErr := Interpret(
+			actualResult, _, actualErr := Interpret(
 				map[string]*model.Value{
 					identifier: {
 						File: &referencedFilePath,
@@ -109,4 +109,40 @@ var _ = Context("Interpret", func() {
 			Expect(actualResult).To(Equal(expectedResult))
 		})
 	})
+	Context("mount is readOnly", func() {
+		It("should mount value.File w/out copying & return expected results", func() {
+			/* arrange */
+			identifier := "identifier"
+			containerFilePath := "/somewhere"
+
+			referencedFile, err := ioutil.TempFile("", "")
+			if err != nil {
+				panic(err)
+			}
+
+			referencedFilePath := referencedFile.Name()
+
+			/* act */
+			actualResult, actualReadOnlyPaths, actualErr := Interpret(
+				map[string]*model.Value{
+					identifier: {
+						File: &referencedFilePath,
+					},
+				},
+				map[string]interface{}{
+					containerFilePath: map[string]interface{}{
+						"ref":      fmt.Sprintf("$(%s)", identifier),
+						"readOnly": true,
+					},
+				},
+				"dummyScratchDirPath",
+				filepath.Dir(referencedFilePath),
+			)
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(actualResult).To(Equal(map[string]string{containerFilePath: referencedFilePath}))
+			Expect(actualReadOnlyPaths).To(Equal([]string{containerFilePath}))
+		})
+	})
 })
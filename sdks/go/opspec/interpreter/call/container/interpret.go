@@ -1,16 +1,22 @@
 package container
 
 import (
+	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
 
 	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/boolean"
 	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/container/cmd"
 	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/container/dirs"
 	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/container/envvars"
 	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/container/files"
 	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/container/image"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/container/readiness"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/container/resources"
 	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/container/sockets"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/file"
 	"github.com/opctl/opctl/sdks/go/opspec/interpreter/str"
 )
 
@@ -47,8 +53,51 @@ func Interpret(
 		return nil, err
 	}
 
-	// interpret cmd
 	var err error
+
+	// interpret allowNonZeroExit
+	if containerCallSpec.AllowNonZeroExit != nil {
+		allowNonZeroExit, err := boolean.Interpret(
+			scope,
+			containerCallSpec.AllowNonZeroExit,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to interpret allowNonZeroExit: %w", err)
+		}
+		containerCall.AllowNonZeroExit = *allowNonZeroExit.Boolean
+	}
+
+	// interpret cache
+	if containerCallSpec.Cache != nil {
+		cache, err := boolean.Interpret(
+			scope,
+			containerCallSpec.Cache,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to interpret cache: %w", err)
+		}
+		containerCall.Cache = *cache.Boolean
+	}
+
+	// interpret capAdd
+	for _, capAddEntryExpression := range containerCallSpec.CapAdd {
+		capAddEntry, err := str.Interpret(scope, capAddEntryExpression)
+		if err != nil {
+			return nil, fmt.Errorf("unable to interpret capAdd: %w", err)
+		}
+		containerCall.CapAdd = append(containerCall.CapAdd, *capAddEntry.String)
+	}
+
+	// interpret capDrop
+	for _, capDropEntryExpression := range containerCallSpec.CapDrop {
+		capDropEntry, err := str.Interpret(scope, capDropEntryExpression)
+		if err != nil {
+			return nil, fmt.Errorf("unable to interpret capDrop: %w", err)
+		}
+		containerCall.CapDrop = append(containerCall.CapDrop, *capDropEntry.String)
+	}
+
+	// interpret cmd
 	containerCall.Cmd, err = cmd.Interpret(
 		scope,
 		containerCallSpec.Cmd,
@@ -60,7 +109,8 @@ func Interpret(
 	dataCachePath := filepath.Join(dataDirPath, "ops")
 
 	// interpret dirs
-	containerCall.Dirs, err = dirs.Interpret(
+	var readOnlyDirPaths []string
+	containerCall.Dirs, containerCall.Tmpfs, readOnlyDirPaths, err = dirs.Interpret(
 		scope,
 		containerCallSpec.Dirs,
 		scratchDirPath,
@@ -80,7 +130,8 @@ func Interpret(
 	}
 
 	// interpret files
-	containerCall.Files, err = files.Interpret(
+	var readOnlyFilePaths []string
+	containerCall.Files, readOnlyFilePaths, err = files.Interpret(
 		scope,
 		containerCallSpec.Files,
 		scratchDirPath,
@@ -90,6 +141,8 @@ func Interpret(
 		return nil, err
 	}
 
+	containerCall.ReadOnlyPaths = append(readOnlyDirPaths, readOnlyFilePaths...)
+
 	// interpret image
 	containerCall.Image, err = image.Interpret(
 		scope,
@@ -100,6 +153,18 @@ func Interpret(
 		return nil, err
 	}
 
+	// interpret interactive
+	if containerCallSpec.Interactive != nil {
+		interactive, err := boolean.Interpret(
+			scope,
+			containerCallSpec.Interactive,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to interpret interactive: %w", err)
+		}
+		containerCall.Interactive = *interactive.Boolean
+	}
+
 	// interpret name as string
 	if containerCallSpec.Name != nil {
 		containerCallName, err := str.Interpret(
@@ -112,6 +177,60 @@ func Interpret(
 		containerCall.Name = containerCallName.String
 	}
 
+	// interpret privileged
+	if containerCallSpec.Privileged != nil {
+		privileged, err := boolean.Interpret(
+			scope,
+			containerCallSpec.Privileged,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to interpret privileged: %w", err)
+		}
+		containerCall.Privileged = privileged.Boolean
+	}
+
+	// interpret readOnlyRootFs
+	if containerCallSpec.ReadOnlyRootFs != nil {
+		readOnlyRootFs, err := boolean.Interpret(
+			scope,
+			containerCallSpec.ReadOnlyRootFs,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to interpret readOnlyRootFs: %w", err)
+		}
+		containerCall.ReadOnlyRootFs = *readOnlyRootFs.Boolean
+	}
+
+	// interpret readiness
+	containerCall.Readiness, err = readiness.Interpret(
+		scope,
+		containerCallSpec.Readiness,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to interpret readiness: %w", err)
+	}
+
+	// interpret resources
+	containerCall.Resources, err = resources.Interpret(
+		scope,
+		containerCallSpec.Resources,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to interpret resources: %w", err)
+	}
+
+	// interpret user
+	if containerCallSpec.User != nil {
+		user, err := str.Interpret(
+			scope,
+			*containerCallSpec.User,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to interpret user: %w", err)
+		}
+		containerCall.User = *user.String
+	}
+
 	// interpret workDir
 	if containerCallSpec.WorkDir != "" {
 		containerCallWorkDir, err := str.Interpret(
@@ -131,7 +250,28 @@ func Interpret(
 		containerCallSpec.Sockets,
 		scratchDirPath,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	// interpret stdin as file
+	if containerCallSpec.Stdin != nil {
+		if containerCall.Interactive {
+			return nil, errors.New("unable to interpret stdin: interactive containers receive std in from attached terminals")
+		}
+
+		stdin, err := file.Interpret(
+			scope,
+			containerCallSpec.Stdin,
+			scratchDirPath,
+			false,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to interpret stdin: %w", err)
+		}
+		containerCall.Stdin = stdin.File
+	}
 
-	return containerCall, err
+	return containerCall, nil
 
 }
@@ -10,6 +10,33 @@ import (
 )
 
 var _ = Context("Interpret", func() {
+	Context("boolean.Interpret errors", func() {
+		It("should return expected error", func() {
+			/* arrange */
+			dataDir, err := ioutil.TempDir("", "")
+			if err != nil {
+				panic(err)
+			}
+
+			/* act */
+			_, actualErr := Interpret(
+				map[string]*model.Value{},
+				&model.ContainerCallSpec{
+					Cache: "$()",
+					Image: &model.ContainerCallImageSpec{
+						Ref: "ref",
+					},
+				},
+				"dummyContainerID",
+				"dummyOpPath",
+				dataDir,
+			)
+
+			/* assert */
+			Expect(actualErr).To(MatchError("unable to interpret cache: unable to interpret $() to boolean: unable to interpret '' as reference: '' not in scope"))
+		})
+	})
+
 	Context("cmd.Interpret errors", func() {
 		It("should return expected error", func() {
 			/* arrange */
@@ -203,4 +230,146 @@ var _ = Context("Interpret", func() {
 		Expect(actualErr).To(BeNil())
 		Expect(*actualResult).To(Equal(expectedResult))
 	})
+	It("should return expected security options", func() {
+		/* arrange */
+		user := "1000:1000"
+
+		dataDir, err := ioutil.TempDir("", "")
+		if err != nil {
+			panic(err)
+		}
+
+		/* act */
+		actualResult, actualErr := Interpret(
+			map[string]*model.Value{},
+			&model.ContainerCallSpec{
+				CapAdd:  []interface{}{"NET_ADMIN"},
+				CapDrop: []interface{}{"ALL"},
+				Image: &model.ContainerCallImageSpec{
+					Ref: "ref",
+				},
+				Privileged:     true,
+				ReadOnlyRootFs: true,
+				User:           &user,
+			},
+			"dummyContainerID",
+			"dummyOpPath",
+			dataDir,
+		)
+
+		/* assert */
+		Expect(actualErr).To(BeNil())
+		Expect(actualResult.CapAdd).To(Equal([]string{"NET_ADMIN"}))
+		Expect(actualResult.CapDrop).To(Equal([]string{"ALL"}))
+		Expect(*actualResult.Privileged).To(BeTrue())
+		Expect(actualResult.ReadOnlyRootFs).To(BeTrue())
+		Expect(actualResult.User).To(Equal(user))
+	})
+	It("should return expected allowNonZeroExit", func() {
+		/* arrange */
+		dataDir, err := ioutil.TempDir("", "")
+		if err != nil {
+			panic(err)
+		}
+
+		/* act */
+		actualResult, actualErr := Interpret(
+			map[string]*model.Value{},
+			&model.ContainerCallSpec{
+				AllowNonZeroExit: "true",
+				Image: &model.ContainerCallImageSpec{
+					Ref: "ref",
+				},
+			},
+			"dummyContainerID",
+			"dummyOpPath",
+			dataDir,
+		)
+
+		/* assert */
+		Expect(actualErr).To(BeNil())
+		Expect(actualResult.AllowNonZeroExit).To(BeTrue())
+	})
+	It("should return expected stdin", func() {
+		/* arrange */
+		dataDir, err := ioutil.TempDir("", "")
+		if err != nil {
+			panic(err)
+		}
+
+		/* act */
+		actualResult, actualErr := Interpret(
+			map[string]*model.Value{},
+			&model.ContainerCallSpec{
+				Image: &model.ContainerCallImageSpec{
+					Ref: "ref",
+				},
+				Stdin: "input",
+			},
+			"dummyContainerID",
+			"dummyOpPath",
+			dataDir,
+		)
+
+		/* assert */
+		Expect(actualErr).To(BeNil())
+
+		actualStdin, err := ioutil.ReadFile(*actualResult.Stdin)
+		if err != nil {
+			panic(err)
+		}
+		Expect(string(actualStdin)).To(Equal("input"))
+	})
+	It("should return expected interactive", func() {
+		/* arrange */
+		dataDir, err := ioutil.TempDir("", "")
+		if err != nil {
+			panic(err)
+		}
+
+		/* act */
+		actualResult, actualErr := Interpret(
+			map[string]*model.Value{},
+			&model.ContainerCallSpec{
+				Image: &model.ContainerCallImageSpec{
+					Ref: "ref",
+				},
+				Interactive: true,
+			},
+			"dummyContainerID",
+			"dummyOpPath",
+			dataDir,
+		)
+
+		/* assert */
+		Expect(actualErr).To(BeNil())
+		Expect(actualResult.Interactive).To(BeTrue())
+	})
+	Context("interactive w/ stdin", func() {
+		It("should return expected error", func() {
+			/* arrange */
+			dataDir, err := ioutil.TempDir("", "")
+			if err != nil {
+				panic(err)
+			}
+
+			/* act */
+			_, actualErr := Interpret(
+				map[string]*model.Value{},
+				&model.ContainerCallSpec{
+					Image: &model.ContainerCallImageSpec{
+						Ref: "ref",
+					},
+					Interactive: true,
+					Stdin:       "input",
+				},
+				"dummyContainerID",
+				"dummyOpPath",
+				dataDir,
+			)
+
+			/* assert */
+			Expect(actualErr).To(MatchError("unable to interpret stdin: interactive containers receive std in from attached terminals"))
+		})
+	})
 })
@@ -0,0 +1,134 @@
+package readiness
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/number"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/str"
+)
+
+// defaultInterval is waited between probes if no interval is given
+const defaultInterval = "1s"
+
+// Interpret a container call readiness probe
+func Interpret(
+	scope map[string]*model.Value,
+	containerCallReadinessSpec *model.ContainerCallReadinessSpec,
+) (*model.ContainerCallReadiness, error) {
+	if containerCallReadinessSpec == nil {
+		return nil, nil
+	}
+
+	probeCount := 0
+	if len(containerCallReadinessSpec.Exec) > 0 {
+		probeCount++
+	}
+	if containerCallReadinessSpec.HTTPGet != nil {
+		probeCount++
+	}
+	if containerCallReadinessSpec.TCPPort != nil {
+		probeCount++
+	}
+	if probeCount != 1 {
+		return nil, errors.New("exactly one of exec, httpGet & tcpPort must be provided")
+	}
+
+	containerCallReadiness := &model.ContainerCallReadiness{
+		Interval: defaultInterval,
+	}
+
+	for _, execEntryExpression := range containerCallReadinessSpec.Exec {
+		execEntry, err := str.Interpret(scope, execEntryExpression)
+		if err != nil {
+			return nil, fmt.Errorf("unable to interpret exec: %w", err)
+		}
+		containerCallReadiness.Exec = append(containerCallReadiness.Exec, *execEntry.String)
+	}
+
+	if containerCallReadinessSpec.HTTPGet != nil {
+		port, err := interpretPort(scope, containerCallReadinessSpec.HTTPGet.Port)
+		if err != nil {
+			return nil, fmt.Errorf("unable to interpret httpGet port: %w", err)
+		}
+
+		containerCallReadiness.HTTPGet = &model.ContainerCallReadinessHTTPGet{
+			Path: "/",
+			Port: port,
+		}
+
+		if containerCallReadinessSpec.HTTPGet.Path != nil {
+			path, err := str.Interpret(scope, containerCallReadinessSpec.HTTPGet.Path)
+			if err != nil {
+				return nil, fmt.Errorf("unable to interpret httpGet path: %w", err)
+			}
+			containerCallReadiness.HTTPGet.Path = *path.String
+		}
+	}
+
+	if containerCallReadinessSpec.TCPPort != nil {
+		port, err := interpretPort(scope, containerCallReadinessSpec.TCPPort)
+		if err != nil {
+			return nil, fmt.Errorf("unable to interpret tcpPort: %w", err)
+		}
+		containerCallReadiness.TCPPort = &port
+	}
+
+	if containerCallReadinessSpec.Interval != nil {
+		interval, err := interpretDuration(scope, *containerCallReadinessSpec.Interval)
+		if err != nil {
+			return nil, fmt.Errorf("unable to interpret interval: %w", err)
+		}
+		containerCallReadiness.Interval = *interval
+	}
+
+	if containerCallReadinessSpec.Timeout != nil {
+		timeout, err := interpretDuration(scope, *containerCallReadinessSpec.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("unable to interpret timeout: %w", err)
+		}
+		containerCallReadiness.Timeout = timeout
+	}
+
+	return containerCallReadiness, nil
+}
+
+func interpretDuration(
+	scope map[string]*model.Value,
+	expression string,
+) (*string, error) {
+	duration, err := str.Interpret(
+		scope,
+		expression,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := time.ParseDuration(*duration.String); err != nil {
+		return nil, err
+	}
+
+	return duration.String, nil
+}
+
+func interpretPort(
+	scope map[string]*model.Value,
+	expression interface{},
+) (int, error) {
+	port, err := number.Interpret(
+		scope,
+		expression,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	if *port.Number < 1 || *port.Number > 65535 {
+		return 0, fmt.Errorf("must be between 1 & 65535; was %v", *port.Number)
+	}
+
+	return int(*port.Number), nil
+}
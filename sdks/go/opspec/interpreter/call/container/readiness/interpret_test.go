@@ -0,0 +1,120 @@
+package readiness
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+var _ = Context("Interpret", func() {
+	Context("containerCallReadinessSpec nil", func() {
+		It("should return nil", func() {
+			/* act */
+			actualResult, actualErr := Interpret(
+				map[string]*model.Value{},
+				nil,
+			)
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(actualResult).To(BeNil())
+		})
+	})
+	Context("multiple probes", func() {
+		It("should return expected error", func() {
+			/* act */
+			_, actualErr := Interpret(
+				map[string]*model.Value{},
+				&model.ContainerCallReadinessSpec{
+					Exec:    []interface{}{"true"},
+					TCPPort: 5432.0,
+				},
+			)
+
+			/* assert */
+			Expect(actualErr).To(MatchError("exactly one of exec, httpGet & tcpPort must be provided"))
+		})
+	})
+	Context("tcpPort out of range", func() {
+		It("should return expected error", func() {
+			/* act */
+			_, actualErr := Interpret(
+				map[string]*model.Value{},
+				&model.ContainerCallReadinessSpec{
+					TCPPort: 70000.0,
+				},
+			)
+
+			/* assert */
+			Expect(actualErr).To(MatchError("unable to interpret tcpPort: must be between 1 & 65535; was 70000"))
+		})
+	})
+	Context("interval invalid", func() {
+		It("should return expected error", func() {
+			/* arrange */
+			interval := "often"
+
+			/* act */
+			_, actualErr := Interpret(
+				map[string]*model.Value{},
+				&model.ContainerCallReadinessSpec{
+					Interval: &interval,
+					TCPPort:  5432.0,
+				},
+			)
+
+			/* assert */
+			Expect(actualErr).To(MatchError(`unable to interpret interval: time: invalid duration "often"`))
+		})
+	})
+	It("should return expected exec result", func() {
+		/* arrange */
+		user := "postgres"
+		providedScope := map[string]*model.Value{
+			"user": {String: &user},
+		}
+		timeout := "30s"
+
+		/* act */
+		actualResult, actualErr := Interpret(
+			providedScope,
+			&model.ContainerCallReadinessSpec{
+				Exec:    []interface{}{"pg_isready", "-U", "$(user)"},
+				Timeout: &timeout,
+			},
+		)
+
+		/* assert */
+		Expect(actualErr).To(BeNil())
+		Expect(*actualResult).To(Equal(model.ContainerCallReadiness{
+			Exec:     []string{"pg_isready", "-U", "postgres"},
+			Interval: "1s",
+			Timeout:  &timeout,
+		}))
+	})
+	It("should return expected httpGet result", func() {
+		/* arrange */
+		interval := "500ms"
+
+		/* act */
+		actualResult, actualErr := Interpret(
+			map[string]*model.Value{},
+			&model.ContainerCallReadinessSpec{
+				HTTPGet: &model.ContainerCallReadinessHTTPGetSpec{
+					Port: 8080.0,
+				},
+				Interval: &interval,
+			},
+		)
+
+		/* assert */
+		Expect(actualErr).To(BeNil())
+		Expect(*actualResult).To(Equal(model.ContainerCallReadiness{
+			HTTPGet: &model.ContainerCallReadinessHTTPGet{
+				Path: "/",
+				Port: 8080,
+			},
+			Interval: "500ms",
+		}))
+	})
+})
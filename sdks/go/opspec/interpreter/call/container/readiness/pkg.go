@@ -0,0 +1,2 @@
+// Package readiness exposes functionality for interpreting readiness probes of container calls.
+package readiness
@@ -0,0 +1,92 @@
+package resources
+
+import (
+	"fmt"
+
+	"github.com/docker/go-units"
+	"github.com/opctl/opctl/sdks/go/data/coerce"
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/number"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/value"
+)
+
+// Interpret container call resources
+func Interpret(
+	scope map[string]*model.Value,
+	containerCallResourcesSpec *model.ContainerCallResourcesSpec,
+) (*model.ContainerCallResources, error) {
+	if containerCallResourcesSpec == nil {
+		return nil, nil
+	}
+
+	containerCallResources := &model.ContainerCallResources{}
+
+	if containerCallResourcesSpec.Cpus != nil {
+		cpus, err := number.Interpret(scope, containerCallResourcesSpec.Cpus)
+		if err != nil {
+			return nil, fmt.Errorf("unable to interpret cpus: %w", err)
+		}
+		containerCallResources.Cpus = *cpus.Number
+	}
+
+	var err error
+	containerCallResources.Memory, err = InterpretBytes(scope, containerCallResourcesSpec.Memory)
+	if err != nil {
+		return nil, fmt.Errorf("unable to interpret memory: %w", err)
+	}
+
+	containerCallResources.MemorySwap, err = InterpretBytes(scope, containerCallResourcesSpec.MemorySwap)
+	if err != nil {
+		return nil, fmt.Errorf("unable to interpret memorySwap: %w", err)
+	}
+
+	if containerCallResourcesSpec.PidsLimit != nil {
+		pidsLimit, err := number.Interpret(scope, containerCallResourcesSpec.PidsLimit)
+		if err != nil {
+			return nil, fmt.Errorf("unable to interpret pidsLimit: %w", err)
+		}
+		containerCallResources.PidsLimit = int64(*pidsLimit.Number)
+	}
+
+	containerCallResources.ShmSize, err = InterpretBytes(scope, containerCallResourcesSpec.ShmSize)
+	if err != nil {
+		return nil, fmt.Errorf("unable to interpret shmSize: %w", err)
+	}
+
+	return containerCallResources, nil
+}
+
+// InterpretBytes interprets an expression to a number of bytes.
+// Numbers are taken as bytes; strings may include a unit e.g. 512m or 1g.
+func InterpretBytes(
+	scope map[string]*model.Value,
+	expression interface{},
+) (int64, error) {
+	if expression == nil {
+		return 0, nil
+	}
+
+	v, err := value.Interpret(
+		expression,
+		scope,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	if v.Number != nil {
+		return int64(*v.Number), nil
+	}
+
+	s, err := coerce.ToString(&v)
+	if err != nil {
+		return 0, err
+	}
+
+	if *s.String == "-1" {
+		// unlimited
+		return -1, nil
+	}
+
+	return units.RAMInBytes(*s.String)
+}
@@ -0,0 +1,66 @@
+package resources
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+var _ = Context("Interpret", func() {
+	Context("containerCallResourcesSpec nil", func() {
+		It("should return nil", func() {
+			/* act */
+			actualResult, actualErr := Interpret(
+				map[string]*model.Value{},
+				nil,
+			)
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(actualResult).To(BeNil())
+		})
+	})
+	Context("memory invalid", func() {
+		It("should return expected error", func() {
+			/* act */
+			_, actualErr := Interpret(
+				map[string]*model.Value{},
+				&model.ContainerCallResourcesSpec{
+					Memory: "lots",
+				},
+			)
+
+			/* assert */
+			Expect(actualErr).To(MatchError("unable to interpret memory: invalid size: 'lots'"))
+		})
+	})
+	It("should return expected result", func() {
+		/* arrange */
+		memory := "512m"
+		providedScope := map[string]*model.Value{
+			"memory": {String: &memory},
+		}
+
+		/* act */
+		actualResult, actualErr := Interpret(
+			providedScope,
+			&model.ContainerCallResourcesSpec{
+				Cpus:       1.5,
+				Memory:     "$(memory)",
+				MemorySwap: -1.0,
+				PidsLimit:  100.0,
+				ShmSize:    2.0,
+			},
+		)
+
+		/* assert */
+		Expect(actualErr).To(BeNil())
+		Expect(*actualResult).To(Equal(model.ContainerCallResources{
+			Cpus:       1.5,
+			Memory:     512 * 1024 * 1024,
+			MemorySwap: -1,
+			PidsLimit:  100,
+			ShmSize:    2,
+		}))
+	})
+})
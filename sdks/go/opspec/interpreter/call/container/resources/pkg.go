@@ -0,0 +1,2 @@
+// Package resources exposes functionality for interpreting resources of container calls.
+package resources
@@ -2,14 +2,29 @@ package call
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/boolean"
 	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/container"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/maxconcurrency"
 	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/op"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/parallel"
 	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/parallelloop"
 	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/predicates"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/retry"
 	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/serialloop"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/switchcall"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/str"
+)
+
+const (
+	// FinallyOutcomeVarName is the name of the variable the outcome of a call is bound to in scope of its finally calls
+	FinallyOutcomeVarName = "outcome"
+	// FinallyErrorVarName is the name of the variable the error of a failed call is bound to in scope of its finally calls
+	FinallyErrorVarName = "error"
 )
 
 //Interpret a spec into a call
@@ -24,11 +39,13 @@ func Interpret(
 	dataDirPath string,
 ) (*model.Call, error) {
 	call := &model.Call{
-		ID:       id,
-		Name:     callSpec.Name,
-		Needs:    callSpec.Needs,
-		ParentID: parentID,
-		RootID:   rootCallID,
+		After:      callSpec.After,
+		ID:         id,
+		Name:       callSpec.Name,
+		Needs:      callSpec.Needs,
+		NeedsReady: callSpec.NeedsReady,
+		ParentID:   parentID,
+		RootID:     rootCallID,
 	}
 	var err error
 
@@ -49,6 +66,82 @@ func Interpret(
 		}
 	}
 
+	if callSpec.Timeout != nil {
+		callTimeout, err := str.Interpret(
+			scope,
+			*callSpec.Timeout,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		timeout, err := time.ParseDuration(*callTimeout.String)
+		if err != nil {
+			return nil, fmt.Errorf("unable to interpret timeout: %w", err)
+		}
+
+		if timeout <= 0 {
+			return nil, fmt.Errorf("unable to interpret timeout: must be > 0; was %s", *callTimeout.String)
+		}
+
+		call.Timeout = callTimeout.String
+	}
+
+	if callSpec.ContinueOnError != nil {
+		continueOnError, err := boolean.Interpret(
+			scope,
+			callSpec.ContinueOnError,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to interpret continueOnError: %w", err)
+		}
+
+		call.ContinueOnError = *continueOnError.Boolean
+	}
+
+	if callSpec.Finally != nil {
+		if callSpec.Op == nil && callSpec.Serial == nil {
+			return nil, errors.New("unable to interpret finally: only applies to op & serial calls")
+		}
+
+		for _, varName := range []string{FinallyOutcomeVarName, FinallyErrorVarName} {
+			if _, ok := scope[varName]; ok {
+				return nil, fmt.Errorf("unable to interpret finally: '%s' already defined; finally calls bind it", varName)
+			}
+		}
+
+		call.Finally = *callSpec.Finally
+	}
+
+	if callSpec.Retry != nil {
+		if callSpec.Retry.On != nil && len(callSpec.Retry.On.ExitCodes) != 0 && callSpec.Container == nil {
+			// exit codes of descendant containers don't reach the call so could never match
+			return nil, errors.New("unable to interpret retry exitCodes: only applies to container calls; use errorMatches for other calls")
+		}
+
+		call.Retry, err = retry.Interpret(
+			callSpec.Retry,
+			scope,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if callSpec.MaxConcurrency != nil {
+		if callSpec.Parallel == nil {
+			return nil, errors.New("unable to interpret maxConcurrency: only applies to parallel calls; parallel loops take parallelLoop.maxConcurrency")
+		}
+
+		call.MaxConcurrency, err = maxconcurrency.Interpret(
+			callSpec.MaxConcurrency,
+			scope,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	switch {
 	case callSpec.Container != nil:
 		call.Container, err = container.Interpret(
@@ -70,8 +163,10 @@ func Interpret(
 		)
 		return call, err
 	case callSpec.Parallel != nil:
-		call.Parallel = *callSpec.Parallel
-		return call, nil
+		call.Parallel, err = parallel.Interpret(
+			*callSpec.Parallel,
+		)
+		return call, err
 	case callSpec.ParallelLoop != nil:
 		call.ParallelLoop, err = parallelloop.Interpret(
 			*callSpec.ParallelLoop,
@@ -87,6 +182,12 @@ func Interpret(
 			scope,
 		)
 		return call, err
+	case callSpec.Switch != nil:
+		call.Switch, err = switchcall.Interpret(
+			*callSpec.Switch,
+			scope,
+		)
+		return call, err
 	default:
 		return nil, fmt.Errorf("invalid call graph '%+v'", callSpec)
 	}
@@ -39,7 +39,442 @@ var _ = Context("Interpret", func() {
 				)
 
 				/* assert */
-				Expect(actualError).To(MatchError("unable to interpret predicate: predicate was unexpected type &{Eq:<nil> Exists:<nil> Ne:<nil> NotExists:<nil>}"))
+				Expect(actualError).To(MatchError("unable to interpret predicate: predicate was unexpected type &{AllOf:<nil> AnyOf:<nil> Contains:<nil> Eq:<nil> Exists:<nil> Gt:<nil> Gte:<nil> Lt:<nil> Lte:<nil> Matches:<nil> Ne:<nil> Not:<nil> NotExists:<nil>}"))
+			})
+		})
+	})
+	Context("callSpec.Timeout not nil", func() {
+		Context("timeout isn't a duration", func() {
+			It("should return expected result", func() {
+				/* arrange */
+				providedTimeout := "notADuration"
+				dataDir, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				/* act */
+				_, actualError := Interpret(
+					context.Background(),
+					map[string]*model.Value{},
+					&model.CallSpec{
+						Serial:  &[]*model.CallSpec{},
+						Timeout: &providedTimeout,
+					},
+					"providedID",
+					"dummyOpPath",
+					nil,
+					"providedRootCallID",
+					dataDir,
+				)
+
+				/* assert */
+				Expect(actualError).To(MatchError(`unable to interpret timeout: time: invalid duration "notADuration"`))
+			})
+		})
+		Context("timeout is zero", func() {
+			It("should return expected result", func() {
+				/* arrange */
+				providedTimeout := "0s"
+				dataDir, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				/* act */
+				_, actualError := Interpret(
+					context.Background(),
+					map[string]*model.Value{},
+					&model.CallSpec{
+						Serial:  &[]*model.CallSpec{},
+						Timeout: &providedTimeout,
+					},
+					"providedID",
+					"dummyOpPath",
+					nil,
+					"providedRootCallID",
+					dataDir,
+				)
+
+				/* assert */
+				Expect(actualError).To(MatchError("unable to interpret timeout: must be > 0; was 0s"))
+			})
+		})
+		Context("timeout is negative", func() {
+			It("should return expected result", func() {
+				/* arrange */
+				providedTimeout := "-1m"
+				dataDir, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				/* act */
+				_, actualError := Interpret(
+					context.Background(),
+					map[string]*model.Value{},
+					&model.CallSpec{
+						Serial:  &[]*model.CallSpec{},
+						Timeout: &providedTimeout,
+					},
+					"providedID",
+					"dummyOpPath",
+					nil,
+					"providedRootCallID",
+					dataDir,
+				)
+
+				/* assert */
+				Expect(actualError).To(MatchError("unable to interpret timeout: must be > 0; was -1m"))
+			})
+		})
+		Context("timeout is a duration", func() {
+			It("should return expected result", func() {
+				/* arrange */
+				timeoutName := "timeout"
+				providedTimeout := "$(timeout)"
+				expectedTimeout := "1m30s"
+				providedScope := map[string]*model.Value{
+					timeoutName: {String: &expectedTimeout},
+				}
+				providedID := "providedID"
+				providedRootCallID := "providedRootCallID"
+				dataDir, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				serialSpec := []*model.CallSpec{}
+
+				expectedCall := &model.Call{
+					ID:      providedID,
+					RootID:  providedRootCallID,
+					Serial:  serialSpec,
+					Timeout: &expectedTimeout,
+				}
+
+				/* act */
+				actualCall, actualError := Interpret(
+					context.Background(),
+					providedScope,
+					&model.CallSpec{
+						Serial:  &serialSpec,
+						Timeout: &providedTimeout,
+					},
+					providedID,
+					"dummyOpPath",
+					nil,
+					providedRootCallID,
+					dataDir,
+				)
+
+				/* assert */
+				Expect(actualError).To(BeNil())
+				Expect(*actualCall).To(Equal(*expectedCall))
+			})
+		})
+	})
+	Context("callSpec.Retry.On.ExitCodes not empty", func() {
+		Context("callSpec.Container nil", func() {
+			It("should return expected result", func() {
+				/* arrange */
+				dataDir, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				/* act */
+				_, actualError := Interpret(
+					context.Background(),
+					map[string]*model.Value{},
+					&model.CallSpec{
+						Retry: &model.RetrySpec{
+							MaxAttempts: 2,
+							On: &model.RetryOnSpec{
+								ExitCodes: []interface{}{75},
+							},
+						},
+						Serial: &[]*model.CallSpec{},
+					},
+					"providedID",
+					"dummyOpPath",
+					nil,
+					"providedRootCallID",
+					dataDir,
+				)
+
+				/* assert */
+				Expect(actualError).To(MatchError("unable to interpret retry exitCodes: only applies to container calls; use errorMatches for other calls"))
+			})
+		})
+		Context("callSpec.Container not nil", func() {
+			It("should return expected result", func() {
+				/* arrange */
+				dataDir, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				imageRef := "docker.io/library/alpine"
+
+				/* act */
+				actualCall, actualError := Interpret(
+					context.Background(),
+					map[string]*model.Value{},
+					&model.CallSpec{
+						Container: &model.ContainerCallSpec{
+							Image: &model.ContainerCallImageSpec{Ref: imageRef},
+						},
+						Retry: &model.RetrySpec{
+							MaxAttempts: 2,
+							On: &model.RetryOnSpec{
+								ExitCodes: []interface{}{75},
+							},
+						},
+					},
+					"providedID",
+					"dummyOpPath",
+					nil,
+					"providedRootCallID",
+					dataDir,
+				)
+
+				/* assert */
+				Expect(actualError).To(BeNil())
+				Expect(actualCall.Retry.On.ExitCodes).To(Equal([]int64{75}))
+			})
+		})
+	})
+	Context("callSpec.MaxConcurrency not nil", func() {
+		Context("callSpec.Parallel nil", func() {
+			It("should return expected result", func() {
+				/* arrange */
+				dataDir, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				/* act */
+				_, actualError := Interpret(
+					context.Background(),
+					map[string]*model.Value{},
+					&model.CallSpec{
+						MaxConcurrency: 2,
+						Serial:         &[]*model.CallSpec{},
+					},
+					"providedID",
+					"dummyOpPath",
+					nil,
+					"providedRootCallID",
+					dataDir,
+				)
+
+				/* assert */
+				Expect(actualError).To(MatchError("unable to interpret maxConcurrency: only applies to parallel calls; parallel loops take parallelLoop.maxConcurrency"))
+			})
+		})
+		Context("callSpec.Parallel not nil", func() {
+			It("should return expected result", func() {
+				/* arrange */
+				providedID := "providedID"
+				providedRootCallID := "providedRootCallID"
+				dataDir, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				parallelSpec := []*model.CallSpec{}
+				expectedMaxConcurrency := 2
+
+				expectedCall := &model.Call{
+					ID:             providedID,
+					MaxConcurrency: &expectedMaxConcurrency,
+					Parallel:       parallelSpec,
+					RootID:         providedRootCallID,
+				}
+
+				/* act */
+				actualCall, actualError := Interpret(
+					context.Background(),
+					map[string]*model.Value{},
+					&model.CallSpec{
+						MaxConcurrency: 2,
+						Parallel:       &parallelSpec,
+					},
+					providedID,
+					"dummyOpPath",
+					nil,
+					providedRootCallID,
+					dataDir,
+				)
+
+				/* assert */
+				Expect(actualError).To(BeNil())
+				Expect(*actualCall).To(Equal(*expectedCall))
+			})
+		})
+	})
+	Context("callSpec.ContinueOnError not nil", func() {
+		Context("continueOnError isn't a boolean", func() {
+			It("should return expected result", func() {
+				/* arrange */
+				dataDir, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				/* act */
+				_, actualError := Interpret(
+					context.Background(),
+					map[string]*model.Value{},
+					&model.CallSpec{
+						ContinueOnError: "$(doesNotExist)",
+						Serial:          &[]*model.CallSpec{},
+					},
+					"providedID",
+					"dummyOpPath",
+					nil,
+					"providedRootCallID",
+					dataDir,
+				)
+
+				/* assert */
+				Expect(actualError).To(MatchError(ContainSubstring("unable to interpret continueOnError:")))
+			})
+		})
+	})
+	Context("callSpec.Finally not nil", func() {
+		Context("callSpec.Op & callSpec.Serial nil", func() {
+			It("should return expected result", func() {
+				/* arrange */
+				dataDir, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				/* act */
+				_, actualError := Interpret(
+					context.Background(),
+					map[string]*model.Value{},
+					&model.CallSpec{
+						Finally:  &[]*model.CallSpec{},
+						Parallel: &[]*model.CallSpec{},
+					},
+					"providedID",
+					"dummyOpPath",
+					nil,
+					"providedRootCallID",
+					dataDir,
+				)
+
+				/* assert */
+				Expect(actualError).To(MatchError("unable to interpret finally: only applies to op & serial calls"))
+			})
+		})
+		Context("scope defines outcome", func() {
+			It("should return expected result", func() {
+				/* arrange */
+				dataDir, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				outcome := "dummyOutcome"
+
+				/* act */
+				_, actualError := Interpret(
+					context.Background(),
+					map[string]*model.Value{
+						"outcome": {String: &outcome},
+					},
+					&model.CallSpec{
+						Finally: &[]*model.CallSpec{},
+						Serial:  &[]*model.CallSpec{},
+					},
+					"providedID",
+					"dummyOpPath",
+					nil,
+					"providedRootCallID",
+					dataDir,
+				)
+
+				/* assert */
+				Expect(actualError).To(MatchError("unable to interpret finally: 'outcome' already defined; finally calls bind it"))
+			})
+		})
+		Context("scope defines error", func() {
+			It("should return expected result", func() {
+				/* arrange */
+				dataDir, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				errMessage := "dummyError"
+
+				/* act */
+				_, actualError := Interpret(
+					context.Background(),
+					map[string]*model.Value{
+						"error": {String: &errMessage},
+					},
+					&model.CallSpec{
+						Finally: &[]*model.CallSpec{},
+						Serial:  &[]*model.CallSpec{},
+					},
+					"providedID",
+					"dummyOpPath",
+					nil,
+					"providedRootCallID",
+					dataDir,
+				)
+
+				/* assert */
+				Expect(actualError).To(MatchError("unable to interpret finally: 'error' already defined; finally calls bind it"))
+			})
+		})
+		Context("callSpec.Serial not nil", func() {
+			It("should return expected result", func() {
+				/* arrange */
+				providedID := "providedID"
+				providedRootCallID := "providedRootCallID"
+				dataDir, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				serialSpec := []*model.CallSpec{}
+				finallySpec := []*model.CallSpec{
+					{Serial: &[]*model.CallSpec{}},
+				}
+
+				expectedCall := &model.Call{
+					ContinueOnError: true,
+					Finally:         finallySpec,
+					ID:              providedID,
+					RootID:          providedRootCallID,
+					Serial:          serialSpec,
+				}
+
+				/* act */
+				actualCall, actualError := Interpret(
+					context.Background(),
+					map[string]*model.Value{},
+					&model.CallSpec{
+						ContinueOnError: true,
+						Finally:         &finallySpec,
+						Serial:          &serialSpec,
+					},
+					providedID,
+					"dummyOpPath",
+					nil,
+					providedRootCallID,
+					dataDir,
+				)
+
+				/* assert */
+				Expect(actualError).To(BeNil())
+				Expect(*actualCall).To(Equal(*expectedCall))
 			})
 		})
 	})
@@ -251,4 +686,58 @@ var _ = Context("Interpret", func() {
 
 		})
 	})
+	Context("callSpec.Switch not empty", func() {
+		It("should return expected result", func() {
+			/* arrange */
+			providedScope := map[string]*model.Value{}
+			providedID := "providedID"
+			providedOpPath := "providedOpPath"
+			providedParentIDValue := "providedParentID"
+			providedParentID := &providedParentIDValue
+			providedRootCallID := "providedRootCallID"
+			providedDataDirPath, err := ioutil.TempDir("", "")
+			if err != nil {
+				panic(err)
+			}
+
+			expectedCaseIndex := 0
+
+			expectedCall := &model.Call{
+				ID:       providedID,
+				ParentID: providedParentID,
+				RootID:   providedRootCallID,
+				Switch: &model.SwitchCall{
+					CaseIndex: &expectedCaseIndex,
+				},
+			}
+
+			/* act */
+			actualCall, actualError := Interpret(
+				context.Background(),
+				providedScope,
+				&model.CallSpec{
+					Switch: &model.SwitchCallSpec{
+						Cases: []*model.SwitchCaseSpec{
+							{
+								If: []*model.PredicateSpec{},
+								Run: model.CallSpec{
+									Serial: &[]*model.CallSpec{},
+								},
+							},
+						},
+					},
+				},
+				providedID,
+				providedOpPath,
+				providedParentID,
+				providedRootCallID,
+				providedDataDirPath,
+			)
+
+			/* assert */
+			Expect(actualError).To(BeNil())
+			Expect(*actualCall).To(Equal(*expectedCall))
+
+		})
+	})
 })
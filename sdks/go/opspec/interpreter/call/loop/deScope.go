@@ -1,13 +1,93 @@
 package loop
 
 import (
+	"sort"
+
 	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/opspec"
 )
 
-// DeScope de-scopes loop vars (index, key, value)
+// DeScope de-scopes loop vars (index, key, value) & binds collected iteration outputs.
+// iterationOutputs must be in iteration order.
 func DeScope(
 	parentScope map[string]*model.Value,
-	callSpecLoopRange interface{},
+	loopRange *model.Value,
+	loopVarsSpec *model.LoopVarsSpec,
+	collectSpec map[string]string,
+	iterationScope map[string]*model.Value,
+	iterationOutputs []map[string]*model.Value,
+) map[string]*model.Value {
+	outboundScope := deScopeVars(
+		parentScope,
+		loopRange,
+		loopVarsSpec,
+		iterationScope,
+	)
+
+	if len(collectSpec) == 0 {
+		return outboundScope
+	}
+
+	collectedScope := map[string]*model.Value{}
+	for varName, varData := range outboundScope {
+		collectedScope[varName] = varData
+	}
+
+	for outputName, boundRef := range collectSpec {
+		boundName := outputName
+		if boundRef != "" {
+			boundName = opspec.RefToName(boundRef)
+		}
+
+		collectedScope[boundName] = collect(
+			outputName,
+			loopRange,
+			iterationOutputs,
+		)
+	}
+
+	return collectedScope
+}
+
+// collect gathers outputName from each iteration; iterations which didn't output it are skipped.
+// Loops over objects collect to an object keyed by loop key, all others to an array.
+func collect(
+	outputName string,
+	loopRange *model.Value,
+	iterationOutputs []map[string]*model.Value,
+) *model.Value {
+	if loopRange != nil && loopRange.Object != nil {
+		// iterations of objects are in order of sorted keys
+		keys := make([]string, 0, len(*loopRange.Object))
+		for key := range *loopRange.Object {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		collection := map[string]interface{}{}
+		for index, key := range keys {
+			if index >= len(iterationOutputs) {
+				break
+			}
+			if output, ok := iterationOutputs[index][outputName]; ok && output != nil {
+				collection[key] = *output
+			}
+		}
+		return &model.Value{Object: &collection}
+	}
+
+	collection := []interface{}{}
+	for _, outputs := range iterationOutputs {
+		if output, ok := outputs[outputName]; ok && output != nil {
+			collection = append(collection, *output)
+		}
+	}
+	return &model.Value{Array: &collection}
+}
+
+func deScopeVars(
+	parentScope map[string]*model.Value,
+	loopRange *model.Value,
 	loopVarsSpec *model.LoopVarsSpec,
 	iterationScope map[string]*model.Value,
 ) map[string]*model.Value {
@@ -25,7 +105,7 @@ func DeScope(
 		outboundScope[*loopVarsSpec.Index] = parentScope[*loopVarsSpec.Index]
 	}
 
-	if callSpecLoopRange != nil {
+	if loopRange != nil {
 		return outboundScope
 	}
 
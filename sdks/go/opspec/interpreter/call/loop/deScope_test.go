@@ -0,0 +1,145 @@
+package loop
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+var _ = Context("DeScope", func() {
+	Context("loopVarsSpec == nil", func() {
+		Context("collectSpec == nil", func() {
+			It("should return parentScope", func() {
+				/* arrange */
+				providedParentScope := map[string]*model.Value{
+					"name1": {String: new(string)},
+				}
+
+				/* act */
+				actualScope := DeScope(
+					providedParentScope,
+					nil,
+					nil,
+					nil,
+					map[string]*model.Value{},
+					nil,
+				)
+
+				/* assert */
+				Expect(actualScope).To(Equal(providedParentScope))
+			})
+		})
+	})
+	Context("loopVarsSpec != nil", func() {
+		It("should restore shadowed index", func() {
+			/* arrange */
+			indexName := "index"
+			parentIndex := 5.0
+			iterationIndex := 1.0
+			output := "output"
+
+			providedParentScope := map[string]*model.Value{
+				indexName: {Number: &parentIndex},
+			}
+
+			expectedScope := map[string]*model.Value{
+				indexName: {Number: &parentIndex},
+				output:    {String: &output},
+			}
+
+			/* act */
+			actualScope := DeScope(
+				providedParentScope,
+				nil,
+				&model.LoopVarsSpec{
+					Index: &indexName,
+				},
+				nil,
+				map[string]*model.Value{
+					indexName: {Number: &iterationIndex},
+					output:    {String: &output},
+				},
+				nil,
+			)
+
+			/* assert */
+			Expect(actualScope).To(Equal(expectedScope))
+		})
+	})
+	Context("collectSpec != nil", func() {
+		Context("loopRange is object", func() {
+			It("should collect to object keyed by loop key", func() {
+				/* arrange */
+				report1 := "report1"
+				report2 := "report2"
+
+				providedLoopRange := &model.Value{
+					Object: &map[string]interface{}{
+						"b": 2,
+						"a": 1,
+						"c": 3,
+					},
+				}
+
+				expectedCollection := map[string]interface{}{
+					"a": model.Value{File: &report1},
+					"c": model.Value{File: &report2},
+				}
+
+				/* act */
+				actualScope := DeScope(
+					map[string]*model.Value{},
+					providedLoopRange,
+					nil,
+					map[string]string{
+						"report": "$(reports)",
+					},
+					map[string]*model.Value{},
+					[]map[string]*model.Value{
+						{"report": {File: &report1}},
+						// iterations which don't output are skipped
+						{},
+						{"report": {File: &report2}},
+					},
+				)
+
+				/* assert */
+				Expect(*actualScope["reports"].Object).To(Equal(expectedCollection))
+			})
+		})
+		Context("loopRange isn't object", func() {
+			It("should collect to array in iteration order", func() {
+				/* arrange */
+				report1 := "report1"
+				report2 := "report2"
+
+				providedParentScope := map[string]*model.Value{}
+
+				expectedCollection := []interface{}{
+					model.Value{File: &report1},
+					model.Value{File: &report2},
+				}
+
+				/* act */
+				actualScope := DeScope(
+					providedParentScope,
+					nil,
+					nil,
+					map[string]string{
+						// implicit ref
+						"report": "",
+					},
+					map[string]*model.Value{},
+					[]map[string]*model.Value{
+						{"report": {File: &report1}},
+						{"report": {File: &report2}},
+					},
+				)
+
+				/* assert */
+				Expect(*actualScope["report"].Array).To(Equal(expectedCollection))
+				Expect(providedParentScope).To(BeEmpty())
+			})
+		})
+	})
+})
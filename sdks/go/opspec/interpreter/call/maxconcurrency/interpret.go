@@ -0,0 +1,34 @@
+package maxconcurrency
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/number"
+)
+
+// Interpret a max concurrency; nil is returned if maxConcurrencySpec is nil i.e. concurrency is unbounded
+func Interpret(
+	maxConcurrencySpec interface{},
+	scope map[string]*model.Value,
+) (*int, error) {
+	if maxConcurrencySpec == nil {
+		return nil, nil
+	}
+
+	maxConcurrency, err := number.Interpret(
+		scope,
+		maxConcurrencySpec,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to interpret maxConcurrency: %w", err)
+	}
+
+	if *maxConcurrency.Number < 1 || *maxConcurrency.Number != math.Trunc(*maxConcurrency.Number) {
+		return nil, fmt.Errorf("unable to interpret maxConcurrency: must be a whole number >= 1; was %v", *maxConcurrency.Number)
+	}
+
+	maxConcurrencyInt := int(*maxConcurrency.Number)
+	return &maxConcurrencyInt, nil
+}
@@ -0,0 +1,82 @@
+package maxconcurrency
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+var _ = Context("Interpret", func() {
+	Context("maxConcurrencySpec nil", func() {
+		It("should return nil", func() {
+			/* arrange */
+			/* act */
+			actualMaxConcurrency, actualErr := Interpret(
+				nil,
+				map[string]*model.Value{},
+			)
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(actualMaxConcurrency).To(BeNil())
+		})
+	})
+	Context("maxConcurrencySpec references number", func() {
+		It("should return expected result", func() {
+			/* arrange */
+			providedScope := map[string]*model.Value{
+				"concurrency": {Number: new(float64)},
+			}
+			*providedScope["concurrency"].Number = 3
+
+			/* act */
+			actualMaxConcurrency, actualErr := Interpret(
+				"$(concurrency)",
+				providedScope,
+			)
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(*actualMaxConcurrency).To(Equal(3))
+		})
+	})
+	Context("maxConcurrencySpec not a number", func() {
+		It("should return expected error", func() {
+			/* arrange */
+			/* act */
+			_, actualErr := Interpret(
+				"abc",
+				map[string]*model.Value{},
+			)
+
+			/* assert */
+			Expect(actualErr).To(MatchError(ContainSubstring("unable to interpret maxConcurrency:")))
+		})
+	})
+	Context("maxConcurrencySpec < 1", func() {
+		It("should return expected error", func() {
+			/* arrange */
+			/* act */
+			_, actualErr := Interpret(
+				0,
+				map[string]*model.Value{},
+			)
+
+			/* assert */
+			Expect(actualErr).To(MatchError("unable to interpret maxConcurrency: must be a whole number >= 1; was 0"))
+		})
+	})
+	Context("maxConcurrencySpec fractional", func() {
+		It("should return expected error", func() {
+			/* arrange */
+			/* act */
+			_, actualErr := Interpret(
+				1.5,
+				map[string]*model.Value{},
+			)
+
+			/* assert */
+			Expect(actualErr).To(MatchError("unable to interpret maxConcurrency: must be a whole number >= 1; was 1.5"))
+		})
+	})
+})
@@ -0,0 +1,4 @@
+// Package maxconcurrency exposes functionality for interpreting the max concurrency of parallel calls.
+package maxconcurrency
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
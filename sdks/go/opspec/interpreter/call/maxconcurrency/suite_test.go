@@ -0,0 +1,13 @@
+package maxconcurrency
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func Test(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "opspec/interpreter/call/maxconcurrency")
+}
@@ -0,0 +1,74 @@
+package parallel
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/opspec"
+)
+
+// Interpret a parallel call; calls referenced by after must be named siblings & mustn't form a cycle.
+// If calls statically bind the same output, the interpreted call is returned along w/ an OutputConflictsError
+// so callers may choose to only warn of the conflicts.
+func Interpret(
+	callSpecs []*model.CallSpec,
+) ([]*model.CallSpec, error) {
+	callSpecByName := map[string]*model.CallSpec{}
+	for _, callSpec := range callSpecs {
+		if callSpec.Name != nil {
+			callSpecByName[*callSpec.Name] = callSpec
+		}
+	}
+
+	for _, callSpec := range callSpecs {
+		for _, afterRef := range callSpec.After {
+			if _, ok := callSpecByName[opspec.RefToName(afterRef)]; !ok {
+				return nil, fmt.Errorf("unable to interpret after: no sibling call named '%s'", opspec.RefToName(afterRef))
+			}
+		}
+	}
+
+	// depth first search; calls on the current path are visiting, calls w/ no cycle below them are visited
+	isVisitingByName := map[string]bool{}
+	isVisitedByName := map[string]bool{}
+	var path []string
+
+	var visit func(name string) error
+	visit = func(name string) error {
+		path = append(path, name)
+		defer func() { path = path[:len(path)-1] }()
+
+		if isVisitingByName[name] {
+			return fmt.Errorf("unable to interpret after: cycle detected; %s", strings.Join(path, " -> "))
+		}
+		if isVisitedByName[name] {
+			return nil
+		}
+
+		isVisitingByName[name] = true
+		for _, afterRef := range callSpecByName[name].After {
+			if err := visit(opspec.RefToName(afterRef)); err != nil {
+				return err
+			}
+		}
+		isVisitingByName[name] = false
+		isVisitedByName[name] = true
+
+		return nil
+	}
+
+	for _, callSpec := range callSpecs {
+		if callSpec.Name != nil {
+			if err := visit(*callSpec.Name); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if conflicts := staticOutputConflicts(callSpecs); len(conflicts) != 0 {
+		return callSpecs, OutputConflictsError{CallLabelsByOutputName: conflicts}
+	}
+
+	return callSpecs, nil
+}
@@ -0,0 +1,106 @@
+package parallel
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+var _ = Context("Interpret", func() {
+	newCallSpec := func(name string, after ...string) *model.CallSpec {
+		return &model.CallSpec{
+			After: after,
+			Name:  &name,
+		}
+	}
+
+	Context("after references unknown call", func() {
+		It("should return expected result", func() {
+			/* arrange/act */
+			_, actualErr := Interpret(
+				[]*model.CallSpec{
+					newCallSpec("a", "b"),
+				},
+			)
+
+			/* assert */
+			Expect(actualErr).To(MatchError("unable to interpret after: no sibling call named 'b'"))
+		})
+	})
+	Context("after references own call", func() {
+		It("should return expected result", func() {
+			/* arrange/act */
+			_, actualErr := Interpret(
+				[]*model.CallSpec{
+					newCallSpec("a", "a"),
+				},
+			)
+
+			/* assert */
+			Expect(actualErr).To(MatchError("unable to interpret after: cycle detected; a -> a"))
+		})
+	})
+	Context("after forms cycle", func() {
+		It("should return expected result", func() {
+			/* arrange/act */
+			_, actualErr := Interpret(
+				[]*model.CallSpec{
+					newCallSpec("a"),
+					newCallSpec("b", "a", "d"),
+					newCallSpec("c", "b"),
+					newCallSpec("d", "c"),
+				},
+			)
+
+			/* assert */
+			Expect(actualErr).To(MatchError("unable to interpret after: cycle detected; b -> d -> c -> b"))
+		})
+	})
+	Context("after forms DAG", func() {
+		It("should return expected result", func() {
+			/* arrange */
+			providedCallSpecs := []*model.CallSpec{
+				newCallSpec("a"),
+				newCallSpec("b", "a"),
+				newCallSpec("c", "a", "b"),
+				{
+					// unnamed calls can be after others
+					After: []string{"c"},
+				},
+			}
+
+			/* act */
+			actualCallSpecs, actualErr := Interpret(providedCallSpecs)
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(actualCallSpecs).To(Equal(providedCallSpecs))
+		})
+	})
+	Context("calls statically bind same output", func() {
+		It("should return call specs & expected error", func() {
+			/* arrange */
+			outputRef := "$(output)"
+			firstName := "first"
+			providedCallSpecs := []*model.CallSpec{
+				{
+					Container: &model.ContainerCallSpec{ExitCode: &outputRef},
+					Name:      &firstName,
+				},
+				{
+					Container: &model.ContainerCallSpec{StdOut: &model.ContainerCallStdioSpec{String: &outputRef}},
+				},
+			}
+
+			/* act */
+			actualCallSpecs, actualErr := Interpret(providedCallSpecs)
+
+			/* assert */
+			Expect(actualErr).To(Equal(OutputConflictsError{
+				CallLabelsByOutputName: map[string][]string{"output": {"#1", firstName}},
+			}))
+			Expect(actualErr).To(MatchError("conflicting outputs; 'output' produced by calls '#1', 'first'"))
+			Expect(actualCallSpecs).To(Equal(providedCallSpecs))
+		})
+	})
+})
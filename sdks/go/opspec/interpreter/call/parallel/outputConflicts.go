@@ -0,0 +1,180 @@
+package parallel
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/opspec"
+)
+
+// OutputConflictsError is returned when more than one call produces the same output
+type OutputConflictsError struct {
+	// CallLabelsByOutputName holds labels of the calls producing each conflicting output
+	CallLabelsByOutputName map[string][]string
+}
+
+func (oce OutputConflictsError) Error() string {
+	outputNames := make([]string, 0, len(oce.CallLabelsByOutputName))
+	for outputName := range oce.CallLabelsByOutputName {
+		outputNames = append(outputNames, outputName)
+	}
+	sort.Strings(outputNames)
+
+	conflicts := make([]string, 0, len(outputNames))
+	for _, outputName := range outputNames {
+		conflicts = append(
+			conflicts,
+			fmt.Sprintf("'%s' produced by calls '%s'", outputName, strings.Join(oce.CallLabelsByOutputName[outputName], "', '")),
+		)
+	}
+
+	return fmt.Sprintf("conflicting outputs; %s", strings.Join(conflicts, "; "))
+}
+
+// CallLabel labels a child of a parallel call by name or, if unnamed, by index
+func CallLabel(
+	index int,
+	callSpec *model.CallSpec,
+) string {
+	if callSpec.Name != nil {
+		return *callSpec.Name
+	}
+	return fmt.Sprintf("#%d", index)
+}
+
+// OutputConflicts returns labels of the calls producing each output produced by more than one of callSpecs.
+// outputNamesByIndex holds names of the outputs produced by each call; names of paths (e.g. $(./)) aren't variables so are ignored.
+func OutputConflicts(
+	callSpecs []*model.CallSpec,
+	outputNamesByIndex []map[string]bool,
+) map[string][]string {
+	callLabelsByOutputName := map[string][]string{}
+	for index, outputNames := range outputNamesByIndex {
+		for outputName := range outputNames {
+			if strings.Contains(outputName, "/") {
+				continue
+			}
+			callLabelsByOutputName[outputName] = append(callLabelsByOutputName[outputName], CallLabel(index, callSpecs[index]))
+		}
+	}
+
+	conflicts := map[string][]string{}
+	for outputName, callLabels := range callLabelsByOutputName {
+		if len(callLabels) > 1 {
+			sort.Strings(callLabels)
+			conflicts[outputName] = callLabels
+		}
+	}
+	return conflicts
+}
+
+// staticOutputConflicts returns OutputConflicts of outputs bound statically by callSpecs.
+// Conditional calls may not run so are ignored; their conflicts are only known at run time.
+func staticOutputConflicts(
+	callSpecs []*model.CallSpec,
+) map[string][]string {
+	outputNamesByIndex := make([]map[string]bool, len(callSpecs))
+	for index, callSpec := range callSpecs {
+		outputNamesByIndex[index] = map[string]bool{}
+		addStaticOutputNames(callSpec, outputNamesByIndex[index])
+	}
+
+	return OutputConflicts(callSpecs, outputNamesByIndex)
+}
+
+func addStaticOutputNames(
+	callSpec *model.CallSpec,
+	outputNames map[string]bool,
+) {
+	if callSpec == nil || callSpec.If != nil {
+		return
+	}
+
+	switch {
+	case callSpec.Container != nil:
+		for _, mountSrc := range callSpec.Container.Dirs {
+			addMountOutputName(mountSrc, outputNames)
+		}
+		for _, mountSrc := range callSpec.Container.Files {
+			addMountOutputName(mountSrc, outputNames)
+		}
+		if callSpec.Container.ExitCode != nil {
+			outputNames[opspec.RefToName(*callSpec.Container.ExitCode)] = true
+		}
+		for _, stdioSpec := range []*model.ContainerCallStdioSpec{callSpec.Container.StdErr, callSpec.Container.StdOut} {
+			if stdioSpec == nil {
+				continue
+			}
+			if stdioSpec.File != nil {
+				outputNames[opspec.RefToName(*stdioSpec.File)] = true
+			}
+			if stdioSpec.String != nil {
+				outputNames[opspec.RefToName(*stdioSpec.String)] = true
+			}
+		}
+	case callSpec.Op != nil:
+		for boundName, boundValue := range callSpec.Op.Outputs {
+			if boundValue == "" {
+				// implicit value
+				outputNames[boundName] = true
+			} else if strings.HasPrefix(boundValue, "$(") {
+				outputNames[opspec.RefToName(boundValue)] = true
+			} else {
+				// obsolete syntax
+				outputNames[boundName] = true
+			}
+		}
+	case callSpec.Parallel != nil:
+		for _, childCallSpec := range *callSpec.Parallel {
+			addStaticOutputNames(childCallSpec, outputNames)
+		}
+	case callSpec.ParallelLoop != nil:
+		addStaticOutputNames(&callSpec.ParallelLoop.Run, outputNames)
+		addCollectOutputNames(callSpec.ParallelLoop.Collect, outputNames)
+	case callSpec.Serial != nil:
+		for _, childCallSpec := range *callSpec.Serial {
+			addStaticOutputNames(childCallSpec, outputNames)
+		}
+	case callSpec.SerialLoop != nil:
+		addStaticOutputNames(&callSpec.SerialLoop.Run, outputNames)
+		addCollectOutputNames(callSpec.SerialLoop.Collect, outputNames)
+	case callSpec.Switch != nil:
+		// which branch is called is only known at run time
+	}
+}
+
+// addMountOutputName adds the name of the variable a dir or file mount is bound back to, if any
+func addMountOutputName(
+	mountSrc interface{},
+	outputNames map[string]bool,
+) {
+	if mountSpec, ok := mountSrc.(map[string]interface{}); ok {
+		if readOnly, ok := mountSpec["readOnly"].(bool); ok && readOnly {
+			return
+		}
+		if _, ok := mountSpec["tmpfs"]; ok {
+			return
+		}
+		mountSrc = mountSpec["ref"]
+	}
+
+	if mountSrcStr, ok := mountSrc.(string); ok && strings.HasPrefix(mountSrcStr, "$(") {
+		outputNames[opspec.RefToName(mountSrcStr)] = true
+	}
+}
+
+// addCollectOutputNames adds the names of the variables collections of a loop are bound to
+func addCollectOutputNames(
+	collectSpec map[string]string,
+	outputNames map[string]bool,
+) {
+	for outputName, boundRef := range collectSpec {
+		if boundRef == "" {
+			outputNames[outputName] = true
+		} else {
+			outputNames[opspec.RefToName(boundRef)] = true
+		}
+	}
+}
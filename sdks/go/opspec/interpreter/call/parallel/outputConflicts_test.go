@@ -0,0 +1,99 @@
+package parallel
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+var _ = Context("OutputConflicts", func() {
+	It("should ignore names of paths", func() {
+		/* arrange/act */
+		actualConflicts := OutputConflicts(
+			[]*model.CallSpec{{}, {}},
+			[]map[string]bool{
+				{"./": true, "name": true},
+				{"./": true},
+			},
+		)
+
+		/* assert */
+		Expect(actualConflicts).To(BeEmpty())
+	})
+})
+
+var _ = Context("staticOutputConflicts", func() {
+	opName := "op"
+	Context("calls bind same output", func() {
+		It("should return expected result", func() {
+			/* arrange/act */
+			actualConflicts := staticOutputConflicts(
+				[]*model.CallSpec{
+					{
+						Container: &model.ContainerCallSpec{
+							Dirs: map[string]interface{}{
+								"/dir": "$(dir)",
+							},
+						},
+					},
+					{
+						Name: &opName,
+						Op: &model.OpCallSpec{
+							Outputs: map[string]string{
+								"opDir": "$(dir)",
+							},
+						},
+					},
+				},
+			)
+
+			/* assert */
+			Expect(actualConflicts).To(Equal(map[string][]string{"dir": {"#0", "op"}}))
+		})
+	})
+	Context("calls bind same output conditionally or read only", func() {
+		It("should return expected result", func() {
+			/* arrange/act */
+			actualConflicts := staticOutputConflicts(
+				[]*model.CallSpec{
+					{
+						Container: &model.ContainerCallSpec{
+							Dirs: map[string]interface{}{
+								"/dir": map[string]interface{}{
+									"ref":      "$(dir)",
+									"readOnly": true,
+								},
+							},
+						},
+					},
+					{
+						If: &[]*model.PredicateSpec{},
+						Serial: &[]*model.CallSpec{
+							{
+								Op: &model.OpCallSpec{
+									Outputs: map[string]string{
+										"dir": "",
+									},
+								},
+							},
+						},
+					},
+					{
+						Serial: &[]*model.CallSpec{
+							{
+								Op: &model.OpCallSpec{
+									Outputs: map[string]string{
+										"dir": "",
+									},
+								},
+							},
+						},
+					},
+				},
+			)
+
+			/* assert */
+			Expect(actualConflicts).To(BeEmpty())
+		})
+	})
+})
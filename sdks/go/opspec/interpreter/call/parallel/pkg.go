@@ -0,0 +1,2 @@
+// Package parallel exposes functionality for interpreting parallel calls.
+package parallel
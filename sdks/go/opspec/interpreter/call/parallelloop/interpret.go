@@ -2,6 +2,7 @@ package parallelloop
 
 import (
 	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/maxconcurrency"
 	"github.com/opctl/opctl/sdks/go/opspec/interpreter/loopable"
 )
 
@@ -12,6 +13,15 @@ func Interpret(
 ) (*model.ParallelLoopCall, error) {
 	parallelLoopCall := model.ParallelLoopCall{}
 
+	maxConcurrency, err := maxconcurrency.Interpret(
+		parallelLoopCallSpec.MaxConcurrency,
+		scope,
+	)
+	if err != nil {
+		return nil, err
+	}
+	parallelLoopCall.MaxConcurrency = maxConcurrency
+
 	loopRangeSpec := parallelLoopCallSpec.Range
 	if loopRangeSpec != nil {
 		dcgLoopRange, err := loopable.Interpret(
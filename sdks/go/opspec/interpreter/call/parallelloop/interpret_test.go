@@ -24,12 +24,29 @@ var _ = Context("Interpret", func() {
 			Expect(actualError).To(MatchError("unable to coerce string to object: invalid character 'r' looking for beginning of value"))
 		})
 	})
+	Context("maxconcurrency.Interpret errs", func() {
+		It("should return expected result", func() {
+			/* arrange */
+			/* act */
+			_, actualError := Interpret(
+				model.ParallelLoopCallSpec{
+					MaxConcurrency: 0,
+				},
+				map[string]*model.Value{},
+			)
+
+			/* assert */
+			Expect(actualError).To(MatchError("unable to interpret maxConcurrency: must be a whole number >= 1; was 0"))
+		})
+	})
 	It("should return expected result", func() {
 		/* arrange */
 		identifier := "identifier"
 		providedScgLoop := model.ParallelLoopCallSpec{
-			Range: fmt.Sprintf("$(%s)", identifier),
+			MaxConcurrency: 2,
+			Range:          fmt.Sprintf("$(%s)", identifier),
 		}
+		expectedMaxConcurrency := 2
 		providedScope := map[string]*model.Value{
 			identifier: {Array: new([]interface{})},
 		}
@@ -43,7 +60,8 @@ var _ = Context("Interpret", func() {
 		/* assert */
 		Expect(*actualResult).To(Equal(
 			model.ParallelLoopCall{
-				Range: providedScope[identifier],
+				MaxConcurrency: &expectedMaxConcurrency,
+				Range:          providedScope[identifier],
 			},
 		))
 	})
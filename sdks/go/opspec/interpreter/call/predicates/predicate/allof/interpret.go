@@ -0,0 +1,25 @@
+package allof
+
+import (
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+// Interpret an allOf predicate; true if all nested predicates are true.
+// interpretPredicate interprets nested predicates.
+func Interpret(
+	predicateSpecs []*model.PredicateSpec,
+	scope map[string]*model.Value,
+	interpretPredicate func(*model.PredicateSpec, map[string]*model.Value) (bool, error),
+) (bool, error) {
+	for _, predicateSpec := range predicateSpecs {
+		predicate, err := interpretPredicate(predicateSpec, scope)
+		if err != nil {
+			return false, err
+		}
+
+		if !predicate {
+			return false, nil
+		}
+	}
+	return true, nil
+}
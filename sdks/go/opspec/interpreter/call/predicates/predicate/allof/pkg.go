@@ -0,0 +1,4 @@
+// Package allof exposes functionality for interpreting an allOf predicate.
+package allof
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
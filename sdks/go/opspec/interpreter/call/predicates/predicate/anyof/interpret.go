@@ -0,0 +1,25 @@
+package anyof
+
+import (
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+// Interpret an anyOf predicate; true if any nested predicate is true.
+// interpretPredicate interprets nested predicates.
+func Interpret(
+	predicateSpecs []*model.PredicateSpec,
+	scope map[string]*model.Value,
+	interpretPredicate func(*model.PredicateSpec, map[string]*model.Value) (bool, error),
+) (bool, error) {
+	for _, predicateSpec := range predicateSpecs {
+		predicate, err := interpretPredicate(predicateSpec, scope)
+		if err != nil {
+			return false, err
+		}
+
+		if predicate {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,74 @@
+package anyof
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+var _ = Context("Interpret", func() {
+	truePredicateSpec := &model.PredicateSpec{}
+	falsePredicateSpec := &model.PredicateSpec{}
+	errPredicateSpec := &model.PredicateSpec{}
+	expectedErr := errors.New("expectedErr")
+
+	// stands in for predicate.Interpret
+	interpretPredicate := func(
+		predicateSpec *model.PredicateSpec,
+		scope map[string]*model.Value,
+	) (bool, error) {
+		switch predicateSpec {
+		case errPredicateSpec:
+			return false, expectedErr
+		case truePredicateSpec:
+			return true, nil
+		}
+		return false, nil
+	}
+	Context("any nested predicate true", func() {
+		It("should return expected result", func() {
+			/* arrange */
+			/* act */
+			actualResult, actualErr := Interpret(
+				[]*model.PredicateSpec{falsePredicateSpec, truePredicateSpec},
+				map[string]*model.Value{},
+				interpretPredicate,
+			)
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(actualResult).To(BeTrue())
+		})
+	})
+	Context("all nested predicates false", func() {
+		It("should return expected result", func() {
+			/* arrange */
+			/* act */
+			actualResult, actualErr := Interpret(
+				[]*model.PredicateSpec{falsePredicateSpec, falsePredicateSpec},
+				map[string]*model.Value{},
+				interpretPredicate,
+			)
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(actualResult).To(BeFalse())
+		})
+	})
+	Context("nested predicate errs", func() {
+		It("should return expected result", func() {
+			/* arrange */
+			/* act */
+			_, actualErr := Interpret(
+				[]*model.PredicateSpec{falsePredicateSpec, errPredicateSpec},
+				map[string]*model.Value{},
+				interpretPredicate,
+			)
+
+			/* assert */
+			Expect(actualErr).To(Equal(expectedErr))
+		})
+	})
+})
@@ -0,0 +1,4 @@
+// Package anyof exposes functionality for interpreting an anyOf predicate.
+package anyof
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
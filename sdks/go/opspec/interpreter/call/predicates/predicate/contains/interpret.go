@@ -0,0 +1,77 @@
+package contains
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/opctl/opctl/sdks/go/data/coerce"
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/str"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/value"
+)
+
+// Interpret a contains predicate; true if the first item (an array, object or string)
+// contains the second as an item, key or substring respectively
+func Interpret(
+	expressions []interface{},
+	scope map[string]*model.Value,
+) (bool, error) {
+	if len(expressions) != 2 {
+		return false, fmt.Errorf("unable to interpret contains: must have 2 items; had %d", len(expressions))
+	}
+
+	container, err := value.Interpret(expressions[0], scope)
+	if err != nil {
+		return false, fmt.Errorf("unable to interpret contains: %w", err)
+	}
+
+	// interpret item as string since everything is coercible to string
+	item, err := str.Interpret(scope, expressions[1])
+	if err != nil {
+		return false, err
+	}
+	itemAsString := *item.String
+
+	switch {
+	case container.Array != nil:
+		for _, arrayItem := range *container.Array {
+			arrayItemAsString, err := toString(arrayItem)
+			if err != nil {
+				return false, fmt.Errorf("unable to interpret contains: %w", err)
+			}
+			if arrayItemAsString == itemAsString {
+				return true, nil
+			}
+		}
+		return false, nil
+	case container.Object != nil:
+		_, ok := (*container.Object)[itemAsString]
+		return ok, nil
+	default:
+		containerAsString, err := coerce.ToString(&container)
+		if err != nil {
+			return false, fmt.Errorf("unable to interpret contains: %w", err)
+		}
+		return strings.Contains(*containerAsString.String, itemAsString), nil
+	}
+}
+
+// toString coerces an array item to a string; items are data so strings aren't interpolated
+func toString(
+	arrayItem interface{},
+) (string, error) {
+	if arrayItemAsString, ok := arrayItem.(string); ok {
+		return arrayItemAsString, nil
+	}
+
+	arrayItemValue, err := value.Interpret(arrayItem, map[string]*model.Value{})
+	if err != nil {
+		return "", err
+	}
+
+	arrayItemAsString, err := coerce.ToString(&arrayItemValue)
+	if err != nil {
+		return "", err
+	}
+	return *arrayItemAsString.String, nil
+}
@@ -0,0 +1,76 @@
+package contains
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+var _ = Context("Interpret", func() {
+	Context("expressions don't have 2 items", func() {
+		It("should return expected result", func() {
+			/* arrange */
+			/* act */
+			_, actualError := Interpret(
+				[]interface{}{"expression"},
+				map[string]*model.Value{},
+			)
+
+			/* assert */
+			Expect(actualError).To(MatchError("unable to interpret contains: must have 2 items; had 1"))
+		})
+	})
+	Context("first item is array", func() {
+		It("should return expected result", func() {
+			/* arrange */
+			/* act */
+			actualResult, actualError := Interpret(
+				[]interface{}{
+					"$(array)",
+					2,
+				},
+				map[string]*model.Value{
+					"array": {Array: &[]interface{}{"1", 2.0}},
+				},
+			)
+
+			/* assert */
+			Expect(actualResult).To(BeTrue())
+			Expect(actualError).To(BeNil())
+		})
+	})
+	Context("first item is object", func() {
+		It("should return expected result", func() {
+			/* arrange */
+			/* act */
+			actualResult, actualError := Interpret(
+				[]interface{}{
+					map[string]interface{}{"key": "value"},
+					"value",
+				},
+				map[string]*model.Value{},
+			)
+
+			/* assert */
+			Expect(actualResult).To(BeFalse())
+			Expect(actualError).To(BeNil())
+		})
+	})
+	Context("first item is string", func() {
+		It("should return expected result", func() {
+			/* arrange */
+			/* act */
+			actualResult, actualError := Interpret(
+				[]interface{}{
+					"release/1.0",
+					"release",
+				},
+				map[string]*model.Value{},
+			)
+
+			/* assert */
+			Expect(actualResult).To(BeTrue())
+			Expect(actualError).To(BeNil())
+		})
+	})
+})
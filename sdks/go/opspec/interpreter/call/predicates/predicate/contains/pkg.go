@@ -0,0 +1,4 @@
+// Package contains exposes functionality for interpreting a contains predicate.
+package contains
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
@@ -0,0 +1,4 @@
+// Package gt exposes functionality for interpreting a gt predicate.
+package gt
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
@@ -0,0 +1,4 @@
+// Package gte exposes functionality for interpreting a gte predicate.
+package gte
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
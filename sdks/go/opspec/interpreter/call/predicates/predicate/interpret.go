@@ -4,9 +4,18 @@ import (
 	"fmt"
 
 	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/predicates/predicate/allof"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/predicates/predicate/anyof"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/predicates/predicate/contains"
 	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/predicates/predicate/eq"
 	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/predicates/predicate/exists"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/predicates/predicate/gt"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/predicates/predicate/gte"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/predicates/predicate/lt"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/predicates/predicate/lte"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/predicates/predicate/matches"
 	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/predicates/predicate/ne"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/predicates/predicate/not"
 	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/predicates/predicate/notexists"
 )
 
@@ -16,6 +25,23 @@ func Interpret(
 	scope map[string]*model.Value,
 ) (bool, error) {
 	switch {
+	case predicateSpec.AllOf != nil:
+		return allof.Interpret(
+			*predicateSpec.AllOf,
+			scope,
+			Interpret,
+		)
+	case predicateSpec.AnyOf != nil:
+		return anyof.Interpret(
+			*predicateSpec.AnyOf,
+			scope,
+			Interpret,
+		)
+	case predicateSpec.Contains != nil:
+		return contains.Interpret(
+			*predicateSpec.Contains,
+			scope,
+		)
 	case predicateSpec.Eq != nil:
 		return eq.Interpret(
 			*predicateSpec.Eq,
@@ -26,11 +52,42 @@ func Interpret(
 			*predicateSpec.Exists,
 			scope,
 		)
+	case predicateSpec.Gt != nil:
+		return gt.Interpret(
+			*predicateSpec.Gt,
+			scope,
+		)
+	case predicateSpec.Gte != nil:
+		return gte.Interpret(
+			*predicateSpec.Gte,
+			scope,
+		)
+	case predicateSpec.Lt != nil:
+		return lt.Interpret(
+			*predicateSpec.Lt,
+			scope,
+		)
+	case predicateSpec.Lte != nil:
+		return lte.Interpret(
+			*predicateSpec.Lte,
+			scope,
+		)
+	case predicateSpec.Matches != nil:
+		return matches.Interpret(
+			*predicateSpec.Matches,
+			scope,
+		)
 	case predicateSpec.Ne != nil:
 		return ne.Interpret(
 			*predicateSpec.Ne,
 			scope,
 		)
+	case predicateSpec.Not != nil:
+		return not.Interpret(
+			predicateSpec.Not,
+			scope,
+			Interpret,
+		)
 	case predicateSpec.NotExists != nil:
 		return notexists.Interpret(
 			*predicateSpec.NotExists,
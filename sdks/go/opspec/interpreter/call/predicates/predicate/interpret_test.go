@@ -91,6 +91,92 @@ var _ = Context("Interpret", func() {
 			Expect(actualError).To(BeNil())
 		})
 	})
+	Context("AllOf predicate", func() {
+		It("should return expected result", func() {
+			/* arrange */
+			gtPredicate := []interface{}{"$(version)", 2}
+			matchesPredicate := []interface{}{"$(branch)", "^release/"}
+
+			/* act */
+			actualResult, actualError := Interpret(
+				&model.PredicateSpec{
+					AllOf: &[]*model.PredicateSpec{
+						{Gt: &gtPredicate},
+						{Matches: &matchesPredicate},
+					},
+				},
+				map[string]*model.Value{
+					"branch":  {String: &[]string{"release/1.0"}[0]},
+					"version": {Number: &[]float64{3}[0]},
+				},
+			)
+
+			/* assert */
+			Expect(actualResult).To(Equal(true))
+			Expect(actualError).To(BeNil())
+		})
+	})
+	Context("AnyOf predicate", func() {
+		It("should return expected result", func() {
+			/* arrange */
+			containsPredicate := []interface{}{"abc", "d"}
+			ltePredicate := []interface{}{1, 1}
+
+			/* act */
+			actualResult, actualError := Interpret(
+				&model.PredicateSpec{
+					AnyOf: &[]*model.PredicateSpec{
+						{Contains: &containsPredicate},
+						{Lte: &ltePredicate},
+					},
+				},
+				map[string]*model.Value{},
+			)
+
+			/* assert */
+			Expect(actualResult).To(Equal(true))
+			Expect(actualError).To(BeNil())
+		})
+	})
+	Context("Not predicate", func() {
+		Context("nested predicate errs", func() {
+			It("should return expected result", func() {
+				/* arrange */
+				ltPredicate := []interface{}{"notANumber", 1}
+
+				/* act */
+				_, actualError := Interpret(
+					&model.PredicateSpec{
+						Not: &model.PredicateSpec{
+							Lt: &ltPredicate,
+						},
+					},
+					map[string]*model.Value{},
+				)
+
+				/* assert */
+				Expect(actualError).To(MatchError(`unable to coerce string to number: strconv.ParseFloat: parsing "notANumber": invalid syntax`))
+			})
+		})
+		It("should return expected result", func() {
+			/* arrange */
+			gtePredicate := []interface{}{1, 2}
+
+			/* act */
+			actualResult, actualError := Interpret(
+				&model.PredicateSpec{
+					Not: &model.PredicateSpec{
+						Gte: &gtePredicate,
+					},
+				},
+				map[string]*model.Value{},
+			)
+
+			/* assert */
+			Expect(actualResult).To(Equal(true))
+			Expect(actualError).To(BeNil())
+		})
+	})
 	Context("Unexpected predicate", func() {
 		It("should return expected result", func() {
 			/* arrange */
@@ -0,0 +1,30 @@
+package lt
+
+import (
+	"fmt"
+
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/number"
+)
+
+// Interpret a lt predicate; true if the first item is less than the second
+func Interpret(
+	expressions []interface{},
+	scope map[string]*model.Value,
+) (bool, error) {
+	if len(expressions) != 2 {
+		return false, fmt.Errorf("unable to interpret lt: must have 2 items; had %d", len(expressions))
+	}
+
+	left, err := number.Interpret(scope, expressions[0])
+	if err != nil {
+		return false, err
+	}
+
+	right, err := number.Interpret(scope, expressions[1])
+	if err != nil {
+		return false, err
+	}
+
+	return *left.Number < *right.Number, nil
+}
@@ -0,0 +1,62 @@
+package lt
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+var _ = Context("Interpret", func() {
+	Context("expressions don't have 2 items", func() {
+		It("should return expected result", func() {
+			/* arrange */
+			/* act */
+			_, actualError := Interpret(
+				[]interface{}{1},
+				map[string]*model.Value{},
+			)
+
+			/* assert */
+			Expect(actualError).To(MatchError("unable to interpret lt: must have 2 items; had 1"))
+		})
+	})
+	Context("number.Interpret errs", func() {
+		It("should return expected result", func() {
+			/* arrange */
+			/* act */
+			_, actualError := Interpret(
+				[]interface{}{1, "notANumber"},
+				map[string]*model.Value{},
+			)
+
+			/* assert */
+			Expect(actualError).To(MatchError(`unable to coerce string to number: strconv.ParseFloat: parsing "notANumber": invalid syntax`))
+		})
+	})
+	Context("first item less than second", func() {
+		It("should return expected result", func() {
+			/* arrange */
+			/* act */
+			actualResult, _ := Interpret(
+				[]interface{}{1, "2"},
+				map[string]*model.Value{},
+			)
+
+			/* assert */
+			Expect(actualResult).To(BeTrue())
+		})
+	})
+	Context("first item not less than second", func() {
+		It("should return expected result", func() {
+			/* arrange */
+			/* act */
+			actualResult, _ := Interpret(
+				[]interface{}{2, 2},
+				map[string]*model.Value{},
+			)
+
+			/* assert */
+			Expect(actualResult).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,4 @@
+// Package lt exposes functionality for interpreting a lt predicate.
+package lt
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
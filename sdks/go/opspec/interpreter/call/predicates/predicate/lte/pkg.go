@@ -0,0 +1,4 @@
+// Package lte exposes functionality for interpreting a lte predicate.
+package lte
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
@@ -0,0 +1,37 @@
+package matches
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/str"
+)
+
+// Interpret a matches predicate; true if the first item matches the regular expression of the second
+func Interpret(
+	expressions []interface{},
+	scope map[string]*model.Value,
+) (bool, error) {
+	if len(expressions) != 2 {
+		return false, fmt.Errorf("unable to interpret matches: must have 2 items; had %d", len(expressions))
+	}
+
+	// interpret items as strings since everything is coercible to string
+	item, err := str.Interpret(scope, expressions[0])
+	if err != nil {
+		return false, err
+	}
+
+	pattern, err := str.Interpret(scope, expressions[1])
+	if err != nil {
+		return false, err
+	}
+
+	regex, err := regexp.Compile(*pattern.String)
+	if err != nil {
+		return false, fmt.Errorf("unable to interpret matches: %w", err)
+	}
+
+	return regex.MatchString(*item.String), nil
+}
@@ -0,0 +1,58 @@
+package matches
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+var _ = Context("Interpret", func() {
+	Context("regular expression invalid", func() {
+		It("should return expected result", func() {
+			/* arrange */
+			/* act */
+			_, actualError := Interpret(
+				[]interface{}{
+					"expression",
+					"(",
+				},
+				map[string]*model.Value{},
+			)
+
+			/* assert */
+			Expect(actualError).To(MatchError("unable to interpret matches: error parsing regexp: missing closing ): `(`"))
+		})
+	})
+	Context("item matches", func() {
+		It("should return expected result", func() {
+			/* arrange */
+			/* act */
+			actualResult, _ := Interpret(
+				[]interface{}{
+					"release/1.0",
+					"^release/",
+				},
+				map[string]*model.Value{},
+			)
+
+			/* assert */
+			Expect(actualResult).To(BeTrue())
+		})
+	})
+	Context("item doesn't match", func() {
+		It("should return expected result", func() {
+			/* arrange */
+			/* act */
+			actualResult, _ := Interpret(
+				[]interface{}{
+					"main",
+					"^release/",
+				},
+				map[string]*model.Value{},
+			)
+
+			/* assert */
+			Expect(actualResult).To(BeFalse())
+		})
+	})
+})
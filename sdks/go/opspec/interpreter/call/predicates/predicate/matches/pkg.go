@@ -0,0 +1,4 @@
+// Package matches exposes functionality for interpreting a matches predicate.
+package matches
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
@@ -0,0 +1,20 @@
+package not
+
+import (
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+// Interpret a not predicate; true if the nested predicate is false.
+// interpretPredicate interprets the nested predicate.
+func Interpret(
+	predicateSpec *model.PredicateSpec,
+	scope map[string]*model.Value,
+	interpretPredicate func(*model.PredicateSpec, map[string]*model.Value) (bool, error),
+) (bool, error) {
+	predicate, err := interpretPredicate(predicateSpec, scope)
+	if err != nil {
+		return false, err
+	}
+
+	return !predicate, nil
+}
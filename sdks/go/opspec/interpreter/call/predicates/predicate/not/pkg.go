@@ -0,0 +1,4 @@
+// Package not exposes functionality for interpreting a not predicate.
+package not
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
@@ -0,0 +1,100 @@
+package retry
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"time"
+
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/number"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/str"
+)
+
+// Interpret a retry policy
+func Interpret(
+	retrySpec *model.RetrySpec,
+	scope map[string]*model.Value,
+) (*model.Retry, error) {
+	maxAttempts, err := number.Interpret(
+		scope,
+		retrySpec.MaxAttempts,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to interpret retry maxAttempts: %w", err)
+	}
+
+	if *maxAttempts.Number < 1 || *maxAttempts.Number != math.Trunc(*maxAttempts.Number) {
+		return nil, fmt.Errorf("unable to interpret retry maxAttempts: must be a whole number >= 1; was %v", *maxAttempts.Number)
+	}
+
+	retry := &model.Retry{
+		MaxAttempts: int(*maxAttempts.Number),
+	}
+
+	if retrySpec.Delay != nil {
+		delay, err := str.Interpret(
+			scope,
+			*retrySpec.Delay,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := time.ParseDuration(*delay.String); err != nil {
+			return nil, fmt.Errorf("unable to interpret retry delay: %w", err)
+		}
+
+		retry.Delay = delay.String
+	}
+
+	if retrySpec.Backoff != nil {
+		backoff, err := number.Interpret(
+			scope,
+			retrySpec.Backoff,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to interpret retry backoff: %w", err)
+		}
+
+		if *backoff.Number < 1 {
+			return nil, fmt.Errorf("unable to interpret retry backoff: must be >= 1; was %v", *backoff.Number)
+		}
+
+		retry.Backoff = backoff.Number
+	}
+
+	if retrySpec.On != nil {
+		retry.On = &model.RetryOn{}
+
+		if retrySpec.On.ErrorMatches != nil {
+			errorMatches, err := str.Interpret(
+				scope,
+				*retrySpec.On.ErrorMatches,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			if _, err := regexp.Compile(*errorMatches.String); err != nil {
+				return nil, fmt.Errorf("unable to interpret retry errorMatches: %w", err)
+			}
+
+			retry.On.ErrorMatches = errorMatches.String
+		}
+
+		for _, exitCodeExpression := range retrySpec.On.ExitCodes {
+			exitCode, err := number.Interpret(
+				scope,
+				exitCodeExpression,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to interpret retry exitCodes: %w", err)
+			}
+
+			retry.On.ExitCodes = append(retry.On.ExitCodes, int64(*exitCode.Number))
+		}
+	}
+
+	return retry, nil
+}
@@ -0,0 +1,127 @@
+package retry
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+var _ = Context("Interpret", func() {
+	Context("maxAttempts < 1", func() {
+		It("should return expected result", func() {
+			/* arrange/act */
+			_, actualErr := Interpret(
+				&model.RetrySpec{
+					MaxAttempts: 0,
+				},
+				map[string]*model.Value{},
+			)
+
+			/* assert */
+			Expect(actualErr).To(MatchError("unable to interpret retry maxAttempts: must be a whole number >= 1; was 0"))
+		})
+	})
+	Context("maxAttempts isn't a whole number", func() {
+		It("should return expected result", func() {
+			/* arrange/act */
+			_, actualErr := Interpret(
+				&model.RetrySpec{
+					MaxAttempts: 2.7,
+				},
+				map[string]*model.Value{},
+			)
+
+			/* assert */
+			Expect(actualErr).To(MatchError("unable to interpret retry maxAttempts: must be a whole number >= 1; was 2.7"))
+		})
+	})
+	Context("backoff < 1", func() {
+		It("should return expected result", func() {
+			/* arrange/act */
+			_, actualErr := Interpret(
+				&model.RetrySpec{
+					MaxAttempts: 2,
+					Backoff:     0.5,
+				},
+				map[string]*model.Value{},
+			)
+
+			/* assert */
+			Expect(actualErr).To(MatchError("unable to interpret retry backoff: must be >= 1; was 0.5"))
+		})
+	})
+	Context("delay isn't a duration", func() {
+		It("should return expected result", func() {
+			/* arrange */
+			providedDelay := "notADuration"
+
+			/* act */
+			_, actualErr := Interpret(
+				&model.RetrySpec{
+					MaxAttempts: 2,
+					Delay:       &providedDelay,
+				},
+				map[string]*model.Value{},
+			)
+
+			/* assert */
+			Expect(actualErr).To(MatchError(`unable to interpret retry delay: time: invalid duration "notADuration"`))
+		})
+	})
+	Context("on.errorMatches isn't a regular expression", func() {
+		It("should return expected result", func() {
+			/* arrange */
+			providedErrorMatches := "("
+
+			/* act */
+			_, actualErr := Interpret(
+				&model.RetrySpec{
+					MaxAttempts: 2,
+					On: &model.RetryOnSpec{
+						ErrorMatches: &providedErrorMatches,
+					},
+				},
+				map[string]*model.Value{},
+			)
+
+			/* assert */
+			Expect(actualErr).To(MatchError("unable to interpret retry errorMatches: error parsing regexp: missing closing ): `(`"))
+		})
+	})
+	It("should return expected result", func() {
+		/* arrange */
+		maxAttemptsName := "maxAttempts"
+		maxAttempts := float64(3)
+		providedDelay := "5s"
+		providedErrorMatches := "connection reset"
+		expectedBackoff := float64(2)
+
+		/* act */
+		actualRetry, actualErr := Interpret(
+			&model.RetrySpec{
+				MaxAttempts: "$(maxAttempts)",
+				Delay:       &providedDelay,
+				Backoff:     2,
+				On: &model.RetryOnSpec{
+					ErrorMatches: &providedErrorMatches,
+					ExitCodes:    []interface{}{1, "137"},
+				},
+			},
+			map[string]*model.Value{
+				maxAttemptsName: {Number: &maxAttempts},
+			},
+		)
+
+		/* assert */
+		Expect(actualErr).To(BeNil())
+		Expect(*actualRetry).To(Equal(model.Retry{
+			MaxAttempts: 3,
+			Delay:       &providedDelay,
+			Backoff:     &expectedBackoff,
+			On: &model.RetryOn{
+				ErrorMatches: &providedErrorMatches,
+				ExitCodes:    []int64{1, 137},
+			},
+		}))
+	})
+})
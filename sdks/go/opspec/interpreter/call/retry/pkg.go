@@ -0,0 +1,4 @@
+// Package retry exposes functionality for interpreting call retry policies.
+package retry
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
@@ -0,0 +1,34 @@
+package switchcall
+
+import (
+	"fmt"
+
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/predicates"
+)
+
+//Interpret a switch; cases are interpreted in order until one's predicates are true
+func Interpret(
+	switchCallSpec model.SwitchCallSpec,
+	scope map[string]*model.Value,
+) (*model.SwitchCall, error) {
+	dcgSwitch := model.SwitchCall{}
+
+	for caseIndex, caseSpec := range switchCallSpec.Cases {
+		isCase, err := predicates.Interpret(
+			caseSpec.If,
+			scope,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to interpret case %d of switch: %w", caseIndex, err)
+		}
+
+		if isCase {
+			caseIndex := caseIndex
+			dcgSwitch.CaseIndex = &caseIndex
+			break
+		}
+	}
+
+	return &dcgSwitch, nil
+}
@@ -0,0 +1,92 @@
+package switchcall
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+var _ = Context("Interpret", func() {
+	env := "staging"
+	scope := map[string]*model.Value{
+		"env": {String: &env},
+	}
+
+	Context("case predicates true", func() {
+		It("should return expected result", func() {
+			/* arrange */
+			expectedCaseIndex := 1
+
+			/* act */
+			actualSwitch, actualErr := Interpret(
+				model.SwitchCallSpec{
+					Cases: []*model.SwitchCaseSpec{
+						{
+							If: []*model.PredicateSpec{
+								{Eq: &[]interface{}{"$(env)", "prod"}},
+							},
+						},
+						{
+							If: []*model.PredicateSpec{
+								{Eq: &[]interface{}{"$(env)", "staging"}},
+							},
+						},
+						{
+							// later true cases aren't called
+							If: []*model.PredicateSpec{
+								{Ne: &[]interface{}{"$(env)", "prod"}},
+							},
+						},
+					},
+				},
+				scope,
+			)
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(*actualSwitch).To(Equal(model.SwitchCall{CaseIndex: &expectedCaseIndex}))
+		})
+	})
+	Context("no case predicates true", func() {
+		It("should return expected result", func() {
+			/* arrange/act */
+			actualSwitch, actualErr := Interpret(
+				model.SwitchCallSpec{
+					Cases: []*model.SwitchCaseSpec{
+						{
+							If: []*model.PredicateSpec{
+								{Eq: &[]interface{}{"$(env)", "prod"}},
+							},
+						},
+					},
+					Default: &model.CallSpec{},
+				},
+				scope,
+			)
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(*actualSwitch).To(Equal(model.SwitchCall{}))
+		})
+	})
+	Context("case predicates err", func() {
+		It("should return expected error", func() {
+			/* arrange/act */
+			_, actualErr := Interpret(
+				model.SwitchCallSpec{
+					Cases: []*model.SwitchCaseSpec{
+						{
+							If: []*model.PredicateSpec{
+								{Eq: &[]interface{}{"$(notInScope)", "prod"}},
+							},
+						},
+					},
+				},
+				scope,
+			)
+
+			/* assert */
+			Expect(actualErr).To(MatchError(HavePrefix("unable to interpret case 0 of switch: ")))
+		})
+	})
+})
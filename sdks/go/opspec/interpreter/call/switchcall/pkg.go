@@ -0,0 +1,4 @@
+// Package switchcall exposes functionality for interpreting a switch call.
+package switchcall
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
@@ -0,0 +1,48 @@
+package call
+
+import (
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/parallel"
+)
+
+// Validate a call spec & its descendants w/out interpreting them; only problems known before run time are reported.
+// Descendants of op calls are defined by other ops so aren't validated.
+func Validate(
+	callSpec *model.CallSpec,
+) error {
+	if callSpec == nil {
+		return nil
+	}
+
+	childCallSpecs := []*model.CallSpec{}
+	if callSpec.Finally != nil {
+		childCallSpecs = append(childCallSpecs, *callSpec.Finally...)
+	}
+
+	switch {
+	case callSpec.Parallel != nil:
+		if _, err := parallel.Interpret(*callSpec.Parallel); err != nil {
+			return err
+		}
+		childCallSpecs = append(childCallSpecs, *callSpec.Parallel...)
+	case callSpec.ParallelLoop != nil:
+		childCallSpecs = append(childCallSpecs, &callSpec.ParallelLoop.Run)
+	case callSpec.Serial != nil:
+		childCallSpecs = append(childCallSpecs, *callSpec.Serial...)
+	case callSpec.SerialLoop != nil:
+		childCallSpecs = append(childCallSpecs, &callSpec.SerialLoop.Run)
+	case callSpec.Switch != nil:
+		for _, caseSpec := range callSpec.Switch.Cases {
+			childCallSpecs = append(childCallSpecs, &caseSpec.Run)
+		}
+		childCallSpecs = append(childCallSpecs, callSpec.Switch.Default)
+	}
+
+	for _, childCallSpec := range childCallSpecs {
+		if err := Validate(childCallSpec); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package call
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+var _ = Context("Validate", func() {
+	outputRef := "$(output)"
+	newConflictingCallSpecs := func() *[]*model.CallSpec {
+		return &[]*model.CallSpec{
+			{Container: &model.ContainerCallSpec{ExitCode: &outputRef}},
+			{Container: &model.ContainerCallSpec{ExitCode: &outputRef}},
+		}
+	}
+	expectedErr := "conflicting outputs; 'output' produced by calls '#0', '#1'"
+
+	Context("parallel call binds same output", func() {
+		It("should return expected result", func() {
+			/* arrange/act */
+			actualErr := Validate(
+				&model.CallSpec{
+					Parallel: newConflictingCallSpecs(),
+				},
+			)
+
+			/* assert */
+			Expect(actualErr).To(MatchError(expectedErr))
+		})
+	})
+	Context("descendant parallel call binds same output", func() {
+		It("should return expected result", func() {
+			/* arrange/act */
+			actualErr := Validate(
+				&model.CallSpec{
+					Serial: &[]*model.CallSpec{
+						{
+							Switch: &model.SwitchCallSpec{
+								Cases: []*model.SwitchCaseSpec{
+									{
+										Run: model.CallSpec{
+											Parallel: newConflictingCallSpecs(),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			)
+
+			/* assert */
+			Expect(actualErr).To(MatchError(expectedErr))
+		})
+	})
+	Context("calls valid", func() {
+		It("should return expected result", func() {
+			/* arrange/act */
+			actualErr := Validate(
+				&model.CallSpec{
+					Parallel: &[]*model.CallSpec{
+						{Container: &model.ContainerCallSpec{ExitCode: &outputRef}},
+						{Op: &model.OpCallSpec{Ref: "dummyOpRef"}},
+					},
+				},
+			)
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+		})
+	})
+})
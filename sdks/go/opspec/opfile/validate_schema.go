@@ -212,79 +212,121 @@ var _escData = map[string]*_escFile{
 	"/opspec/opfile/jsonschema.json": {
 		name:    "jsonschema.json",
 		local:   "../../../../opspec/opfile/jsonschema.json",
-		size:    36027,
-		modtime: 1628286479,
+		size:    54088,
+		modtime: 1792228997,
 		compressed: `
-H4sIAAAAAAAC/+w9a3PcNpLf9StQE9dac9ZwJD+UjbZcLp/i5HQVx6n4sVWrUbwQ2aPBigRoABxJ8em/
-XwHg8Ak+h7QV29+kIQB2NxqNfvPjDkKTe8JdQYAnR2iykjI8ms//IxidmV8dxi/mHsdLOdv/fm5++26y
-p+ZJIn1Qs16FrvQRC0UILmKheeqBcDkJJWFUjfkRloSCQJhmRiwJJWqAmBwhBQpCE8w5vjlmVEiOCZXp
-k+wLS4P2kiE3oR7Bzv8Drkx/DzkLgUsC2QXV6zxPQ4D9EwlB/mEZif99/epX9FrTAJ0WpqJLuLli3Dvb
-VUQUR/O5ZMwXDgG51ERcycCPKXnFycVKzjJknq2xTzys1pvtH3wnwNV/HjoH+9MECw3SPQ5LBct38wz9
-5grvLEGSGbfp5AnpiiIZEbEfCnhhevNKIXaa+RHlQO2DfoEE1iVtZOn/NoRud6r+O7NuS4CvOzPfZs6A
-m7OfbM6TMtdtzhWhEi6A5x8GhJIgCiZHaN+OIKHdEYznjIXgwZAIRpR8iKAzjplpY0mPRxVonjPmA6YZ
-ObFTQCsjGn/LCs8l9gXsZIYaafziOuQghEEzkdgF7NNB6GpF3BWCNfYjLEEgydTVoJcqSfPTjLzODUBo
-IiQn9GKDx1kOsBjJIUBDG4LVwFYc0gAdDAGW+g86EcwCJY2C8xzLF6/PBkyIB1SSJQFeg8lzZJZAAi8B
-LRlHkQCEtUaQWaB0k8cvTm9yLCVwveQfp7P3ePbn89m/9mc/nD24N8lB5TMW4nMfBtn+zWJIgYV2NVUR
-48gQatppB7oRV735d0wvoAZ6/RyxJZIr0KDuIeKAo//VHKKQUL8jts7S2H69WQhXgugd5jnVrE5Y7FVA
-/Q5zot4jEPY88BSMwmUhIEYRYHeFiASuhVqzHkeoB9cNsnfzvsLiAmEhmEuwBA/pddAV8X10DijAHiC8
-xsQ3e7/iLLpYtdHH1vG7foclcKAu2DWyS7gZAOhLuPl0IBuJsz3Qhi/HBbt0pxXMgxxbB5hfeuyKWi2O
-5GEVM7+MByBC0el633n4d3TMgoBR9QCJGyrxtbncj+ZzZSg5rn6sFtYXvJoynyJCXT/ylJz8+aeXSBoq
-XkugIncOCtIxh4gR5002VHlUfyPK97Xq3t50UhNG0uwePqxQeYpyuFLzr2C0MsF2bFp+9qxsbJr2hFET
-xiLMo7tDGKBam25NFzV+LLLsb02WzYxYj2rEfsl4gGURf0ahjQGcHGCbpWJVDuBDRLhSZFaADIhK4iq1
-q2qFCt2ruH2nZYN5s2ThyVl3kzg2uLpYxGrKWFxSwSTFLS+YvZ2RMFNGQuJxHyQiX5LQh25yLJ01lv3e
-AxXKZBccKJNjMdOTVj69GrmaRWsjN1ojpieMhdrjz3zJ9HdjmDcMYi0WDGqLUVg2uetsQKOINWl05VED
-uMV/s49o7RtP53fhuBWmHocr0YLnDp0nzmGB6dpepVXOsDZe4z6O6MZ77+vQpMuM+k2T7ksYD0KgHlC3
-4wnNzhvrjv6h37H8tCEemyXdP5bzRRo2RT1/QiPfL1sFWaeKTfG/rVL1+90xuYljsfCjgeNgPVHNThwL
-1cdDovpX07VrJO/Xp2v3uIbCXnwdjs7UhxUkKsVg2im9fW6nCoKZMFY/eVCaPBb5vr+r5OPGqeV1odpm
-zljEejKYI7Gsiwxi4ZpdGyZSb9Zy0MkShZytiQdeHMw1T/ZQfLhvEMUBCPQ3E/ERSchH3Ss8ZD6W4NWZ
-yt3CpSHmONg6NPmbWgUkcIHYMpOz1uLoTvJB6Z5e3gx/W7yshqPqfax727zAahEP+wqP8DGXXxIfxly/
-7Osf+g02dXrYNwjmXsK4b7AaVTXRgJyUDGvuEXMEyhZdq+NedV0812kd4ebwl89+G9AsC5cHGMudg6ul
-3xGSPCpBV3NfJuHo0pTbPRswSxz5sgoQ+yuKWV2t3kTEa3A5yGqcc/Q+MRkrJpuGCCTMZCshap11VfC4
-VpdpW+xrcjyLngHL663JBhWjE6wGZuj/Nst+Y2n9inI+4Mi7Wk+yToQoLU340MzyI+HgSsa/PglYrfy6
-DLhLdEoSQx7hRonVaq9JYCpEfjj4WJI1oBDL1R4iMlF3OQjmr8FDS84CLfZc7PtKw3QjzoFKdMX4JaEX
-6jVmHyYd6AGfRFInoG0vrQc9aFrbG/g4/ER8+HYS7CdB0fsrPwqaBHfrFMQ2ycDn4FeTMvRNhUjTED6N
-Whznat0pvbg2DWMMno7HDMzTr/Sq33g64+7/NDxt3nW3eLo23DEGT8d+l4F5+rVe9cvj6X6MZmh8xy7o
-2B029Mabuqpvwky9wpD40wizuKDtTgkzA9OYwmynYmblrG5lOCEHjyj+qolOHTNqjostOKV4EjFuzk4a
-tSkHXnJxmUo/9gQ+TJrSftqudE1EfkO2WY3CYCsx+aIOtJ2Cc74ybRQ+NMRj36i9IUuEfR+Z5gKYA4IP
-Efa3jZ22tFzjM5uvm+1hTlbUtRgytiNCbDXrKehqjnhSwTZctR6FtjtCb9IdofflF7QpKXu3IwVl421N
-N1EY+f4xBy+f7l2Ryl0UkRx0UTX2BYoEeMiLNI1xJFfqdxcb+UnkKtaXIu5CrDqQAF9oGZoLe1cc+kgA
-pzhoYrTOe99h5/OJNUJcMe59TnBKu2yVvSnlbOCXhG7rNA8BwbvaUvzT9UNn39lHAgKsWAGtgSsM0vpU
-CNbAdS6MCMGdm/HOSgb+tFuF/u6pzoKYLhaO5c/dZ0e7i8VM/fd89i88+3N29mD32dFi4eR+mv7XdPpM
-//4g8/tiMVssnLMH02eFwv+yCmSrliiP+lb/2l+z/Jqz9nsQ5susf61NW2usfy1atVEIXIBUV1KOFmb2
-KNT4vmcd0UameFjCTJIAGoty87UYm2nI4DYsTs6jYrXkljW+KZZbZQ6lVGPuJfCZVjpm6oQ1pukgMyXW
-UxLlDGGB9MEED53foNMLIlfRueOyYG4mzD2i0D2P1ErzZF5K74YZkgNsHhw4B4/SJYYlcJEgw9AZAkz8
-bpypp4zFlQ8HJZrBbhhKrZiQBcWsBbE2s8ai16NB6ZXgOAzJSLh+3I1casZYpHo8KKk0boOR6bAzmQ7H
-ItOTocl0OBCZIk66USniZCwiHQ5KJIXZMDQyRlqLy7Jo5hWvydTasxmAg2Ifw7x9d5BfgF7IVcdyQTNp
-JD36sF/53EFVpWAPDDeTRsLw+54Fgns71qDOl1E4WGP8fX2Fgz0s4dRZ1LX6bSTi/L2CNhZhlxqyEw4X
-cD1IN9VSALN/G5KiY+7zdAct1vbZnHHFMY11Wci1jS73kP7Ytp6kIVW7vpVC8mxQXjxsY7fX5hts6NsJ
-HT3priFixnbD4yaEoQVnDR6VZXp7PcuiCiS44kTCK+rfdKVDMnHgxjsH+7UWqb2pTtN98HFni9Kcbuu0
-a6/1cajeAR+30yWsorUcaazpEZx4yeLG0WZqt3DOYnFvsdg9nb13khLXe7vT08VivlicnT1YLKabWMxO
-DKVN6E4K4cJSxjEOkm7G2QhkXdNTu/wuf4Ui+df2gjZZS0nTaxpG+dvMPjsuSM4XYkey/2Qe0W3Lm58j
-QeiFD4gyL6H0qYt9H11wHK5SSQHUuSKXJASPmE+BqP/mx9j33+uR0wESbFxGJSY0bxduk8/CwqFWUvT3
-ffCHXu8XNhyMAjjB/rCr1cDXOhko3daCbl/XRKJORXMDr8Xdd8yCAFMP8Yii8xuEUQLHP3T3c048/Rma
-GyRAIiw175tAgg9r8KtvNPt1Xtdbavv0l15lLrdNlYM2cOu2pa5akIBAhJoqnmTHy4mnDe1O4mF/7J6a
-i+XsaPpMXTOLxTzX4b8iF9IeqKvyoVWhtLspUDpnETX96NVNFGK5UiiycGrN8ET5BqS+P7EOut3bCrjG
-okT0tznjcQd9DksNPkgUhYwiuCayGvbuZVS2NNKyCtsu2bTRMq5iZKDrwmcIWvCDlX26ZF93YGfD0qd/
-PK3m3BbcW8ck7biY0AxbXM0NVysdrJqfW/J0DV93BbuncBwgIbyOoe1MXTX6tjYB3N7QwzqrQ8J3TbZh
-FahnTbL9BV0TzmgAVCaGgkXKN5bkDnHP/ET8bzfMZ71h0mLfoa+Ydmfzs180Wjvsw8pN5TaGKK0qTgqZ
-LpJtqqhNETUFecX4pTNmnbQtHbnFa9Jprd4iuNuSJq+TlGW9QQ56+fb1G/1pA6Q9Vuh0feDsOwfo1fEJ
-2n0VAkXHG/mBThR4uj59iv5tMm18fMMi+W9rNhALgSbCR8zNBJ0be+6z87l50Ty7jhN407R+3amvDbI7
-BNswdX3/JVvy0HDnwpL1XenOyUlv5GJqGgHE/KwD0ZqRmVwZ735M6vKh6iNKipCHjFsLq0rxBjUuFtZp
-s4IEDcn0Dysm5GSvs3Bod2GZVOndWZwy/WxXuuH/RV44fdbymPwPExIphHfFVEF8TvTNU8uQ9luuKuCf
-DxTZpHWdEq0z0gtITj6leDelor10lbZ7eFTdEbDaj7HhsriUFXue4m0U4DA0X0vDm0e2pMmB5Etvqqrr
-6Ed7k6ICpv8sNvsw+tBu3luTyVjRYrZrRKmybLHaF2bu/cr2dK1Ik+/VXhVjbF0vm/ucb1NCwYkp60oq
-KvPxYWPdJu1Xku4s4pIoBnPGSRFIyzub67aai4qykYJN7xiFjIOOzQ2jS5+UCWy+MHmToqvF+duT+wIx
-Lch9IiTCAlEAz7BZfBVh3xdObU5AReVdWVMq+eHjL54qFAQ599Up0MCZlqkmqF/ETUMoHPQ6MyHtqnpJ
-fB88xJSSSBnyGb0AHiM10pZmvt7ZuKcsHMobXIq/VJ4Cren55E8Q6OTX396+ef/r85cvzP6/e/7L2xfK
-8oqLCu6nA47Mw/u6wVE8TiBlfOleRomuKUQUgBePePoU3dtN15iOpxVkQ3DVd0pPq3V4U/Mv6mIsB+ra
-cFnKX6/evkkYLsNlhr8yDw2X5UbX8Joe8PRpdvznZjT719s/A6O1ak/RJovVpqbaPzg8ztlo4de7E0ek
-zivQzSNQXNnuHanMaxBxOF/7RiKadQfcvyByxiFk3318/eLluxe/v//55M37N89/vp0rVfO+UgHubwie
-WqX3UVWG89CaZsFO31LPTKLWFVdtnws/vYrnPKJt8jozke4CHG3DLcWtVmspMWr0oriHRPJpYR63EfSQ
-IEHkS0yBRcIvuF5qdQpe+M52Ax+nn+au5+OoTUrhP1dYoguQQkeuS5/Azn7du84nUr1PZcDWFdGzamx1
-vG17btc0s1Cp8ghUtpbJ5qCaPIjPyPOZ3IlROL7AEZpZNiwPHyLT7qHE8IN8ccC2ZbZtQwM3kI+otNQs
-tnpvr7byX5kEMORtBu3FNZHITbouZSD5R+JU8NA5LBkvfvTdGTKhpoNPoTkRZlzx19gNK5/JaUqzalIX
-38XFW63TFuM2IPnEw1CE4LZ7y6kZnIZDzP8OYVPjVjm/6QNJkhhaOviTTTmqPq71as/tzv8HAAD//+mP
-Lry7jAAA
+H4sIAAAAAAAC/+w9a3PcOI7f/StQnlTivml328kks2tXLuV1PLO+yqsSJ1u3tjfLltDd3EikhqRs9+Ty
+369ISmq13pKlJDNJvsy4xQcAgiAAAuDHLYDtO9JZok+2D2B7qVRwMJ3+R3K2a3+dcLGYuoLM1e7ez1P7
+2w/bY91PUeWh7vUycJQHPJABOsAD+9VF6QgaKMqZbvMU55ShBMJSLeaUUd1Abh+ABgVgmwhBVsecSSUI
+ZWr9JT1hrtE4abIKTAs++w86av17IHiAQlFMD6inc10DAfFOFfqbH/NI/M+bly/gjaEBnGe6wgdcXXPh
+Xu5oIsqD6VRx7skJRTU3RFwq34soeS3oYql2U2TevSIedYkeb3dv/weJjvnfR5P9vdH2OA3SHYFzDcsP
+0xT9phrvNEGSHp/WnbdpWxTpgIj9NYMXYauXGrHz1I+wAWoX9DMkKByyiCzdZwP4tFX212XhsvjkpjXz
+xX16XJy9ZHEe5rku3leUKVyg2PzoU0b90N8+gL1iBClrjyBlgyK43yeCIaO/hdgax1S3oaTHgxI0Z5x7
+SFhKTmxl0EqJxldp4TknnsStVFMrjU9uAoFSWjQ/bhVjv24E10vqLAGviBcShRIUB8LADJWT5ucpeb3R
+AGBbKkHZYnsrvcFiwCIk+wANYoJVwJZtUgMd9gGW/gtbEawAShb6sw2Wzx6fNZhQF5mic4qiApMjsEOA
+JHOEORcQSgRiNILUALmTPJo4+T0gSqEwQ/7rfPc92f39aPefe7t/vfzxzvYGVB7nAZl52Mvyx4OBBgt2
+DFWBC7CEGrVagXbE1TMfc8/TXVLwV+3OcQmSf6PMlfDy7dmrt2fvXxw9PwE+ByTOEqhCYcTHGChb/wVc
+uCjGoDi8O3p9evS3Zyf6+5wLnyi4lxrpIGlw79AQSwK/wpg+EmaUuUb3M39raYcuzFamqf5rDFwtUchE
+CEzgNIZCRkvicnZPAQ9VEKoNLIhAkB9oEKA7gdN5ClYJLPS8MVAFz9++OYOZ5jgZ+uiuGz1+DHd2UsON
+crz2qkR5PN99P0nxX1b0N9RoYkbXkLZTXIq1kysiqGbV1zhHgczB7Sb6SE78ZzTpHE++JmyBFTvKfAc+
+B7VEs8xjoBOcmD+N1ALFze+GUdY0L0aqYDPnIHpHhLztFnkXEU8CcV10QXGQDg8QOMvslHrbgjIXb2r0
+gXi+zOBSsyl3KFHoghkHrqnnwQzBJy4CuSLUM/3UUvBwsWxiI1QwRlqZ+YCrHoD+gKvPB7I9BW8PtBln
+YLBbbTSfiA8uv2aFVnDysYyZn0cNgDI4v9qb3P8LHHPf50x/ALliitxYhfNgOtXG+8Qxn/XARunUXaYj
+oMzxQlef3b/+8hyUpeKNQiY39kHmxN5AxKoYdXZ9vlV3w97zXs43fqoz53WHgayN+/dL1PCsblBqjZYw
+Wp5gW0WSPr1X4lOpOWHYajjCPPh6CIMs9DPDV9JFtx+KLHu3JstaqzC6fS32VqfL4s8ZNlJh4g1cZD0X
+Kgf4W0gFSqMNWBCNbsagdIQSeyC7fOeZ32ENVObLZXs3TeQEaOOlof5wXFLCJNklz7hiWiNB2YBI/NQF
+idBTNPCwnRxb9xrKp9QBFcZVGxwYV0Mx08NGfuYKuZpGK5YbjREzHYZC7acvfMh0d63ZGXrxYGScPAWO
+irwbqMovYRWxOo0u36qHq5oSe7zxfc26fxuOWxLmCryWDXju0eTh5FGG6ZoepWUO2u4OgerLkdpz79vQ
+pPOM+l2T7koYFwNkLjKn5Q5N9xvqjP5rt235ea8diyzp4i3bZAP/KQ2brJ5v3ac5qyDtVMlTqYyBfXLT
+7YzZ6DgUCz/o+W62I6qUDY/qT32i+kfTtSsk77ena3c4hoJOfB0MztSPSkiUuxdspvR2OZ1KCFZ53VVH
+t2znocj389dKPmGdWm4bqsV9hiLWw94ciXldpBcL165aP9EjdixzBRwIfkVddKMAA/tlDNHmXgEjPkq4
+a298ZHLlo88VEXBP3wZVmcrtrvADIoh/66vJV3oUVCgk8HkqjhKa3VRXXFQ31IFT/F3gZbUcVe1jHd9m
+gkKLuN8pXCqGHH5OPRxy/Lyvv+8ZitTpfmeQ3PmAw85QaFRV3AakZytXKpItcJAHqMl2LzsujvSgEMSb
+P7/3m4BWMHC+gWkSCHSM9DsAJUIcF7UqPi+T6+hcl0/jImDmJPRUGSDFU2QjDRvNROUbdASqcpw36H1q
+I1bMVEAlSNu5kBCVzroyeJxCl2lT7CvijrOegYLpC4MNSlonWPXM0H+zw35naTNFPkZ14FWtJlkrQuSG
+pqJvZnlKBTqKi29PApYrvw5H4VATksTBpcIqsUbtNf+XvfkR6BFFrxACopZjoCpRdwVK7l2hC3PBfSP2
+HOJ5KCQ4oRDIFFxz8YGyBbjxOmy3oAd+FkmdgHZ7ad3rRjPaXs/b4Rfq4fedULwTNL2/8a1gSPB17YLI
+Jul5H7wwo35XIVJhCJ9HLbZzfV16cWUYxhA8HbXpmadfmlG/83TK3f95eNrO9XXxdOV1xxA8Hfldeubp
+N2bUPx9Pd2M0S+Ov7ICO3GF9L7wZ9bswM1NYEn8eYWbn+rqEmYVpSGG2VdKztFe7NJxAoEs1f1XcTh1z
+ZrdL0eWU5kngwu6dZEkKLl4+bjXxY0cxeXWRP00HM3FsPQ3mcKYIZbKv8fC33ka6oVL1BtdC9TcS9jWU
+p/obqTegfKKcJfZGeNYbYIyrHoc6qWKvrcxV0q3S1s60MKFzIJ4HDKVCFxIBJYEINOJmmDCcZKJ+wmMT
+VNjqa0YlEWrNsLGuGCEVaIAg7m1+l+hw5h7a+yUZf9OfqDDN5ThJmrc/5mIW7HEaf5bhLPqhMZlS9Vju
+b34gN8UfWhG22NdVTFn8rQWzGygMV+BvIfFuyxYN/YqWuplKG/0RwIqNZkSIfJqmC1xPQST5xf3lUi9U
+Ny6nEhYCiUIBaklYitk/P182XNnImTXQyi4U9kNJLiy/g+Lfqep1Z08PpfzOm4l6d3syfmfM8VZOze1E
+1qiz+VngIvSIgPXkcRWVr5y+wx6UDFvokommoCsG/XmUhfoMhjR/ZRVqoDLjgGinHWcgOWmjuTA+nPLS
+zpUTet6xQHczXbUkFTXr4hFoCpURT0Io0QU3NKtNQrXUvzu2aNY1VcvI3xsKByPXJ/XJAoGLzbDdEjMw
+lCi0ul9D3dZc2IIHNxMDpNTR6V8SnNwqF1rja8oVgZ8zwxuHqUv031WWtzu/uj/Zm+yBRJ9oVoArFBqD
+dX0d9K9QmFh+XWpnattPdFz/qF3Vu51zE8U9uriYFPzvzpODnYuLXf3X0e4/ye7vu5c/7jw5uLiYbPw0
++q/R6In5/cfU7xcXuxcXk8sfR08yxfSkcinX9eM27wqKK8wRkMrVq47EN9wfm7koQHGI97KEMOBMSwTV
+PSu8IMqmtPJT5B/XUMVhHzMeMhcUB6LBNoP1Z0oVXq10h66g8kkf0rL5Hsi58Ysy/vOtvtdwqlysytuR
+bznzvANh/pw1nCpTr2prOGVvZsMAhUQFfA4btLC9B6HGzx1rYcQyxSUKdxX1sbaw1AZGSbeoYmm/OE0e
+ZCv+wO3qVK2xvFX2y5pqOtxA7BrFc1fvsNpUE7BdIl01UdCBSDAb09ZsPV9QtQxnuljg1HaYulSjOwv1
+SNOk35reNT2UQIw/7E/2H6yH6JfAWYL0Q2f0CfXacabpMhRX3u+VaBa7fii15FJllPMGxIp7DUWvB73S
+K8GxH5LR4OqnduTSPYYi1U+9ksrg1huZHrUm06OhyPSwbzI96olMoaDtqBQKOhSRHvVKJI1ZPzSyhnqD
+wzJr6mePybXFX+QE6BX7CObbV7h8hmyhli1L3thOA+nRj7qVgNkvq3bTAUPKBsXw545FbsZbhYGJf47i
+NxXG37dX/KaDJbx2GLat4DIQcf5SQpsir1ZiyG4LXOBNj96rXkppZkD+Qq+uZOvTFDnjsm1qa4uAU9Q6
+/5LFx6Y1EWrSjavLASbfeuXFR03s9sqY+Zi+rdAxnb42RFZBezxWAfYtOCvwKC01M+5Y2iNDgmtBFb5k
+3qotHZKOPReP3d+rtEiLC8PWnQcf60/c8vIS7cZpViL6Y1/17z7eTpcoFK35+5OKd27iNvGDXLZruyu9
+i4s7Fxc7G+8J3dkZnV9cTC8uLi9/vLgYxfdxWxGURUJ3O3NlnMuaJX7yIg8PSiHcIEWx/M6/7pn8WTRB
+k8ybeELKgnDzNCvuHRXV2uhr34bq2FmE7LYluo5AUrbwEBh3E0qf60RyWAgSLNeSAtnkmn6gAbrUPrGq
+/5oeE897b1qOekgSSS5d+4py50FfI2n6ex56fY/3jPcHo0RBidfvaL3Cd02Vs7x1wsFc5dLzc2wdvckG
+fA6SzjzKFqY6QhSRbnVkP5QKZOg4qN0fOOfCXmnH5RNMB6mIUNLUZyBsBS5Hye4l3cb5DlSuX3bTZy2Q
+IPCoVcSdJfVcgUbixAxgeslhrLDUK4WNMgYoC/ElOxGC1xH4dG6zFmFOqBeKRHTEJBDocOFquoYqIZpu
+C1RJjTsy1cSorig2VJDukOeLqlKblYXRPI9fv+Dsnyj4iQ73qFe1EpIQYJz9joKbQBFwtGS95gkBYjLl
+FaZO9Zay2qFDnCW2AVegDD21rihpBnDhLggMPLJCF+ZcWC6F62n08qVDPHvnNgbHd8eA7Eo/Zjc2NUee
+UgF3wechU7o39XDqUmOoKUxbaX0jHhy5bgPMn1EW3oBDAjKjHlU0/XSeWZyYmQAniwm8ODl7f/T0+emL
+cg23WL2vqpd+++BM6J69+6mOjk8FD7oR0hU8CDZq0mwS8+jZs2+FjH4TXtSv2xHmgggZzFbpuLND88qk
+oK5mT7YCiQqIMjQ1Gw88vELvD0NM7EhGl4oicKsEO1RUZaMowSavrSmd71xXVtr+03GN1vi5PBg90abQ
+xcV043Xfol6lz6wWKVNVKO3kYu4k8W2dKKAMeDAqWJ0M9fIvuJasQ1vgaou/wd0pF9FLpQLnBnxUBfGV
+t+avXvDRR1p8oF1PgZsPsp7AJezZRAdJ/9u2OJc3GGAFDoHOgftUKa3nljHZ9rgKpm5rVbFeETGIW+L/
+aqTxRDX41s+mRgurxwWuB+6AVIOymE1wU34wl+0Ri25xx0AYoB+oFVAGPvpcrAyqVCZYUiYVEvcQHKJ1
+Uq3xcX9GmeVszQlcQELiSkqUOjzT/4rfOdn8V1KmOf3vshKShvtI/9uW9HesbVX0Nu0N6K6xqWNW6hCQ
+qiUKmK0USuAiuWzRxAwZVVbvefSTX0OCWxRLKz9NG/Ndk3upNtOVTtV+mqIpLjuUY2k8cabrdmTbFGkh
+xWGhZQdLq/pELRQRq4yc/+txuc5RA23dcdhM/6AsdZxcT+1RoT0uo0ZipEIbqeHePs7FGrW2dpvWl0yq
+2zOXjdn/U2WJpDJZWtCrRUmkmkf6i0C9rNPKT9gVFZz5yFQqxyann1fvzhuqjrnbxOWxkbiy9s3w+eZ8
+JcksVg5XaKjdCFdUhLcPi+cXWkjL77ZOY8lyW1tnXd63b2Onmay5JVqagf4kNk/zlRjO6GlxPgxs+hhq
+fDHb5xtQGI1/rosIr9kZFTsit+CZfBjF43rh1jXLUGlP/WTIiuBFiesNpll3azSLFE5DmrxJktvNAk3g
++ds3ZzBDEwPhURfOr/Yne5N9eHl8CjsvA2RwnOgEpxo8U4l9BP82/Xc9suKh+ndhzhAPkCWHrpzaDiaL
+eubx2dRONE2PM/Hd0bpS+6S6CmZx2FATpq5+aagoxai/fVFQHwDKgj42NTKHMJil+NmEqxtG5sb6XpO6
+qVZWLY6zkAdcKNkA9Fe6XXRsFFyBRBdMOuFme9xaODRT1GxS/c6u/e/oyY5ygv8L3WD0pOE2+TuXCjTC
+O3IEisOMmjOwkiGLtbuytIDoa/HjX1Dm60mHH2WR3P6s4p0pFMTRsqDNPWuxjaEvfq6nttIwMpDKBcrg
+Lpyd/W8UpqBQ+JQRT5pdQJQizrJoPfq5RQ0EvaIeLtDtB7f1eBN4aiP+pVHJNJkhZFFtpigAiZr6gnO6
+CAW6dm9fU4kD4RorU685V7/ItvgKzpXRpeRKKvTLzch6Fas/dChD2UhQCT5DcNEyl3FXLjFjK9o4DuKu
+DjdiaCR4VCr9F1VAGTBEV77W7WywDHDmoPkmJ3ByQxzlrYAzc/DiDTpj0Oflr6jgLign0CLThuLMMHkE
+s4N0rBGLeuaGAjB9J5u1nw9LSJQgrY0ICTr6o/oEL4sI3qyCtV/YoPwOF/p1eHW6v/nUSG2LeKDhkvz9
+7OwV/HpyBlp9QanAJy7m4jVqF0egDDhzTRwLgfs3N8AFPLi50YyrQrndxK1WfDQ1cNBq87H0a1GCg1rG
+6KJ7CG5KeE4/q8PAaD9tINc7OicNE1Tawt7s/qO5m7RSA17j21gtaWWfFm0Fo09cEa/hXngaClu1a8fc
+LD3c2/PlGO7LEVwTqkwoo7pG1Ecvn6Hc5Jx92cbi6/YMQCTUG6JTyC21O5k4DgZKwtnxK92M2UyEVsh1
+e4RIUR95qLqt1YM9OYaH/ghMDGus38Vhkzo6UMal8NK462tag37Pazeciqx9DdrYlo2iyHyqJHAGSS8g
+V4R6JDqoahzYt9UMnCCUDZdTXwHr5gXWaSjR3vTuTx4Oz4X2Zr8F1LZDMdxN77Af7t/3Pxdqb65J0B69
+wAslyGsS3A7R/cUh7O4Dldo+0dyJ7vB4B9SVZiu0QDsQ3EEpsYghdXLI4EDLpf+mPJoi54CLoiemLl5N
+5dK/bfBEd1SGk3z2xaROF3hNHTwH5Q/jl5s0MWtY+IC4rqYO+MSE69okYfupqO4S9ON87E5V5aIQzV//
+3Sj+WDdy8XHex8i0SQZxg0ur6yWXGMfPJ36FuV24yGWUVaGGcYTqYqUNcHorUcDO3ejOkHjeChaCh8Go
+QK8LGRAJlEVVW7SgFucHpvkl7GwGQaeKlRjf+WgYLKMkhgaI/iP7xKq9JWwPdmUOdOljUeW5VmaejVSr
+8VbbnZiaqyIrvvErZenh5tRwRU2W0bFxN0V+GJuPFqnOidKMzNVZJ0s0BwlNMrPQtblJ6AIXcE0kfKCe
+p72QZ0sEHiqH+7mspeS+985O1GIEd220iM6J0s1JPKrpY5qab6NDYNFp5pMVEM/aKzOMFz+fDcYDuBtj
+1XMS2FrnnWo9oDb5i87r8700I68rc28UmLABbGtSxhIqToT74s/h+OTmmDObp+fUcd1zcqML1sQBP3y+
+kbdHNjP3rPyyTs/DxI/5349hfwLHSbcry5tEIPwWYoguhExR09k4X5MJUL+0k/zFDCNrsWFc4Ul9eMd6
+lPMsVZVU2FZZStOvQWXvdKp2OiFyAsf28s7UH1c8SiCbr1KGr+A+vD29Z1RAxY1/GYjMom+RmlQWZSmG
+HvOX0J0yRrO4GQjlBN6kOqwT6azAMZwBjIPH2QJFhNTkyyd8rv32w5HG3B8ixLLQpNhSlSTVJnRLrm9t
+CHp0j2H2S8rvk7wupW8KiLZ7DtOcYRuZsdFNDf5HWpRc3l3nDNZcVYJS0a7hJB79HSWcvnj19uz9i6Pn
+J3ZTvjt69vYkpZvdWzc4sB/vmdToqJ0EHWQ3BqrWsRVShj66UYvHj+HOznqM0XC34OnCFOVmUnVE9MfP
+F1LYManpS0c65ctXNOGyNX+9fHuWMFyKyyx/pT5aLttoXcFrpsHjx+n2X5rROobBDhG72uDhYWhQ2zFL
+KFMV5nz3/UZQ8IB7o0E8+lexRaqi4NpFwOWd7PMGGy+hj4yK3IDiWu9Mh7/dW1C1KzDgP3x8c/L83cnr
+97+enr0/O/r101Sbs/eAC7gXE3wdhXUPSnijd2s2E5d2S1s2qeVSctR+Dotro/5LBo6maUK5mxTOA6Bx
+CcDo/Ul7AWV1Ka0KoQuS+qGnCEMeSm81aa5TONzztLBszMke58Fx1KmSlysNtGZGWgrXCpvsdN2q1ipL
+jYjMbepqqnY/5zYxYcWxwuUEfW26VI8aNvE8/mNJFCxQWYJxBkic5Rrr2JrTc1YhX876ecCuShLpyrE1
+qXe3FyCGZgVUKpUqpe+wj7cyJZK+pBhJFWkaRIhkOMIwSyxFdECHeVssJ0MavpZRE4tcsGRFywatC2dX
+z2tkQLd5S5npywjVb0y22IVrcuFiMv+YS7OQHCZuzaQg2CbYkz7rrbTwakJtnZRhBWsjYWiru/Ukhqzr
+f/3oqENkfA+WeW/d+OFFiIf21UaG+tcxOMkAUfwT7NinNkfaIynNu+TxF8ZVLNWIKHNbV+5hIgt+LsJK
+TxxxnCVYmucoAy5cFH1yWbuc8/pIxvxdQTm6r9YrlSq5R6wnTq+ZtvgjP7DEyFloF6IsIrU+frY2QLb9
+1ivagIXbsFJENhGUdL4mB5U1tGgmJsuA/1R0xFXHRuq1L0U493tBuGRNjnpNKajkwYB2h48RC3UkbXrm
+dNA9rXC4laIpUIk6N/0r7lFnZXaUwN3YhlnfyS2RAbU1COV2FQSNpZ5Pbo6UQj9QspPFRqLOY6DM8UI3
+htdI/E3DbRjjy0WPNDE2M2Gc+3Jsw25NfpSOuk2XD7VvXsfIDROgMCPOBz5v4v75heiwhOgc9MgKqARt
+/VN9Z2ju1uyFutFzWsLcjtiNyukfx2qZhJC5SYxscuseQbhOMUIlKLqbadv6pjquS5ppOEBiib79f174
+lHoZkq/z76Xb59RdIAtCmVSpmAMfpTTvMEdPsG/QYfhg7ri8hmwdaZYU2IgP/7qlaHi+16W/dM8i+DSo
+X7jDyZEWsP25P4tD2JuFro9hf/lgryyCPXPJete8zUFdFOiCntUFPW/jt3ROql7V3ix/b9+zqqj3/s62
+aF7rPXo/e2MaHugU7maznNvG6+xw+/eE8pENhZitukCSVNPPcc12/IafYY9qtvi09f8DAL7tJBFI0wAA
 `,
 	},
 }
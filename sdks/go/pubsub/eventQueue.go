@@ -0,0 +1,47 @@
+package pubsub
+
+import (
+	"sync"
+
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+// eventQueue is an unbounded FIFO queue of events; pushing never blocks
+type eventQueue struct {
+	mutex  sync.Mutex
+	events []model.Event
+	// receives once events are pushed; buffered so pushes don't wait on it
+	pushed chan struct{}
+}
+
+func newEventQueue() *eventQueue {
+	return &eventQueue{
+		pushed: make(chan struct{}, 1),
+	}
+}
+
+// Push adds event to the back of the queue
+func (eq *eventQueue) Push(
+	event model.Event,
+) {
+	eq.mutex.Lock()
+	eq.events = append(eq.events, event)
+	eq.mutex.Unlock()
+
+	select {
+	case eq.pushed <- struct{}{}:
+	default:
+		// already signaled
+	}
+}
+
+// PopAll removes & returns all queued events in the order they were pushed
+func (eq *eventQueue) PopAll() []model.Event {
+	eq.mutex.Lock()
+	defer eq.mutex.Unlock()
+
+	events := eq.events
+	eq.events = nil
+
+	return events
+}
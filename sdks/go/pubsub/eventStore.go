@@ -3,6 +3,10 @@ package pubsub
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/opctl/opctl/sdks/go/model"
@@ -12,6 +16,10 @@ import (
 
 type EventStore interface {
 	Add(event model.Event) error
+	// Prune removes events of ended root calls exceeding any limit of policy & compacts the store;
+	// an empty policy removes nothing.
+	// The ids of all calls w/ removed events are returned.
+	Prune(policy RetentionPolicy) ([]string, error)
 	List(
 		ctx context.Context,
 		filter model.EventFilter,
@@ -23,19 +31,38 @@ type EventStore interface {
 
 const sortableRFC3339Nano = "2006-01-02T15:04:05.000000000Z07:00"
 
+// sequences are zero padded so keys sort numerically
+const sortableSequenceFormat = "%020d"
+
 //newEventStore returns an EventStore implementation leveraging [Badger DB](https://github.com/dgraph-io/badger)
 func newEventStore(
 	db *badger.DB,
 ) EventStore {
 	return &_eventStore{
-		eventsByTimestampKeyPrefix: "eventsByTimestamp_",
-		db:                         db,
+		eventsBySequenceKeyPrefix:          "eventsBySequence_",
+		eventSequencesByTimestampKeyPrefix: "eventSequencesByTimestamp_",
+		db:                                 db,
 	}
 }
 
 type _eventStore struct {
-	eventsByTimestampKeyPrefix string
-	db                         *badger.DB
+	eventsBySequenceKeyPrefix string
+	// index of event sequences by timestamp; keys are suffixed w/ sequence so they're unique
+	eventSequencesByTimestampKeyPrefix string
+	db                                 *badger.DB
+}
+
+func (es *_eventStore) getEventKey(sequence uint64) []byte {
+	return []byte(es.eventsBySequenceKeyPrefix + fmt.Sprintf(sortableSequenceFormat, sequence))
+}
+
+func (es *_eventStore) getEventSequenceByTimestampKey(event model.Event) []byte {
+	return []byte(fmt.Sprintf(
+		"%s%s_"+sortableSequenceFormat,
+		es.eventSequencesByTimestampKeyPrefix,
+		event.Timestamp.Format(sortableRFC3339Nano),
+		event.Sequence,
+	))
 }
 
 // O(1); threadsafe
@@ -49,13 +76,75 @@ func (es *_eventStore) Add(
 			return err
 		}
 
-		return txn.Set(
-			[]byte(es.eventsByTimestampKeyPrefix+event.Timestamp.Format(sortableRFC3339Nano)),
+		if err := txn.Set(
+			es.getEventKey(event.Sequence),
 			encodedEvent,
+		); err != nil {
+			return err
+		}
+
+		return txn.Set(
+			es.getEventSequenceByTimestampKey(event),
+			nil,
 		)
 	})
 }
 
+// events were previously keyed by timestamp alone
+const legacyEventsByTimestampKeyPrefix = "eventsByTimestamp_"
+
+// migrateLegacyEvents moves events keyed by timestamp alone to eventStore w/ sequences assigned by getNextSequence
+func migrateLegacyEvents(
+	db *badger.DB,
+	eventStore EventStore,
+	getNextSequence func() (uint64, error),
+) error {
+	legacyEvents := []model.Event{}
+	legacyKeys := [][]byte{}
+	err := db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+		prefixBytes := []byte(legacyEventsByTimestampKeyPrefix)
+		for it.Seek(prefixBytes); it.ValidForPrefix(prefixBytes); it.Next() {
+			item := it.Item()
+			if err := item.Value(func(v []byte) error {
+				event := model.Event{}
+				if err := json.Unmarshal(v, &event); err != nil {
+					return err
+				}
+				legacyEvents = append(legacyEvents, event)
+				legacyKeys = append(legacyKeys, item.KeyCopy(nil))
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for i, event := range legacyEvents {
+		event.Sequence, err = getNextSequence()
+		if err != nil {
+			return err
+		}
+
+		if err := eventStore.Add(event); err != nil {
+			return err
+		}
+
+		if err := db.Update(func(txn *badger.Txn) error {
+			return txn.Delete(legacyKeys[i])
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // O(n) (n being number of events that exist); threadsafe
 func (es _eventStore) List(
 	ctx context.Context,
@@ -68,24 +157,21 @@ func (es _eventStore) List(
 		defer close(eventChannel)
 		defer close(errChannel)
 
-		if err := es.db.View(func(txn *badger.Txn) error {
-			sinceTime := new(time.Time)
-			if filter.Since != nil {
-				sinceTime = filter.Since
-			}
+		eventMatcher := newEventMatcher(filter)
 
-			it := txn.NewIterator(badger.DefaultIteratorOptions)
-			defer it.Close()
-			sinceBytes := []byte(es.eventsByTimestampKeyPrefix + sinceTime.Format(sortableRFC3339Nano))
-			for it.Seek(sinceBytes); it.ValidForPrefix([]byte(es.eventsByTimestampKeyPrefix)); it.Next() {
-				item := it.Item()
-				item.Value(func(v []byte) error {
+		if err := es.db.View(func(txn *badger.Txn) error {
+			emitEvent := func(item *badger.Item) error {
+				return item.Value(func(v []byte) error {
 					event := model.Event{}
 					if err := json.Unmarshal(v, &event); err != nil {
 						return err
 					}
 
-					if !isRootCallIDExcludedByFilter(getEventRootCallID(event), filter) {
+					if filter.Since != nil && event.Timestamp.Before(*filter.Since) {
+						return nil
+					}
+
+					if eventMatcher.isMatch(event) {
 						select {
 						case <-ctx.Done():
 							return ctx.Err()
@@ -96,6 +182,43 @@ func (es _eventStore) List(
 				})
 			}
 
+			if filter.Since == nil || filter.AfterSequence != 0 {
+				// iterate events in sequence order
+				it := txn.NewIterator(badger.DefaultIteratorOptions)
+				defer it.Close()
+				prefixBytes := []byte(es.eventsBySequenceKeyPrefix)
+				for it.Seek(es.getEventKey(filter.AfterSequence + 1)); it.ValidForPrefix(prefixBytes); it.Next() {
+					if err := emitEvent(it.Item()); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+
+			// iterate event sequences in timestamp order so we can seek to since
+			opts := badger.DefaultIteratorOptions
+			opts.PrefetchValues = false
+			it := txn.NewIterator(opts)
+			defer it.Close()
+			prefixBytes := []byte(es.eventSequencesByTimestampKeyPrefix)
+			sinceBytes := []byte(es.eventSequencesByTimestampKeyPrefix + filter.Since.Format(sortableRFC3339Nano))
+			for it.Seek(sinceBytes); it.ValidForPrefix(prefixBytes); it.Next() {
+				key := string(it.Item().Key())
+				sequence, err := strconv.ParseUint(key[strings.LastIndex(key, "_")+1:], 10, 64)
+				if err != nil {
+					return err
+				}
+
+				item, err := txn.Get(es.getEventKey(sequence))
+				if err != nil {
+					return err
+				}
+
+				if err := emitEvent(item); err != nil {
+					return err
+				}
+			}
+
 			return nil
 		}); err != nil {
 			errChannel <- err
@@ -104,3 +227,142 @@ func (es _eventStore) List(
 
 	return eventChannel, errChannel
 }
+
+// rootCallInfo is info about a root call used to apply a RetentionPolicy
+type rootCallInfo struct {
+	ID        string
+	EndedAt   *time.Time
+	SizeBytes int64
+}
+
+// O(n) (n being number of events that exist); threadsafe
+func (es *_eventStore) Prune(
+	policy RetentionPolicy,
+) ([]string, error) {
+	if policy.IsEmpty() {
+		return []string{}, nil
+	}
+
+	// 1st pass: gather info about root calls
+	rootCallInfos := map[string]*rootCallInfo{}
+	var totalSizeBytes int64
+	if err := es.iterate(func(event model.Event, sizeBytes int64) error {
+		rootCallID := getEventRootCallID(event)
+		info, ok := rootCallInfos[rootCallID]
+		if !ok {
+			info = &rootCallInfo{ID: rootCallID}
+			rootCallInfos[rootCallID] = info
+		}
+
+		info.SizeBytes += sizeBytes
+		totalSizeBytes += sizeBytes
+
+		if event.CallEnded != nil && event.CallEnded.Call.ID == rootCallID {
+			endedAt := event.Timestamp
+			info.EndedAt = &endedAt
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	endedRootCallInfos := []*rootCallInfo{}
+	for _, info := range rootCallInfos {
+		if info.EndedAt != nil {
+			endedRootCallInfos = append(endedRootCallInfos, info)
+		}
+	}
+
+	// newest first
+	sort.Slice(endedRootCallInfos, func(i, j int) bool {
+		return endedRootCallInfos[i].EndedAt.After(*endedRootCallInfos[j].EndedAt)
+	})
+
+	prunedRootCallIDs := map[string]struct{}{}
+	for i, info := range endedRootCallInfos {
+		if policy.All ||
+			(policy.MaxAge != 0 && time.Since(*info.EndedAt) > policy.MaxAge) ||
+			(policy.MaxRootCalls != 0 && i >= policy.MaxRootCalls) {
+			prunedRootCallIDs[info.ID] = struct{}{}
+		}
+	}
+
+	if policy.MaxSizeBytes != 0 {
+		// prune oldest until within limit
+		for _, info := range endedRootCallInfos {
+			if _, ok := prunedRootCallIDs[info.ID]; ok {
+				totalSizeBytes -= info.SizeBytes
+			}
+		}
+		for i := len(endedRootCallInfos) - 1; i >= 0 && totalSizeBytes > policy.MaxSizeBytes; i-- {
+			info := endedRootCallInfos[i]
+			if _, ok := prunedRootCallIDs[info.ID]; !ok {
+				prunedRootCallIDs[info.ID] = struct{}{}
+				totalSizeBytes -= info.SizeBytes
+			}
+		}
+	}
+
+	if len(prunedRootCallIDs) == 0 {
+		return []string{}, nil
+	}
+
+	// 2nd pass: delete events of pruned root calls
+	prunedCallIDs := []string{}
+	writeBatch := es.db.NewWriteBatch()
+	defer writeBatch.Cancel()
+
+	if err := es.iterate(func(event model.Event, sizeBytes int64) error {
+		if _, ok := prunedRootCallIDs[getEventRootCallID(event)]; !ok {
+			return nil
+		}
+
+		if event.CallStarted != nil {
+			prunedCallIDs = append(prunedCallIDs, event.CallStarted.Call.ID)
+		}
+
+		if err := writeBatch.Delete(es.getEventKey(event.Sequence)); err != nil {
+			return err
+		}
+		return writeBatch.Delete(es.getEventSequenceByTimestampKey(event))
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := writeBatch.Flush(); err != nil {
+		return nil, err
+	}
+
+	// reclaim space; GC rewrites at most one value log file per run so run until nothing's rewritten
+	for {
+		if err := es.db.RunValueLogGC(0.5); err != nil {
+			break
+		}
+	}
+
+	return prunedCallIDs, nil
+}
+
+// iterate calls fn w/ each event & its estimated size in sequence order
+func (es *_eventStore) iterate(
+	fn func(event model.Event, sizeBytes int64) error,
+) error {
+	return es.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+		prefixBytes := []byte(es.eventsBySequenceKeyPrefix)
+		for it.Seek(prefixBytes); it.ValidForPrefix(prefixBytes); it.Next() {
+			item := it.Item()
+			if err := item.Value(func(v []byte) error {
+				event := model.Event{}
+				if err := json.Unmarshal(v, &event); err != nil {
+					return err
+				}
+				return fn(event, item.EstimatedSize())
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,115 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fakes
+
+import (
+	"sync"
+
+	"github.com/opctl/opctl/sdks/go/pubsub"
+)
+
+type FakeEventPruner struct {
+	PruneStub        func(pubsub.RetentionPolicy) ([]string, error)
+	pruneMutex       sync.RWMutex
+	pruneArgsForCall []struct {
+		arg1 pubsub.RetentionPolicy
+	}
+	pruneReturns struct {
+		result1 []string
+		result2 error
+	}
+	pruneReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeEventPruner) Prune(arg1 pubsub.RetentionPolicy) ([]string, error) {
+	fake.pruneMutex.Lock()
+	ret, specificReturn := fake.pruneReturnsOnCall[len(fake.pruneArgsForCall)]
+	fake.pruneArgsForCall = append(fake.pruneArgsForCall, struct {
+		arg1 pubsub.RetentionPolicy
+	}{arg1})
+	fake.recordInvocation("Prune", []interface{}{arg1})
+	fake.pruneMutex.Unlock()
+	if fake.PruneStub != nil {
+		return fake.PruneStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.pruneReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeEventPruner) PruneCallCount() int {
+	fake.pruneMutex.RLock()
+	defer fake.pruneMutex.RUnlock()
+	return len(fake.pruneArgsForCall)
+}
+
+func (fake *FakeEventPruner) PruneCalls(stub func(pubsub.RetentionPolicy) ([]string, error)) {
+	fake.pruneMutex.Lock()
+	defer fake.pruneMutex.Unlock()
+	fake.PruneStub = stub
+}
+
+func (fake *FakeEventPruner) PruneArgsForCall(i int) pubsub.RetentionPolicy {
+	fake.pruneMutex.RLock()
+	defer fake.pruneMutex.RUnlock()
+	argsForCall := fake.pruneArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeEventPruner) PruneReturns(result1 []string, result2 error) {
+	fake.pruneMutex.Lock()
+	defer fake.pruneMutex.Unlock()
+	fake.PruneStub = nil
+	fake.pruneReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEventPruner) PruneReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.pruneMutex.Lock()
+	defer fake.pruneMutex.Unlock()
+	fake.PruneStub = nil
+	if fake.pruneReturnsOnCall == nil {
+		fake.pruneReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.pruneReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEventPruner) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.pruneMutex.RLock()
+	defer fake.pruneMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeEventPruner) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pubsub.EventPruner = new(FakeEventPruner)
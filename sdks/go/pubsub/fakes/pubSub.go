@@ -10,6 +10,19 @@ import (
 )
 
 type FakePubSub struct {
+	PruneStub        func(pubsub.RetentionPolicy) ([]string, error)
+	pruneMutex       sync.RWMutex
+	pruneArgsForCall []struct {
+		arg1 pubsub.RetentionPolicy
+	}
+	pruneReturns struct {
+		result1 []string
+		result2 error
+	}
+	pruneReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
 	PublishStub        func(model.Event)
 	publishMutex       sync.RWMutex
 	publishArgsForCall []struct {
@@ -33,6 +46,69 @@ type FakePubSub struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakePubSub) Prune(arg1 pubsub.RetentionPolicy) ([]string, error) {
+	fake.pruneMutex.Lock()
+	ret, specificReturn := fake.pruneReturnsOnCall[len(fake.pruneArgsForCall)]
+	fake.pruneArgsForCall = append(fake.pruneArgsForCall, struct {
+		arg1 pubsub.RetentionPolicy
+	}{arg1})
+	fake.recordInvocation("Prune", []interface{}{arg1})
+	fake.pruneMutex.Unlock()
+	if fake.PruneStub != nil {
+		return fake.PruneStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.pruneReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePubSub) PruneCallCount() int {
+	fake.pruneMutex.RLock()
+	defer fake.pruneMutex.RUnlock()
+	return len(fake.pruneArgsForCall)
+}
+
+func (fake *FakePubSub) PruneCalls(stub func(pubsub.RetentionPolicy) ([]string, error)) {
+	fake.pruneMutex.Lock()
+	defer fake.pruneMutex.Unlock()
+	fake.PruneStub = stub
+}
+
+func (fake *FakePubSub) PruneArgsForCall(i int) pubsub.RetentionPolicy {
+	fake.pruneMutex.RLock()
+	defer fake.pruneMutex.RUnlock()
+	argsForCall := fake.pruneArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePubSub) PruneReturns(result1 []string, result2 error) {
+	fake.pruneMutex.Lock()
+	defer fake.pruneMutex.Unlock()
+	fake.PruneStub = nil
+	fake.pruneReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePubSub) PruneReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.pruneMutex.Lock()
+	defer fake.pruneMutex.Unlock()
+	fake.PruneStub = nil
+	if fake.pruneReturnsOnCall == nil {
+		fake.pruneReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.pruneReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePubSub) Publish(arg1 model.Event) {
 	fake.publishMutex.Lock()
 	fake.publishArgsForCall = append(fake.publishArgsForCall, struct {
@@ -131,6 +207,8 @@ func (fake *FakePubSub) SubscribeReturnsOnCall(i int, result1 <-chan model.Event
 func (fake *FakePubSub) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.pruneMutex.RLock()
+	defer fake.pruneMutex.RUnlock()
 	fake.publishMutex.RLock()
 	defer fake.publishMutex.RUnlock()
 	fake.subscribeMutex.RLock()
@@ -1,10 +1,31 @@
 package pubsub
 
 import (
+	"time"
+
 	"github.com/opctl/opctl/sdks/go/model"
 )
 
 type subscriptionInfo struct {
 	Filter model.EventFilter
 	Done   chan struct{}
+	// Queue holds published events until they're delivered to the subscription
+	Queue *eventQueue
+}
+
+// RetentionPolicy defines which events of ended root calls are retained; zero values disable the respective limit.
+type RetentionPolicy struct {
+	// All retains no events of ended root calls regardless of other limits
+	All bool
+	// MaxAge is the max duration since a root call ended its events are retained
+	MaxAge time.Duration
+	// MaxSizeBytes is the max total size of events retained
+	MaxSizeBytes int64
+	// MaxRootCalls is the max number of ended root calls events are retained for
+	MaxRootCalls int
+}
+
+// IsEmpty returns true if no limit is enabled; empty policies retain all events
+func (rp RetentionPolicy) IsEmpty() bool {
+	return !rp.All && rp.MaxAge == 0 && rp.MaxSizeBytes == 0 && rp.MaxRootCalls == 0
 }
@@ -31,8 +31,22 @@ type EventSubscriber interface {
 	)
 }
 
+//counterfeiter:generate -o fakes/eventPruner.go . EventPruner
+type EventPruner interface {
+	// Prune removes events of ended root calls exceeding any limit of policy;
+	// an empty policy removes events of all ended root calls.
+	// The ids of all calls w/ removed events are returned.
+	Prune(
+		policy RetentionPolicy,
+	) (
+		[]string,
+		error,
+	)
+}
+
 //counterfeiter:generate -o fakes/pubSub.go . PubSub
 type PubSub interface {
+	EventPruner
 	EventPublisher
 	EventSubscriber
 }
@@ -40,14 +54,30 @@ type PubSub interface {
 func New(
 	db *badger.DB,
 ) PubSub {
-	return &pubSub{
-		eventStore:    newEventStore(db),
+	// sequences are leased in batches; leases not fully used before exit leave gaps
+	sequence, err := db.GetSequence([]byte("eventSequence"), 1000)
+	if err != nil {
+		panic(err)
+	}
+
+	ps := &pubSub{
+		sequence:      sequence,
 		subscriptions: map[chan model.Event]subscriptionInfo{},
 	}
+
+	ps.eventStore = newEventStore(db)
+	if err := migrateLegacyEvents(db, ps.eventStore, ps.getNextSequence); err != nil {
+		panic(err)
+	}
+
+	return ps
 }
 
 type pubSub struct {
 	eventStore EventStore
+	// synchronize publishing via mutex so events are stored in sequence order
+	publishMutex sync.Mutex
+	sequence     *badger.Sequence
 	// subscriptions is a map where key is a channel for the subscription & value is info about the subscription
 	subscriptions      map[chan model.Event]subscriptionInfo
 	subscriptionsMutex sync.RWMutex
@@ -71,16 +101,40 @@ func (ps *pubSub) Subscribe(
 		subscriptionInfo := subscriptionInfo{
 			Filter: filter,
 			// Done is closed when the subscription is garbage collected
-			Done: make(chan struct{}, 1),
+			Done:  make(chan struct{}, 1),
+			Queue: newEventQueue(),
 		}
 
 		ps.subscriptionsMutex.Lock()
 		ps.subscriptions[publishEventChannel] = subscriptionInfo
 		ps.subscriptionsMutex.Unlock()
 
+		go ps.deliverToSubscription(publishEventChannel, subscriptionInfo)
+
+		eventMatcher := newEventMatcher(filter)
+
+		eventStoreFilter := filter
+		if filter.CallIDs != nil {
+			// descendants are discovered from CallPending & CallStarted events so they must not be filtered out;
+			// events before Since are excluded by eventMatcher once they've been used for discovery
+			eventStoreFilter.ContainerIDs = nil
+			eventStoreFilter.Kinds = nil
+			eventStoreFilter.Outcomes = nil
+			eventStoreFilter.Since = nil
+		}
+
 		// old events
-		eventStoreEventChannel, _ := ps.eventStore.List(ctx, filter)
+		var lastSequence uint64
+		eventStoreEventChannel, _ := ps.eventStore.List(ctx, eventStoreFilter)
 		for event := range eventStoreEventChannel {
+			if event.Sequence > lastSequence {
+				lastSequence = event.Sequence
+			}
+
+			if !eventMatcher.isMatch(event) {
+				continue
+			}
+
 			select {
 			case dstEventChannel <- event:
 			case <-ctx.Done():
@@ -90,6 +144,15 @@ func (ps *pubSub) Subscribe(
 
 		// new events
 		for event := range publishEventChannel {
+			if event.Sequence <= lastSequence {
+				// already sent from event store
+				continue
+			}
+
+			if !eventMatcher.isMatch(event) {
+				continue
+			}
+
 			select {
 			case dstEventChannel <- event:
 			case <-ctx.Done():
@@ -113,26 +176,55 @@ func (ps *pubSub) gcSubscription(
 	ps.subscriptionsMutex.Unlock()
 }
 
+func (ps *pubSub) Prune(
+	policy RetentionPolicy,
+) (
+	[]string,
+	error,
+) {
+	return ps.eventStore.Prune(policy)
+}
+
+// getNextSequence gets the next event sequence; sequences start at 1
+func (ps *pubSub) getNextSequence() (uint64, error) {
+	sequence, err := ps.sequence.Next()
+	if err != nil {
+		return 0, err
+	}
+	return sequence + 1, nil
+}
+
 // O(n) complexity (n being number of existing subscriptions); thread safe
 func (ps *pubSub) Publish(
 	event model.Event,
 ) {
+	// queue to subscriptions while holding publishMutex so they receive events in sequence order
+	ps.publishMutex.Lock()
+	defer ps.publishMutex.Unlock()
+
+	// @TODO: handle err
+	event.Sequence, _ = ps.getNextSequence()
 	ps.eventStore.Add(event)
 
 	ps.subscriptionsMutex.RLock()
 	defer ps.subscriptionsMutex.RUnlock()
 
-	for publishEventChannel, subscriptionInfo := range ps.subscriptions {
+	for _, subscriptionInfo := range ps.subscriptions {
+
+		isExcluded := isRootCallIDExcludedByFilter(getEventRootCallID(event), subscriptionInfo.Filter)
+		if subscriptionInfo.Filter.CallIDs == nil {
+			// when filtering by call id, remaining checks are made by the subscription since they depend on other events
+			isExcluded = isEventExcludedByFilter(event, subscriptionInfo.Filter)
+		}
 
-		RootCallID := getEventRootCallID(event)
-		if !isRootCallIDExcludedByFilter(RootCallID, subscriptionInfo.Filter) {
+		if !isExcluded {
 
-			// use go routine because this publishEventChannel could be blocked
+			// queue rather than send because this publishEventChannel could be blocked
 			// for valid reasons such as replaying events from event store.
 			//
 			// In such a case, we don't want to hold up delivery to any
 			// other subscriptions
-			go ps.publishToSubscription(publishEventChannel, subscriptionInfo, event)
+			subscriptionInfo.Queue.Push(event)
 
 		}
 
@@ -140,15 +232,25 @@ func (ps *pubSub) Publish(
 }
 
 /**
-publishToSubscription publishes event to subscription
+deliverToSubscription delivers queued events to subscription, in the order they were queued, until it's garbage collected
 */
-func (ps *pubSub) publishToSubscription(
+func (ps *pubSub) deliverToSubscription(
 	subscriptionChannel chan model.Event,
 	subscriptionInfo subscriptionInfo,
-	event model.Event,
 ) {
-	select {
-	case <-subscriptionInfo.Done:
-	case subscriptionChannel <- event:
+	for {
+		select {
+		case <-subscriptionInfo.Done:
+			return
+		case <-subscriptionInfo.Queue.pushed:
+		}
+
+		for _, event := range subscriptionInfo.Queue.PopAll() {
+			select {
+			case <-subscriptionInfo.Done:
+				return
+			case subscriptionChannel <- event:
+			}
+		}
 	}
 }
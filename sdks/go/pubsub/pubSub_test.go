@@ -2,7 +2,9 @@ package pubsub
 
 import (
 	"context"
+	"encoding/json"
 	"io/ioutil"
+	"sync"
 	"time"
 
 	"github.com/dgraph-io/badger/v3"
@@ -30,6 +32,198 @@ var _ = Context("pubSub", func() {
 
 			Expect(New(db)).To(Not(BeNil()))
 		})
+		Context("events keyed by timestamp exist", func() {
+			It("should migrate events", func() {
+				/* arrange */
+				db.DropAll()
+
+				expectedEvent := model.Event{
+					CallStarted: &model.CallStarted{
+						Call: model.Call{
+							ID: "id",
+						},
+					},
+					Sequence:  1,
+					Timestamp: time.Now().UTC(),
+				}
+
+				legacyEvent := expectedEvent
+				legacyEvent.Sequence = 0
+				encodedLegacyEvent, err := json.Marshal(legacyEvent)
+				if err != nil {
+					panic(err)
+				}
+
+				err = db.Update(func(txn *badger.Txn) error {
+					return txn.Set(
+						[]byte("eventsByTimestamp_"+legacyEvent.Timestamp.Format(sortableRFC3339Nano)),
+						encodedLegacyEvent,
+					)
+				})
+				if err != nil {
+					panic(err)
+				}
+
+				/* act */
+				objectUnderTest := New(db)
+
+				/* assert */
+				eventChannel, _ := objectUnderTest.Subscribe(context.TODO(), model.EventFilter{})
+
+				var actualEvent model.Event
+				Eventually(eventChannel).Should(Receive(&actualEvent))
+				// ignore timestamp
+				actualEvent.Timestamp = expectedEvent.Timestamp
+				Expect(actualEvent).To(Equal(expectedEvent))
+			})
+		})
+	})
+	Context("Prune", func() {
+		publishRootCall := func(
+			objectUnderTest PubSub,
+			rootCallID string,
+			isEnded bool,
+			timestamp time.Time,
+		) {
+			call := model.Call{
+				ID:     rootCallID,
+				RootID: rootCallID,
+			}
+			objectUnderTest.Publish(
+				model.Event{
+					CallStarted: &model.CallStarted{Call: call},
+					Timestamp:   timestamp,
+				},
+			)
+			objectUnderTest.Publish(
+				model.Event{
+					CallStarted: &model.CallStarted{
+						Call: model.Call{
+							ID:     rootCallID + "Child",
+							RootID: rootCallID,
+						},
+					},
+					Timestamp: timestamp,
+				},
+			)
+			if isEnded {
+				objectUnderTest.Publish(
+					model.Event{
+						CallEnded: &model.CallEnded{Call: call},
+						Timestamp: timestamp,
+					},
+				)
+			}
+		}
+
+		listRootCallIDs := func(objectUnderTest PubSub) []string {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			eventChannel, _ := objectUnderTest.Subscribe(ctx, model.EventFilter{})
+
+			rootCallIDs := []string{}
+			for {
+				select {
+				case event := <-eventChannel:
+					if event.CallStarted != nil && event.CallStarted.Call.ID == event.CallStarted.Call.RootID {
+						rootCallIDs = append(rootCallIDs, event.CallStarted.Call.ID)
+					}
+				case <-time.After(100 * time.Millisecond):
+					return rootCallIDs
+				}
+			}
+		}
+
+		Context("empty policy", func() {
+			It("should prune nothing", func() {
+				/* arrange */
+				db.DropAll()
+
+				objectUnderTest := New(db)
+				publishRootCall(objectUnderTest, "endedRootCallID", true, time.Now().UTC())
+				publishRootCall(objectUnderTest, "runningRootCallID", false, time.Now().UTC())
+
+				/* act */
+				actualPrunedCallIDs, actualErr := objectUnderTest.Prune(RetentionPolicy{})
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualPrunedCallIDs).To(BeEmpty())
+				Expect(listRootCallIDs(objectUnderTest)).To(ConsistOf("endedRootCallID", "runningRootCallID"))
+			})
+		})
+		Context("All", func() {
+			It("should prune events of all ended root calls", func() {
+				/* arrange */
+				db.DropAll()
+
+				objectUnderTest := New(db)
+				publishRootCall(objectUnderTest, "endedRootCallID", true, time.Now().UTC())
+				publishRootCall(objectUnderTest, "runningRootCallID", false, time.Now().UTC())
+
+				/* act */
+				actualPrunedCallIDs, actualErr := objectUnderTest.Prune(RetentionPolicy{All: true})
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualPrunedCallIDs).To(ConsistOf("endedRootCallID", "endedRootCallIDChild"))
+				Expect(listRootCallIDs(objectUnderTest)).To(ConsistOf("runningRootCallID"))
+			})
+		})
+		Context("MaxAge", func() {
+			It("should prune events of root calls ended before max age", func() {
+				/* arrange */
+				db.DropAll()
+
+				objectUnderTest := New(db)
+				publishRootCall(objectUnderTest, "oldRootCallID", true, time.Now().UTC().Add(-time.Hour))
+				publishRootCall(objectUnderTest, "newRootCallID", true, time.Now().UTC())
+
+				/* act */
+				actualPrunedCallIDs, actualErr := objectUnderTest.Prune(RetentionPolicy{MaxAge: time.Minute})
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualPrunedCallIDs).To(ConsistOf("oldRootCallID", "oldRootCallIDChild"))
+				Expect(listRootCallIDs(objectUnderTest)).To(ConsistOf("newRootCallID"))
+			})
+		})
+		Context("MaxRootCalls", func() {
+			It("should prune events of all but the most recently ended root calls", func() {
+				/* arrange */
+				db.DropAll()
+
+				objectUnderTest := New(db)
+				publishRootCall(objectUnderTest, "rootCallID1", true, time.Now().UTC().Add(-2*time.Second))
+				publishRootCall(objectUnderTest, "rootCallID2", true, time.Now().UTC().Add(-time.Second))
+				publishRootCall(objectUnderTest, "rootCallID3", true, time.Now().UTC())
+
+				/* act */
+				_, actualErr := objectUnderTest.Prune(RetentionPolicy{MaxRootCalls: 2})
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(listRootCallIDs(objectUnderTest)).To(ConsistOf("rootCallID2", "rootCallID3"))
+			})
+		})
+		Context("MaxSizeBytes", func() {
+			It("should prune events of the oldest root calls until within max size", func() {
+				/* arrange */
+				db.DropAll()
+
+				objectUnderTest := New(db)
+				publishRootCall(objectUnderTest, "rootCallID1", true, time.Now().UTC().Add(-time.Second))
+				publishRootCall(objectUnderTest, "rootCallID2", true, time.Now().UTC())
+
+				/* act */
+				_, actualErr := objectUnderTest.Prune(RetentionPolicy{MaxSizeBytes: 1})
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(listRootCallIDs(objectUnderTest)).To(BeEmpty())
+			})
+		})
 	})
 	Context("Publish", func() {
 		Context("subscription exist", func() {
@@ -44,6 +238,7 @@ var _ = Context("pubSub", func() {
 								RootID: "rootID",
 							},
 						},
+						Sequence: 1,
 					}
 
 					objectUnderTest := New(db)
@@ -59,6 +254,51 @@ var _ = Context("pubSub", func() {
 					Expect(actualEvent).To(Equal(expectedEvent))
 				})
 			})
+			Context("many events published concurrently", func() {
+				It("receives events in sequence order", func() {
+					/* arrange */
+					db.DropAll()
+
+					objectUnderTest := New(db)
+
+					eventChannel, _ := objectUnderTest.Subscribe(context.TODO(), model.EventFilter{})
+
+					// ensure events are delivered as published rather than replayed from the event store
+					objectUnderTest.Publish(model.Event{})
+					var firstEvent model.Event
+					Eventually(eventChannel).Should(Receive(&firstEvent))
+
+					publishedEventCount := 5000
+					var publishWaitGroup sync.WaitGroup
+
+					/* act */
+					for i := 0; i < publishedEventCount; i++ {
+						publishWaitGroup.Add(1)
+						go func() {
+							defer publishWaitGroup.Done()
+							objectUnderTest.Publish(
+								model.Event{
+									CallStarted: &model.CallStarted{
+										Call: model.Call{
+											RootID: "rootID",
+										},
+									},
+								},
+							)
+						}()
+					}
+					publishWaitGroup.Wait()
+
+					/* assert */
+					lastSequence := firstEvent.Sequence
+					for i := 0; i < publishedEventCount; i++ {
+						var actualEvent model.Event
+						Eventually(eventChannel).Should(Receive(&actualEvent))
+						Expect(actualEvent.Sequence).To(BeNumerically(">", lastSequence))
+						lastSequence = actualEvent.Sequence
+					}
+				})
+			})
 			Context("isn't subscribed", func() {
 				It("doesn't receive event", func() {
 					/* arrange */
@@ -98,6 +338,7 @@ var _ = Context("pubSub", func() {
 								ID: "id",
 							},
 						},
+						Sequence: 1,
 					}
 
 					objectUnderTest := New(db)
@@ -125,6 +366,7 @@ var _ = Context("pubSub", func() {
 								RootID: "rootId",
 							},
 						},
+						Sequence: 1,
 					}
 
 					providedFilter := model.EventFilter{
@@ -160,6 +402,7 @@ var _ = Context("pubSub", func() {
 								ID: "id",
 							},
 						},
+						Sequence:  1,
 						Timestamp: time.Now(),
 					}
 
@@ -169,6 +412,7 @@ var _ = Context("pubSub", func() {
 								RootID: "rootID",
 							},
 						},
+						Sequence:  2,
 						Timestamp: time.Now().Add(time.Second),
 					}
 
@@ -193,6 +437,281 @@ var _ = Context("pubSub", func() {
 					Expect(actualEvent2).To(Equal(expectedEvent2))
 				})
 			})
+			Context("same timestamp", func() {
+				It("should receive published events", func() {
+					/* arrange */
+					db.DropAll()
+
+					providedTimestamp := time.Now().UTC()
+
+					expectedEvent1 := model.Event{
+						ContainerStdOutWrittenTo: &model.ContainerStdOutWrittenTo{
+							Data: []byte("data1"),
+						},
+						Sequence:  1,
+						Timestamp: providedTimestamp,
+					}
+
+					expectedEvent2 := model.Event{
+						ContainerStdOutWrittenTo: &model.ContainerStdOutWrittenTo{
+							Data: []byte("data2"),
+						},
+						Sequence:  2,
+						Timestamp: providedTimestamp,
+					}
+
+					objectUnderTest := New(db)
+					objectUnderTest.Publish(expectedEvent1)
+					objectUnderTest.Publish(expectedEvent2)
+
+					/* act */
+					eventChannel, _ := objectUnderTest.Subscribe(context.TODO(), model.EventFilter{})
+
+					/* assert */
+					var actualEvent1 model.Event
+					Eventually(eventChannel).Should(Receive(&actualEvent1))
+					Expect(actualEvent1).To(Equal(expectedEvent1))
+
+					var actualEvent2 model.Event
+					Eventually(eventChannel).Should(Receive(&actualEvent2))
+					Expect(actualEvent2).To(Equal(expectedEvent2))
+				})
+			})
+			Context("filter w/ Kinds", func() {
+				It("should receive only events of kinds", func() {
+					/* arrange */
+					db.DropAll()
+
+					call := model.Call{ID: "id", RootID: "id"}
+
+					expectedEvent := model.Event{
+						CallEnded: &model.CallEnded{
+							Call:    call,
+							Outcome: model.OpOutcomeSucceeded,
+						},
+						Sequence: 2,
+					}
+
+					objectUnderTest := New(db)
+					objectUnderTest.Publish(
+						model.Event{
+							CallStarted: &model.CallStarted{Call: call},
+						},
+					)
+					objectUnderTest.Publish(expectedEvent)
+
+					/* act */
+					eventChannel, _ := objectUnderTest.Subscribe(
+						context.TODO(),
+						model.EventFilter{Kinds: []string{model.EventKindCallEnded}},
+					)
+
+					/* assert */
+					var actualEvent model.Event
+					Eventually(eventChannel).Should(Receive(&actualEvent))
+					// ignore timestamp
+					actualEvent.Timestamp = expectedEvent.Timestamp
+					Expect(actualEvent).To(Equal(expectedEvent))
+					Consistently(eventChannel).ShouldNot(Receive())
+				})
+			})
+			Context("filter w/ CallIDs", func() {
+				It("should receive only events of calls & their descendants", func() {
+					/* arrange */
+					db.DropAll()
+
+					parentID := "parentID"
+					childID := "childID"
+
+					objectUnderTest := New(db)
+					objectUnderTest.Publish(
+						model.Event{
+							CallStarted: &model.CallStarted{
+								Call: model.Call{ID: "otherID", RootID: "rootID"},
+							},
+						},
+					)
+					objectUnderTest.Publish(
+						model.Event{
+							CallStarted: &model.CallStarted{
+								Call: model.Call{ID: parentID, RootID: "rootID"},
+							},
+						},
+					)
+					objectUnderTest.Publish(
+						model.Event{
+							CallStarted: &model.CallStarted{
+								Call: model.Call{ID: childID, ParentID: &parentID, RootID: "rootID"},
+							},
+						},
+					)
+					objectUnderTest.Publish(
+						model.Event{
+							ContainerStdOutWrittenTo: &model.ContainerStdOutWrittenTo{
+								ContainerID: childID,
+								RootCallID:  "rootID",
+							},
+						},
+					)
+
+					/* act */
+					eventChannel, _ := objectUnderTest.Subscribe(
+						context.TODO(),
+						model.EventFilter{
+							CallIDs: []string{parentID},
+							Kinds:   []string{model.EventKindContainerStdOutWrittenTo},
+						},
+					)
+
+					/* assert */
+					var actualEvent model.Event
+					Eventually(eventChannel).Should(Receive(&actualEvent))
+					Expect(actualEvent.ContainerStdOutWrittenTo.ContainerID).To(Equal(childID))
+					Consistently(eventChannel).ShouldNot(Receive())
+				})
+				Context("descendant is pending", func() {
+					It("should receive events of the descendant", func() {
+						/* arrange */
+						db.DropAll()
+
+						parentID := "parentID"
+						childID := "childID"
+
+						objectUnderTest := New(db)
+						objectUnderTest.Publish(
+							model.Event{
+								CallStarted: &model.CallStarted{
+									Call: model.Call{ID: parentID, RootID: "rootID"},
+								},
+							},
+						)
+						objectUnderTest.Publish(
+							model.Event{
+								CallPending: &model.CallPending{
+									Call: model.Call{ID: childID, ParentID: &parentID, RootID: "rootID"},
+								},
+							},
+						)
+						objectUnderTest.Publish(
+							model.Event{
+								CallEnded: &model.CallEnded{
+									Call:    model.Call{ID: childID, ParentID: &parentID, RootID: "rootID"},
+									Outcome: model.OpOutcomeSkipped,
+								},
+							},
+						)
+
+						/* act */
+						eventChannel, _ := objectUnderTest.Subscribe(
+							context.TODO(),
+							model.EventFilter{
+								CallIDs: []string{parentID},
+								Kinds:   []string{model.EventKindCallEnded},
+							},
+						)
+
+						/* assert */
+						var actualEvent model.Event
+						Eventually(eventChannel).Should(Receive(&actualEvent))
+						Expect(actualEvent.CallEnded.Call.ID).To(Equal(childID))
+						Consistently(eventChannel).ShouldNot(Receive())
+					})
+				})
+				Context("descendant started before since", func() {
+					It("should receive events of the descendant after since", func() {
+						/* arrange */
+						db.DropAll()
+
+						parentID := "parentID"
+						childID := "childID"
+						startedTime := time.Now().UTC().Add(-time.Minute)
+						providedSince := time.Now().UTC()
+
+						objectUnderTest := New(db)
+						objectUnderTest.Publish(
+							model.Event{
+								CallStarted: &model.CallStarted{
+									Call: model.Call{ID: parentID, RootID: "rootID"},
+								},
+								Timestamp: startedTime,
+							},
+						)
+						objectUnderTest.Publish(
+							model.Event{
+								CallStarted: &model.CallStarted{
+									Call: model.Call{ID: childID, ParentID: &parentID, RootID: "rootID"},
+								},
+								Timestamp: startedTime,
+							},
+						)
+						objectUnderTest.Publish(
+							model.Event{
+								CallEnded: &model.CallEnded{
+									Call:    model.Call{ID: childID, ParentID: &parentID, RootID: "rootID"},
+									Outcome: model.OpOutcomeSucceeded,
+								},
+								Timestamp: providedSince.Add(time.Second),
+							},
+						)
+
+						/* act */
+						eventChannel, _ := objectUnderTest.Subscribe(
+							context.TODO(),
+							model.EventFilter{
+								CallIDs: []string{parentID},
+								Since:   &providedSince,
+							},
+						)
+
+						/* assert */
+						var actualEvent model.Event
+						Eventually(eventChannel).Should(Receive(&actualEvent))
+						Expect(actualEvent.CallEnded.Call.ID).To(Equal(childID))
+						Consistently(eventChannel).ShouldNot(Receive())
+					})
+				})
+			})
+			Context("filter w/ AfterSequence", func() {
+				It("should receive only events after sequence", func() {
+					/* arrange */
+					db.DropAll()
+
+					expectedEvent := model.Event{
+						CallStarted: &model.CallStarted{
+							Call: model.Call{
+								ID: "id2",
+							},
+						},
+						Sequence: 2,
+					}
+
+					objectUnderTest := New(db)
+					objectUnderTest.Publish(
+						model.Event{
+							CallStarted: &model.CallStarted{
+								Call: model.Call{
+									ID: "id1",
+								},
+							},
+						},
+					)
+					objectUnderTest.Publish(expectedEvent)
+
+					/* act */
+					eventChannel, _ := objectUnderTest.Subscribe(
+						context.TODO(),
+						model.EventFilter{AfterSequence: 1},
+					)
+
+					/* assert */
+					var actualEvent model.Event
+					Eventually(eventChannel).Should(Receive(&actualEvent))
+					// ignore timestamp
+					actualEvent.Timestamp = expectedEvent.Timestamp
+					Expect(actualEvent).To(Equal(expectedEvent))
+					Consistently(eventChannel).ShouldNot(Receive())
+				})
+			})
 		})
 	})
 })
@@ -33,6 +33,12 @@ func getEventRootCallID(
 		return event.ContainerStdOutWrittenTo.RootCallID
 	case event.CallKillRequested != nil:
 		return event.CallKillRequested.Request.RootCallID
+	case event.CallOutputsConflicted != nil:
+		return event.CallOutputsConflicted.RootCallID
+	case event.CallPending != nil:
+		return event.CallPending.Call.RootID
+	case event.CallReady != nil:
+		return event.CallReady.RootCallID
 	case event.CallStarted != nil:
 		return event.CallStarted.Call.RootID
 	default:
@@ -40,3 +46,166 @@ func getEventRootCallID(
 		return "00000000-0000-0000-0000-000000000000"
 	}
 }
+
+func getEventKind(
+	event model.Event,
+) string {
+	switch {
+	case event.AuthAdded != nil:
+		return model.EventKindAuthAdded
+	case event.CallEnded != nil:
+		return model.EventKindCallEnded
+	case event.CallKillRequested != nil:
+		return model.EventKindCallKillRequested
+	case event.CallOutputsConflicted != nil:
+		return model.EventKindCallOutputsConflicted
+	case event.CallPending != nil:
+		return model.EventKindCallPending
+	case event.CallReady != nil:
+		return model.EventKindCallReady
+	case event.CallStarted != nil:
+		return model.EventKindCallStarted
+	case event.ContainerStdErrWrittenTo != nil:
+		return model.EventKindContainerStdErrWrittenTo
+	case event.ContainerStdOutWrittenTo != nil:
+		return model.EventKindContainerStdOutWrittenTo
+	default:
+		return ""
+	}
+}
+
+// getEventCallID gets the id of the call an event is about; empty if none
+func getEventCallID(
+	event model.Event,
+) string {
+	switch {
+	case event.CallEnded != nil:
+		return event.CallEnded.Call.ID
+	case event.CallKillRequested != nil:
+		return event.CallKillRequested.Request.OpID
+	case event.CallOutputsConflicted != nil:
+		return event.CallOutputsConflicted.CallID
+	case event.CallPending != nil:
+		return event.CallPending.Call.ID
+	case event.CallReady != nil:
+		return event.CallReady.CallID
+	case event.CallStarted != nil:
+		return event.CallStarted.Call.ID
+	case event.ContainerStdErrWrittenTo != nil:
+		return event.ContainerStdErrWrittenTo.ContainerID
+	case event.ContainerStdOutWrittenTo != nil:
+		return event.ContainerStdOutWrittenTo.ContainerID
+	default:
+		return ""
+	}
+}
+
+// getEventContainerID gets the id of the container an event is about; empty if none
+func getEventContainerID(
+	event model.Event,
+) string {
+	switch {
+	case event.CallEnded != nil && event.CallEnded.Call.Container != nil:
+		return event.CallEnded.Call.Container.ContainerID
+	case event.CallReady != nil:
+		return event.CallReady.ContainerID
+	case event.CallStarted != nil && event.CallStarted.Call.Container != nil:
+		return event.CallStarted.Call.Container.ContainerID
+	case event.ContainerStdErrWrittenTo != nil:
+		return event.ContainerStdErrWrittenTo.ContainerID
+	case event.ContainerStdOutWrittenTo != nil:
+		return event.ContainerStdOutWrittenTo.ContainerID
+	default:
+		return ""
+	}
+}
+
+func isIncluded(
+	value string,
+	includedValues []string,
+) bool {
+	for _, includedValue := range includedValues {
+		if includedValue == value {
+			return true
+		}
+	}
+	return false
+}
+
+// isEventExcludedByFilter checks all parts of filter which don't depend on other events i.e. all but CallIDs
+func isEventExcludedByFilter(
+	event model.Event,
+	filter model.EventFilter,
+) bool {
+	if isRootCallIDExcludedByFilter(getEventRootCallID(event), filter) {
+		return true
+	}
+
+	if filter.Kinds != nil && !isIncluded(getEventKind(event), filter.Kinds) {
+		return true
+	}
+
+	if filter.ContainerIDs != nil && !isIncluded(getEventContainerID(event), filter.ContainerIDs) {
+		return true
+	}
+
+	if filter.Outcomes != nil && event.CallEnded != nil && !isIncluded(event.CallEnded.Outcome, filter.Outcomes) {
+		return true
+	}
+
+	return false
+}
+
+// eventMatcher matches events against a filter.
+// Descendants of filter.CallIDs are discovered from CallStarted events so events must be offered in order.
+type eventMatcher struct {
+	filter model.EventFilter
+	// callIDs are filter.CallIDs & all descendants discovered so far
+	callIDs map[string]struct{}
+}
+
+func newEventMatcher(
+	filter model.EventFilter,
+) *eventMatcher {
+	callIDs := map[string]struct{}{}
+	for _, callID := range filter.CallIDs {
+		callIDs[callID] = struct{}{}
+	}
+
+	return &eventMatcher{
+		filter:  filter,
+		callIDs: callIDs,
+	}
+}
+
+// isMatch returns true if event isn't excluded by the filter
+func (em *eventMatcher) isMatch(
+	event model.Event,
+) bool {
+	if em.filter.CallIDs != nil {
+		// calls are discovered when pending (if ever) or started
+		var discoveredCall *model.Call
+		switch {
+		case event.CallPending != nil:
+			discoveredCall = &event.CallPending.Call
+		case event.CallStarted != nil:
+			discoveredCall = &event.CallStarted.Call
+		}
+		if discoveredCall != nil && discoveredCall.ParentID != nil {
+			if _, isParentIncluded := em.callIDs[*discoveredCall.ParentID]; isParentIncluded {
+				em.callIDs[discoveredCall.ID] = struct{}{}
+			}
+		}
+
+		if _, isCallIncluded := em.callIDs[getEventCallID(event)]; !isCallIncluded {
+			return false
+		}
+	}
+
+	if em.filter.Since != nil && event.Timestamp.Before(*em.filter.Since) {
+		// checked after discovery so descendants discovered before since are still matched
+		return false
+	}
+
+	return !isEventExcludedByFilter(event, em.filter)
+}
@@ -2,6 +2,26 @@ import _Websocket from '../_Websocket'
 import Event from '../../../model/event'
 
 export interface EventFilter {
+    /**
+     * Filters events to those w/ a sequence greater than this; use to resume a stream
+     */
+    afterSequence?: number | null | undefined
+    /**
+     * Filters events to those of these calls & their descendants
+     */
+    callIds?: string[] | null | undefined
+    /**
+     * Filters events to those of these containers
+     */
+    containerIds?: string[] | null | undefined
+    /**
+     * Filters events to those of these kinds (e.g. callStarted)
+     */
+    kinds?: string[] | null | undefined
+    /**
+     * Filters callEnded events to those w/ these outcomes; other events are unaffected
+     */
+    outcomes?: string[] | null | undefined
     roots: string[]
 }
 
@@ -52,6 +72,19 @@ export default function stream(
         { roots: [] },
         filter
     )
+    if (defaultedFilter.afterSequence) {
+        queryParts.push(`afterSequence=${defaultedFilter.afterSequence}`)
+    }
+    for (const [name, values] of [
+        ['callIds', defaultedFilter.callIds],
+        ['containerIds', defaultedFilter.containerIds],
+        ['kinds', defaultedFilter.kinds],
+        ['outcomes', defaultedFilter.outcomes],
+    ] as [string, string[] | null | undefined][]) {
+        if (values && values.length) {
+            queryParts.push(`${name}=${values.map(value => encodeURIComponent(value)).join(',')}`)
+        }
+    }
     queryParts.push(`roots=${defaultedFilter.roots.map(root => encodeURIComponent(root)).join(',')}`)
 
     // enable backpressure
@@ -20,6 +20,7 @@ export default interface Event {
     opEnded?: OpEnded | null | undefined
     opErred?: OpErred | null | undefined
     opStarted?: OpStarted | null | undefined
+    sequence?: number | null | undefined
     timestamp: Date
     parallelCallEnded?: ParallelCallEnded | null | undefined
     serialCallEnded?: SerialCallEnded | null | undefined
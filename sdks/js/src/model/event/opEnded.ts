@@ -3,7 +3,7 @@ import Value from "../value";
 export default interface OpEnded {
     opId: string
     opRef: string
-    outcome: 'SUCCEEDED' | 'FAILED' | 'KILLED'
+    outcome: 'SUCCEEDED' | 'FAILED' | 'KILLED' | 'TIMED_OUT'
     outputs: { [key: string]: Value }
     rootOpId: string
 }
\ No newline at end of file
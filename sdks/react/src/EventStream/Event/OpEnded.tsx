@@ -23,6 +23,9 @@ export default (
     case 'KILLED':
       color = 'rgb(96, 253, 255)'
       break
+    case 'TIMED_OUT':
+      color = 'rgb(255, 110, 103)'
+      break
     default:
       throw new Error(`received unexpected OpEnded.Outcome: '${opEnded.outcome}'`)
   }
@@ -84,7 +84,8 @@ module.exports = {
                         "reference/opspec/op-directory/op/call/predicate",
                         "reference/opspec/op-directory/op/call/pull-creds",
                         "reference/opspec/op-directory/op/call/rangeable-value",
-                        "reference/opspec/op-directory/op/call/serial-loop"
+                        "reference/opspec/op-directory/op/call/serial-loop",
+                        "reference/opspec/op-directory/op/call/switch"
                       ]
                     },
                     {
@@ -140,6 +141,15 @@ module.exports = {
                 "reference/cli/auth/add",
               ]
             },
+            {
+              type: "category",
+              label: "cache",
+              items: [
+                "reference/cli/cache/index",
+                "reference/cli/cache/ls",
+                "reference/cli/cache/prune",
+              ]
+            },
             "reference/cli/events",
             "reference/cli/ls",
             {
@@ -149,6 +159,7 @@ module.exports = {
                 "reference/cli/node/index",
                 "reference/cli/node/create",
                 "reference/cli/node/kill",
+                "reference/cli/node/prune",
               ]
             },
             {
@@ -156,10 +167,14 @@ module.exports = {
               label: "op",
               items: [
                 "reference/cli/op/index",
+                "reference/cli/op/attach",
                 "reference/cli/op/create",
+                "reference/cli/op/get",
                 "reference/cli/op/install",
                 "reference/cli/op/kill",
+                "reference/cli/op/ls",
                 "reference/cli/op/validate",
+                "reference/cli/op/wait",
               ]
             },
             "reference/cli/run",